@@ -0,0 +1,225 @@
+package banner
+
+import (
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Animation selects how an [AnimatedBanner] moves.
+type Animation string
+
+const (
+	// AnimationScroll scrolls the gradient across the art, endlessly.
+	AnimationScroll Animation = "scroll"
+	// AnimationTypewriter types the art in from the left, once.
+	AnimationTypewriter Animation = "typewriter"
+)
+
+// Default timing of an AnimatedBanner.
+const (
+	DefaultScrollInterval     = 150 * time.Millisecond
+	DefaultTypewriterInterval = 30 * time.Millisecond
+	typewriterColumns         = 2 // columns revealed per typewriter frame
+)
+
+// lastID numbers AnimatedBanners so each takes only its own ticks.
+var lastID atomic.Int64
+
+// animatedTickMsg advances the AnimatedBanner with the same id, when tag
+// matches the banner's current run.
+type animatedTickMsg struct {
+	id  int64
+	tag int
+}
+
+// AnimatedBanner is a bubble that shows figlet art either typed in or with
+// its gradient scrolling across it. Every frame is rendered up front, so
+// ticks only pick the next one. With reduced motion it shows the finished
+// art and never ticks.
+type AnimatedBanner struct {
+	id        int64
+	tag       int // run counter; ticks from earlier runs are dropped
+	animation Animation
+	frames    []string
+	frame     int
+	interval  time.Duration
+	reduced   bool
+	ticking   bool
+}
+
+// NewAnimated renders cfg as an AnimatedBanner moving by animation. Scrolling
+// needs a Gradient of at least two stops and a colored Parser; otherwise the
+// banner stands still. Unknown animations scroll.
+func NewAnimated(cfg Config, animation Animation) (AnimatedBanner, error) {
+	b := AnimatedBanner{id: lastID.Add(1), animation: animation}
+	var err error
+	if animation == AnimationTypewriter {
+		b.interval = DefaultTypewriterInterval
+		b.frames, err = typewriterFrames(cfg)
+	} else {
+		b.animation = AnimationScroll
+		b.interval = DefaultScrollInterval
+		b.frames, err = scrollFrames(cfg)
+	}
+	if err != nil {
+		return AnimatedBanner{}, err
+	}
+	return b, nil
+}
+
+// scrollFrames renders one frame per gradient stop, each with the stops
+// rotated one further.
+func scrollFrames(cfg Config) ([]string, error) {
+	if cfg.Gradient == nil || len(cfg.Gradient.Colors) < 2 || cfg.Parser == "terminal" {
+		art, err := Render(cfg)
+		return []string{art}, err
+	}
+	g := cfg.Gradient
+	frames := make([]string, len(g.Colors))
+	for i := range frames {
+		cfg.Gradient = &Gradient{Name: g.Name, Colors: slices.Concat(g.Colors[i:], g.Colors[:i])}
+		art, err := Render(cfg)
+		if err != nil {
+			return nil, err
+		}
+		frames[i] = art
+	}
+	return frames, nil
+}
+
+// typewriterFrames renders the art once and cuts it off a few more columns
+// along in each frame. Cut lines are padded so every frame is as wide as the
+// art and the layout around it does not shift.
+func typewriterFrames(cfg Config) ([]string, error) {
+	art, err := Render(cfg)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(art, "\n")
+	w := lipgloss.Width(art)
+	var frames []string
+	for cols := typewriterColumns; cols < w; cols += typewriterColumns {
+		cut := make([]string, len(lines))
+		for i, l := range lines {
+			t := ansi.Truncate(l, cols, "")
+			cut[i] = t + strings.Repeat(" ", max(ansi.StringWidth(l)-ansi.StringWidth(t), 0))
+		}
+		frames = append(frames, strings.Join(cut, "\n"))
+	}
+	return append(frames, art), nil
+}
+
+// WithReducedMotion returns b showing the finished art, without ticking,
+// while on is set.
+func (b AnimatedBanner) WithReducedMotion(on bool) AnimatedBanner {
+	b.reduced = on
+	if on {
+		b.ticking = false
+	}
+	return b
+}
+
+// WithInterval returns b showing each frame for d.
+func (b AnimatedBanner) WithInterval(d time.Duration) AnimatedBanner {
+	b.interval = d
+	return b
+}
+
+// WithFrame returns b on frame i, so a banner rendered anew, e.g. for a new
+// theme, can carry on where the old one was. Frames out of range are clamped.
+func (b AnimatedBanner) WithFrame(i int) AnimatedBanner {
+	if len(b.frames) == 0 {
+		return b
+	}
+	b.frame = min(max(i, 0), len(b.frames)-1)
+	return b
+}
+
+// Frame returns the index of the frame shown.
+func (b AnimatedBanner) Frame() int {
+	return b.frame
+}
+
+// Animating reports whether b is ticking.
+func (b AnimatedBanner) Animating() bool {
+	return b.ticking
+}
+
+// Start starts the animation and returns the command for its first tick.
+// It is a no-op while the animation runs, with reduced motion, when there
+// is nothing to animate, and for a typewriter that has finished typing.
+func (b AnimatedBanner) Start() (AnimatedBanner, tea.Cmd) {
+	if b.ticking || b.reduced || len(b.frames) < 2 || b.finished() {
+		return b, nil
+	}
+	b.ticking = true
+	b.tag++
+	return b, b.tick()
+}
+
+// Stop stops the animation on the frame it is on.
+func (b AnimatedBanner) Stop() AnimatedBanner {
+	b.ticking = false
+	return b
+}
+
+// Restart starts the animation over from its first frame.
+func (b AnimatedBanner) Restart() (AnimatedBanner, tea.Cmd) {
+	b.ticking = false
+	b.frame = 0
+	return b.Start()
+}
+
+func (b AnimatedBanner) finished() bool {
+	return b.animation == AnimationTypewriter && b.frame == len(b.frames)-1
+}
+
+func (b AnimatedBanner) tick() tea.Cmd {
+	id, tag := b.id, b.tag
+	return tea.Tick(b.interval, func(time.Time) tea.Msg {
+		return animatedTickMsg{id: id, tag: tag}
+	})
+}
+
+// Update advances b on its own ticks and ignores every other message.
+func (b AnimatedBanner) Update(msg tea.Msg) (AnimatedBanner, tea.Cmd) {
+	tick, ok := msg.(animatedTickMsg)
+	if !ok || tick.id != b.id || tick.tag != b.tag || !b.ticking {
+		return b, nil
+	}
+	b.frame = (b.frame + 1) % len(b.frames)
+	if b.finished() {
+		b.ticking = false
+		return b, nil
+	}
+	return b, b.tick()
+}
+
+// View returns the frame shown, or the finished art with reduced motion.
+func (b AnimatedBanner) View() string {
+	if len(b.frames) == 0 {
+		return ""
+	}
+	if b.reduced {
+		return b.Still()
+	}
+	return b.frames[b.frame]
+}
+
+// Still returns the art at rest: fully typed, or with the gradient where it
+// starts.
+func (b AnimatedBanner) Still() string {
+	if len(b.frames) == 0 {
+		return ""
+	}
+	if b.animation == AnimationTypewriter {
+		return b.frames[len(b.frames)-1]
+	}
+	return b.frames[0]
+}
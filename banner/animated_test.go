@@ -0,0 +1,98 @@
+package banner
+
+import (
+	"testing"
+
+	"charm.land/lipgloss/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() Config {
+	return Config{
+		Text:     "Hi",
+		Font:     "standard",
+		Width:    80,
+		Gradient: &Gradient{Name: "test", Colors: []string{"FF0000", "00FF00", "0000FF"}},
+	}
+}
+
+// run delivers ticks to b until it stops or limit ticks have passed, and
+// returns the frames it showed.
+func run(t *testing.T, b AnimatedBanner, limit int) (AnimatedBanner, []string) {
+	t.Helper()
+	b, cmd := b.Start()
+	views := []string{b.View()}
+	for range limit {
+		if cmd == nil {
+			break
+		}
+		b, cmd = b.Update(animatedTickMsg{id: b.id, tag: b.tag})
+		views = append(views, b.View())
+	}
+	return b, views
+}
+
+func TestAnimatedBanner_Typewriter(t *testing.T) {
+	art, err := Render(testConfig())
+	require.NoError(t, err)
+	b, err := NewAnimated(testConfig(), AnimationTypewriter)
+	require.NoError(t, err)
+
+	b, views := run(t, b, 1000)
+	assert.False(t, b.Animating(), "typing stops at the end")
+	assert.Equal(t, art, views[len(views)-1])
+	for _, v := range views {
+		assert.Equal(t, lipgloss.Width(art), lipgloss.Width(v), "frames keep the art's width")
+	}
+	assert.NotEqual(t, art, views[0], "typing starts with part of the art")
+
+	_, cmd := b.Start()
+	assert.Nil(t, cmd, "a finished typewriter stays put")
+}
+
+func TestAnimatedBanner_Scroll(t *testing.T) {
+	b, err := NewAnimated(testConfig(), AnimationScroll)
+	require.NoError(t, err)
+
+	b, views := run(t, b, 3)
+	assert.True(t, b.Animating(), "scrolling never ends")
+	assert.Equal(t, views[0], views[3], "the gradient comes round after one frame per stop")
+	assert.NotEqual(t, views[0], views[1])
+
+	cfg := testConfig()
+	cfg.Parser = "terminal"
+	still, err := NewAnimated(cfg, AnimationScroll)
+	require.NoError(t, err)
+	_, cmd := still.Start()
+	assert.Nil(t, cmd, "plain text has no gradient to scroll")
+}
+
+func TestAnimatedBanner_ReducedMotion(t *testing.T) {
+	b, err := NewAnimated(testConfig(), AnimationTypewriter)
+	require.NoError(t, err)
+	b = b.WithReducedMotion(true)
+
+	b, cmd := b.Start()
+	assert.Nil(t, cmd)
+	assert.Equal(t, b.Still(), b.View(), "the finished art is shown at once")
+}
+
+func TestAnimatedBanner_IgnoresOtherTicks(t *testing.T) {
+	a, err := NewAnimated(testConfig(), AnimationScroll)
+	require.NoError(t, err)
+	b, err := NewAnimated(testConfig(), AnimationScroll)
+	require.NoError(t, err)
+	a, _ = a.Start()
+	b, _ = b.Start()
+
+	a, cmd := a.Update(animatedTickMsg{id: b.id, tag: b.tag})
+	assert.Nil(t, cmd)
+	assert.Zero(t, a.Frame())
+
+	a = a.Stop()
+	a, _ = a.Start()
+	a, cmd = a.Update(animatedTickMsg{id: a.id, tag: a.tag - 1})
+	assert.Nil(t, cmd, "ticks from an earlier run are dropped")
+	assert.Zero(t, a.Frame())
+}
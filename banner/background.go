@@ -0,0 +1,142 @@
+package banner
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// MinBackgroundContrast is the least contrast, as a WCAG ratio, a background
+// must have with every text color of the art. Figlet art counts as large
+// text, for which WCAG asks 3:1. A gradient's BG below it is replaced by
+// black or white, whichever contrasts more.
+const MinBackgroundContrast = 3.0
+
+// ansiRGB approximates the ANSI colors as xterm draws them, for contrast
+// checks; terminals are free to draw them otherwise.
+var ansiRGB = map[string]string{
+	"black":   "000000",
+	"red":     "CD0000",
+	"green":   "00CD00",
+	"yellow":  "CDCD00",
+	"blue":    "0000EE",
+	"magenta": "CD00CD",
+	"cyan":    "00CDCD",
+	"white":   "E5E5E5",
+}
+
+// sgrPattern matches an SGR escape sequence, capturing its parameters.
+var sgrPattern = regexp.MustCompile(`\x1b\[([0-9;:]*)m`)
+
+// backgroundFor returns the hex background, without '#', painted behind art
+// in text: bg when it contrasts with every text color by at least
+// MinBackgroundContrast, and black or white otherwise. An empty bg is
+// always replaced, and without text colors to check, as for a Colorizer, it
+// is black.
+func backgroundFor(bg string, text []colorful.Color) (string, error) {
+	if bg != "" {
+		c, err := colorful.Hex("#" + strings.TrimPrefix(bg, "#"))
+		if err != nil {
+			return "", fmt.Errorf("invalid background %q: %w", bg, err)
+		}
+		if minContrast(c, text) >= MinBackgroundContrast {
+			return strings.ToUpper(c.Hex()[1:]), nil
+		}
+	}
+	black, white := colorful.Color{}, colorful.Color{R: 1, G: 1, B: 1}
+	if len(text) > 0 && minContrast(white, text) > minContrast(black, text) {
+		return "FFFFFF", nil
+	}
+	return "000000", nil
+}
+
+// textColors returns the colors hex values, or ANSI color names, stand for.
+// Values that are neither are left out.
+func textColors(values ...string) []colorful.Color {
+	var colors []colorful.Color
+	for _, v := range values {
+		if hex, ok := ansiRGB[strings.ToLower(v)]; ok {
+			v = hex
+		}
+		if c, err := colorful.Hex("#" + strings.TrimPrefix(v, "#")); err == nil {
+			colors = append(colors, c)
+		}
+	}
+	return colors
+}
+
+// minContrast returns the lowest contrast ratio between bg and colors, or
+// +Inf when there are none.
+func minContrast(bg colorful.Color, colors []colorful.Color) float64 {
+	lowest := math.Inf(1)
+	for _, c := range colors {
+		lowest = min(lowest, contrast(bg, c))
+	}
+	return lowest
+}
+
+// contrast returns the WCAG contrast ratio of a and b, from 1 to 21.
+func contrast(a, b colorful.Color) float64 {
+	la, lb := luminance(a), luminance(b)
+	return (max(la, lb) + 0.05) / (min(la, lb) + 0.05)
+}
+
+// luminance returns the WCAG relative luminance of c.
+func luminance(c colorful.Color) float64 {
+	r, g, b := c.LinearRgb()
+	return 0.2126*r + 0.7152*g + 0.0722*b
+}
+
+// fillBackground paints the hex color bg behind colored art, padding each
+// line of it to the width of the widest so the art sits on a solid block.
+// The background is set again after every SGR sequence in the art that
+// resets it, and reset at the end of each line, so it neither drops out
+// mid-line nor bleeds past the art. The blank lines figlet pads the art with
+// are left as they are.
+func fillBackground(art, bg string) (string, error) {
+	c, err := colorful.Hex("#" + bg)
+	if err != nil {
+		return "", fmt.Errorf("invalid background %q: %w", bg, err)
+	}
+	r, g, b := c.RGB255()
+	on := fmt.Sprintf("\x1b[48;2;%d;%d;%dm", r, g, b)
+
+	rows, width := artSize(ansi.Strip(art))
+	lines := strings.Split(art, "\n")
+	for i, line := range lines[:rows] {
+		line = sgrPattern.ReplaceAllStringFunc(line, func(seq string) string {
+			if resetsBackground(sgrPattern.FindStringSubmatch(seq)[1]) {
+				return seq + on
+			}
+			return seq
+		})
+		pad := strings.Repeat(" ", max(width-ansi.StringWidth(line), 0))
+		lines[i] = on + line + pad + "\x1b[0m"
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// resetsBackground reports whether an SGR sequence with params resets the
+// background: a full reset, which an empty parameter also is, or 49. The
+// components of extended colors, such as the 0 in 38;2;0;119;182, are not
+// parameters of their own.
+func resetsBackground(params string) bool {
+	ps := strings.Split(params, ";")
+	for i := 0; i < len(ps); i++ {
+		switch ps[i] {
+		case "", "0", "49":
+			return true
+		case "38", "48", "58":
+			if i+1 < len(ps) && ps[i+1] == "5" {
+				i += 2
+			} else if i+1 < len(ps) && ps[i+1] == "2" {
+				i += 4
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package banner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResetsBackground(t *testing.T) {
+	for params, want := range map[string]bool{
+		"":               true,
+		"0":              true,
+		"0;31":           true,
+		"49":             true,
+		"1;49":           true,
+		"31":             false,
+		"38;2;0;119;182": false,
+		"38;5;0":         false,
+		"38;2;1;2;3;0":   true,
+	} {
+		assert.Equal(t, want, resetsBackground(params), "params %q", params)
+	}
+}
+
+func TestBackgroundFor(t *testing.T) {
+	light := textColors("FFE066", "F5D063")
+	bg, err := backgroundFor("1B1B3A", light)
+	require.NoError(t, err)
+	assert.Equal(t, "1B1B3A", bg, "a dark BG behind light text is kept")
+
+	bg, err = backgroundFor("FFFFAA", light)
+	require.NoError(t, err)
+	assert.Equal(t, "000000", bg, "a BG too close to the text is replaced")
+
+	bg, err = backgroundFor("", textColors("blue"))
+	require.NoError(t, err)
+	assert.Equal(t, "FFFFFF", bg, "without a BG the better of black and white is used")
+
+	_, err = backgroundFor("nope", light)
+	assert.Error(t, err)
+
+	assert.InDelta(t, 21, contrast(textColors("000000")[0], textColors("FFFFFF")[0]), 0.01)
+}
+
+func TestRender_Background(t *testing.T) {
+	for _, dir := range []Direction{DirectionHorizontal, DirectionVertical} {
+		cfg := testConfig()
+		cfg.Gradient = &Gradient{Name: "test", Colors: []string{"FF6B6B", "FFE066", "48CAE4"}, BG: "101010"}
+		cfg.Direction = dir
+		cfg.Background = true
+		art, err := Render(cfg)
+		require.NoError(t, err)
+
+		on := "\x1b[48;2;16;16;16m"
+		rows, width := artSize(ansi.Strip(art))
+		lines := strings.Split(art, "\n")[:rows]
+		for _, line := range lines {
+			assert.True(t, strings.HasPrefix(line, on), "%s: each line starts on the background", dir)
+			assert.True(t, strings.HasSuffix(line, "\x1b[0m"), "%s: and resets it at its end", dir)
+			assert.Equal(t, width, ansi.StringWidth(line), "%s: lines are padded to a block", dir)
+			for _, seq := range sgrPattern.FindAllStringSubmatchIndex(line, -1) {
+				if resetsBackground(line[seq[2]:seq[3]]) && seq[1] < len(line)-len("\x1b[0m") {
+					assert.True(t, strings.HasPrefix(line[seq[1]:], on), "%s: the background is set again after a reset", dir)
+				}
+			}
+		}
+	}
+
+	plain := testConfig()
+	plain.Background = true
+	plain.Parser = "terminal"
+	art, err := Render(plain)
+	require.NoError(t, err)
+	assert.Equal(t, ansi.Strip(art), art, "plain text stays plain")
+}
@@ -1,12 +1,19 @@
 // Package banner provides figlet-go ASCII art rendering with gradient color support.
-// This is a simplified unified version without safefonts, spacing/kerning, or background features.
+// It is the one banner module shared by the apps in this repository, which
+// require it through a replace directive pointing at this directory.
+// Random fonts come from a curated pool of safe fonts unless asked otherwise.
+// Config.Background paints a solid block behind the art. It does not offer
+// spacing/kerning.
 package banner
 
 import (
 	"fmt"
+	"image/color"
 	"math/rand/v2"
+	"slices"
 	"strings"
 
+	colorful "github.com/lucasb-eyer/go-colorful"
 	"github.com/lsferreira42/figlet-go/figlet"
 )
 
@@ -42,10 +49,13 @@ func resolveColor(s string) (figlet.Color, error) {
 // Gradient holds a named set of hex color stops for figlet-go TrueColor rendering.
 // Colors are hex strings without '#', e.g. "FF6B6B".
 // figlet-go cycles through the stops across rendered characters; more stops
-// produce smoother-looking transitions.
+// produce smoother-looking transitions. BG, also hex, is the color painted
+// behind the art when Config.Background is set; empty lets Render choose
+// black or white.
 type Gradient struct {
 	Name   string
 	Colors []string
+	BG     string
 }
 
 // Predefined gradients — each uses 6–7 stops for gradual color transitions.
@@ -169,25 +179,145 @@ var allGradients = []Gradient{
 	GradientDrift, GradientBloom, GradientAtlas,
 }
 
+// Gradients returns the predefined gradients, in a stable order.
+func Gradients() []Gradient {
+	return slices.Clone(allGradients)
+}
+
+// GradientByName returns the predefined gradient called name.
+// The second return value reports whether the name was found.
+func GradientByName(name string) (Gradient, bool) {
+	i := slices.IndexFunc(allGradients, func(g Gradient) bool { return g.Name == name })
+	if i < 0 {
+		return Gradient{}, false
+	}
+	return allGradients[i], true
+}
+
 // RandomGradient returns a randomly selected predefined gradient.
 func RandomGradient() Gradient {
 	return allGradients[rand.IntN(len(allGradients))]
 }
 
+// Fonts returns the names of the fonts embedded in figlet-go, sorted.
+func Fonts() []string {
+	fonts := slices.Clone(figlet.ListFonts())
+	slices.Sort(fonts)
+	return fonts
+}
+
 // RandomFont returns a randomly selected font from the full figlet-go list.
 func RandomFont() string {
 	fonts := figlet.ListFonts()
 	return fonts[rand.IntN(len(fonts))]
 }
 
+// GradientConfig controls gradient generation parameters.
+type GradientConfig struct {
+	Stops  int  // Number of color stops (default: 7)
+	UseLab bool // Use Lab blending instead of HCL (default: false, meaning HCL is used)
+}
+
+// GradientThemedWithConfig builds a gradient with configurable blending.
+// Uses HCL blending by default for perceptually smooth transitions.
+func GradientThemedWithConfig(primary, secondary color.Color, cfg GradientConfig) *Gradient {
+	if cfg.Stops <= 0 {
+		cfg.Stops = 7
+	}
+
+	p1, ok1 := colorful.MakeColor(primary)
+	p2, ok2 := colorful.MakeColor(secondary)
+	if !ok1 || !ok2 {
+		return &Gradient{Name: "themed", Colors: []string{"888888"}}
+	}
+
+	if cfg.Stops == 1 {
+		return &Gradient{Name: "themed", Colors: []string{p1.Hex()[1:]}}
+	}
+
+	hexes := make([]string, cfg.Stops)
+	for i := 0; i < cfg.Stops; i++ {
+		t := float64(i) / float64(cfg.Stops-1)
+
+		var blended colorful.Color
+		if cfg.UseLab {
+			blended = p1.BlendLab(p2, t).Clamped()
+		} else {
+			blended = p1.BlendHcl(p2, t).Clamped()
+		}
+		hexes[i] = blended.Hex()[1:] // strip '#'
+	}
+
+	return &Gradient{Name: "themed", Colors: hexes}
+}
+
+// GradientThemed builds a *Gradient that flows from primary to secondary.
+// Uses HCL blending for perceptually smooth color transitions.
+// Returns *Gradient so it can be assigned inline in banner.Config{Gradient: ...}.
+// Pass palette.Primary and palette.Secondary to derive a theme-matched gradient.
+func GradientThemed(primary, secondary color.Color) *Gradient {
+	return GradientThemedWithConfig(primary, secondary, GradientConfig{Stops: 7})
+}
+
+// GradientFromColors builds a *Gradient with one stop per color, such as a
+// ramp from theme.Ramp. Colors that cannot be converted, like
+// lipgloss.NoColor, become a neutral gray.
+func GradientFromColors(name string, colors []color.Color) *Gradient {
+	hexes := make([]string, len(colors))
+	for i, c := range colors {
+		hexes[i] = "888888"
+		if cf, ok := colorful.MakeColor(c); ok {
+			hexes[i] = cf.Clamped().Hex()[1:] // strip '#'
+		}
+	}
+	return &Gradient{Name: name, Colors: hexes}
+}
+
+// GenerateGradient creates a perceptually smooth gradient between two hex colors.
+// Uses HCL blending for smooth transitions.
+func GenerateGradient(name, startHex, endHex string, stops int) (Gradient, error) {
+	start, err := colorful.Hex("#" + strings.TrimPrefix(startHex, "#"))
+	if err != nil {
+		return Gradient{}, fmt.Errorf("invalid start color: %w", err)
+	}
+	end, err := colorful.Hex("#" + strings.TrimPrefix(endHex, "#"))
+	if err != nil {
+		return Gradient{}, fmt.Errorf("invalid end color: %w", err)
+	}
+
+	if stops <= 0 {
+		stops = 7
+	}
+
+	if stops == 1 {
+		return Gradient{Name: name, Colors: []string{start.Hex()[1:]}}, nil
+	}
+
+	colors := make([]string, stops)
+	for i := 0; i < stops; i++ {
+		t := float64(i) / float64(stops-1)
+		blended := start.BlendHcl(end, t).Clamped()
+		colors[i] = blended.Hex()[1:]
+	}
+
+	return Gradient{Name: name, Colors: colors}, nil
+}
+
 // Config defines parameters for rendering an ASCII banner.
 type Config struct {
-	// Text is the string to render as ASCII art. Required.
+	// Text is the string to render as ASCII art. Required. Each line of a
+	// multi-line text is rendered on its own, at the same width and
+	// justification, with the gradient carrying on from the line before.
 	Text string
 
-	// Font is the figlet font name. Empty string selects a random font.
+	// Font is the figlet font name. Empty string selects a random font from
+	// FontPool.
 	Font string
 
+	// FontPool is the fonts a random font is picked from: FontPoolSafe, the
+	// default when empty, or FontPoolAll. Ignored when Font is set.
+	FontPool FontPool
+
 	// FontDir sets a custom font directory for loading .flf files from disk.
 	// Leave empty to use the 145 fonts embedded in figlet-go.
 	FontDir string
@@ -211,34 +341,60 @@ type Config struct {
 	// Color is a single color applied uniformly to all characters.
 	// Accepts ANSI names (black, red, green, yellow, blue, magenta, cyan, white)
 	// or hex values with or without '#' (e.g. "FF0000", "#FF0000").
-	// Mutually exclusive with Gradient, RandomGradient, and RandomColor.
+	// Mutually exclusive with Gradient, RandomGradient, RandomColor, and Colorizer.
 	Color string
 
 	// Gradient is a specific color gradient to apply across characters.
-	// Mutually exclusive with Color, RandomGradient, and RandomColor.
+	// Mutually exclusive with Color, RandomGradient, RandomColor, and Colorizer.
 	Gradient *Gradient
 
 	// RandomGradient picks a random predefined gradient.
-	// Mutually exclusive with Color, Gradient, and RandomColor.
+	// Mutually exclusive with Color, Gradient, RandomColor, and Colorizer.
 	// When no color option is set, this is the default behaviour.
 	RandomGradient bool
 
 	// RandomColor picks a random ANSI color applied uniformly to all characters.
-	// Mutually exclusive with Color, Gradient, and RandomGradient.
+	// Mutually exclusive with Color, Gradient, RandomGradient, and Colorizer.
 	RandomColor bool
 
+	// Colorizer colors the art character by character, for effects the
+	// other options cannot express, such as highlighting a substring. The
+	// art is rendered plain and painted afterwards, so it needs the default
+	// "terminal-color" parser. Mutually exclusive with Color, Gradient,
+	// RandomGradient, and RandomColor. Renders with a Colorizer are not
+	// cached, since it may return different colors on every call.
+	Colorizer Colorizer
+
+	// Direction selects which way Gradient, or RandomGradient, runs across
+	// the art. Empty is DirectionHorizontal. Vertical and diagonal gradients
+	// are applied to the plain art afterwards, so they need the default
+	// "terminal-color" parser; other parsers color horizontally.
+	Direction Direction
+
+	// Background paints a solid block behind the art: the gradient's BG, or
+	// black or white, whichever contrasts more with the text, when it has
+	// none or its BG contrasts too little (see MinBackgroundContrast). It
+	// needs the default "terminal-color" parser and is ignored by others.
+	Background bool
+
 	// Parser selects the output format. Valid values: "terminal-color" (default),
-	// "terminal" (plain text, no ANSI), "html".
+	// "terminal" (plain text, no ANSI), "html". Colors are ignored by "terminal".
 	Parser string
 }
 
 // Render renders ASCII art for the given config.
 // Returns ANSI-colored (or plain/HTML) figlet output ready for display.
+// Renders are cached (see [CacheSize]), so rendering the same config again,
+// as on every theme change and resize, is cheap; random fonts and colors are
+// picked first and cached under what was picked.
 func Render(cfg Config) (string, error) {
 	// Resolve font
 	font := cfg.Font
 	if font == "" {
-		font = RandomFont()
+		var err error
+		if font, err = randomFont(cfg.FontPool); err != nil {
+			return "", err
+		}
 	}
 
 	// Resolve colors — exactly one color source may be set.
@@ -255,24 +411,39 @@ func Render(cfg Config) (string, error) {
 	if cfg.RandomColor {
 		colorSources++
 	}
+	if cfg.Colorizer != nil {
+		colorSources++
+	}
 	if colorSources > 1 {
-		return "", fmt.Errorf("banner: Color, Gradient, RandomGradient, and RandomColor are mutually exclusive")
+		return "", fmt.Errorf("banner: Color, Gradient, RandomGradient, RandomColor, and Colorizer are mutually exclusive")
+	}
+	if !cfg.Direction.valid() {
+		return "", fmt.Errorf("banner: unknown direction %q (use horizontal, vertical or diagonal)", cfg.Direction)
 	}
 
 	var colors []figlet.Color
+	var grad *Gradient // the gradient in use, if any
+	var colorKey string // the resolved colors, for the render cache
+	var textHex []string // the text colors, for the background contrast check
 	switch {
+	case cfg.Colorizer != nil:
+		// Painted after rendering.
 	case cfg.Color != "":
 		tc, err := resolveColor(cfg.Color)
 		if err != nil {
 			return "", err
 		}
 		colors = []figlet.Color{tc}
+		colorKey = "color:" + strings.ToLower(strings.TrimPrefix(cfg.Color, "#"))
+		textHex = []string{cfg.Color}
 	case cfg.RandomColor:
 		name := ansiColorNames[rand.IntN(len(ansiColorNames))]
 		colors = []figlet.Color{ansiColors[name]}
+		colorKey = "color:" + name
+		textHex = []string{name}
 	default:
 		// cfg.Gradient set, cfg.RandomGradient set, or nothing set — all use a gradient.
-		grad := cfg.Gradient
+		grad = cfg.Gradient
 		if grad == nil {
 			rg := RandomGradient()
 			grad = &rg
@@ -285,6 +456,8 @@ func Render(cfg Config) (string, error) {
 			}
 			colors[i] = tc
 		}
+		colorKey = "gradient:" + strings.ToUpper(strings.Join(grad.Colors, ","))
+		textHex = grad.Colors
 	}
 
 	// Resolve width
@@ -299,14 +472,56 @@ func Render(cfg Config) (string, error) {
 		parser = "terminal-color"
 	}
 
+	var bg string // hex background painted behind the art; empty for none
+	if cfg.Background && parser == "terminal-color" {
+		var gradBG string
+		if grad != nil {
+			gradBG = grad.BG
+		}
+		var err error
+		if bg, err = backgroundFor(gradBG, textColors(textHex...)); err != nil {
+			return "", err
+		}
+	}
+
+	key := renderKey{
+		text:          cfg.Text,
+		font:          font,
+		fontDir:       cfg.FontDir,
+		width:         width,
+		justification: cfg.Justification,
+		rightToLeft:   cfg.RightToLeft,
+		colors:        colorKey,
+		direction:     cfg.Direction,
+		background:    bg,
+		parser:        parser,
+	}
+	cached := cfg.Colorizer == nil
+	if cached {
+		if art, ok := renders.get(key); ok {
+			return art, nil
+		}
+	}
+
+	// Vertical and diagonal gradients and Colorizers paint over plain art.
+	recolor := grad != nil && parser == "terminal-color" && cfg.Direction.recolored()
+	colorize := cfg.Colorizer != nil && parser == "terminal-color"
+	if recolor || colorize {
+		parser = "terminal"
+	}
+
 	opts := []figlet.Option{
 		figlet.WithFont(font),
 		figlet.WithParser(parser),
 		figlet.WithWidth(width),
-		figlet.WithColors(colors...),
 		figlet.WithJustification(cfg.Justification),
 	}
 
+	// figlet colors every parser's output; plain text must stay plain.
+	if parser == "terminal" {
+		colors = nil
+	}
+
 	if cfg.RightToLeft != 0 {
 		opts = append(opts, figlet.WithRightToLeft(cfg.RightToLeft))
 	}
@@ -315,11 +530,83 @@ func Render(cfg Config) (string, error) {
 		opts = append(opts, figlet.WithFontDir(cfg.FontDir))
 	}
 
-	result, err := figlet.Render(cfg.Text, opts...)
+	result, err := renderLines(cfg.Text, opts, colors)
 	if err != nil {
 		return cfg.Text, fmt.Errorf("figlet render failed (font=%q): %w", font, err)
 	}
 
+	switch {
+	case recolor:
+		if result, err = recolorArt(result, grad, cfg.Direction); err != nil {
+			return "", err
+		}
+	case colorize:
+		result = paint(result, cfg.Colorizer)
+	}
+	if bg != "" {
+		if result, err = fillBackground(result, bg); err != nil {
+			return "", err
+		}
+	}
+	if cached {
+		renders.put(key, result)
+	}
 	return result, nil
 }
 
+// renderLines renders each line of text with figlet, in colors when there
+// are any, and stacks the results. figlet steps through the colors once per
+// non-blank input character, so each line's colors are rotated by the
+// characters before it to carry the gradient on. A single line is rendered
+// as it is.
+func renderLines(text string, opts []figlet.Option, colors []figlet.Color) (string, error) {
+	if !strings.Contains(text, "\n") {
+		if len(colors) > 0 {
+			opts = append(opts, figlet.WithColors(colors...))
+		}
+		return figlet.Render(text, opts...)
+	}
+	var blocks []string
+	chars := 0
+	for line := range strings.SplitSeq(text, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		lineOpts := opts
+		if len(colors) > 0 {
+			k := chars % len(colors)
+			lineOpts = append(slices.Clone(opts), figlet.WithColors(slices.Concat(colors[k:], colors[:k])...))
+		}
+		art, err := figlet.Render(line, lineOpts...)
+		if err != nil {
+			return "", err
+		}
+		blocks = append(blocks, strings.TrimSuffix(art, "\n"))
+		for _, r := range line {
+			if r != ' ' && r != '\t' {
+				chars++
+			}
+		}
+	}
+	return strings.Join(blocks, "\n") + "\n", nil
+}
+
+// RandomBanner returns a Config with random font and random gradient, centered.
+// The font is one of SafeFonts.
+func RandomBanner(text string) Config {
+	return Config{
+		Text:           text,
+		RandomGradient: true,
+		Justification:  1,
+	}
+}
+
+// NamedBanner returns a Config with explicit font and gradient names, centered.
+// An empty font or unknown gradient name falls back to a random selection.
+func NamedBanner(text, fontName, gradientName string) Config {
+	cfg := RandomBanner(text)
+	cfg.Font = fontName
+	if grad, ok := GradientByName(gradientName); ok {
+		cfg.Gradient = &grad
+		cfg.RandomGradient = false
+	}
+	return cfg
+}
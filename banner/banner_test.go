@@ -0,0 +1,36 @@
+package banner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender_MultiLine(t *testing.T) {
+	plain := func(text string) string {
+		cfg := testConfig()
+		cfg.Text = text
+		cfg.Parser = "terminal"
+		art, err := Render(cfg)
+		require.NoError(t, err)
+		return art
+	}
+	assert.Equal(t,
+		strings.TrimSuffix(plain("Hi"), "\n")+"\n"+plain("Ho"),
+		plain("Hi\nHo"), "each line is rendered on its own and stacked")
+
+	cfg := testConfig()
+	cfg.Text = "Hi\nHo"
+	art, err := Render(cfg)
+	require.NoError(t, err)
+	lines := strings.Split(art, "\n")
+	block := strings.Count(plain("Hi"), "\n")
+	first := lineColors(strings.Join(lines[:block], ""))
+	second := lineColors(strings.Join(lines[block:], ""))
+	require.NotEmpty(t, first)
+	require.NotEmpty(t, second)
+	assert.Equal(t, "255;0;0", first[0], "the first line starts the gradient")
+	assert.Equal(t, "0;0;255", second[0], "the second line carries on after H and i")
+}
@@ -0,0 +1,89 @@
+package banner
+
+import (
+	"container/list"
+	"sync"
+)
+
+// CacheSize is how many renders Render keeps, least recently used first
+// out. A few themes times a few widths fit comfortably.
+const CacheSize = 32
+
+// renderKey is everything that changes what figlet renders, with random
+// choices already made.
+type renderKey struct {
+	text          string
+	font          string
+	fontDir       string
+	width         int
+	justification int
+	rightToLeft   int
+	colors        string // resolved color or gradient stops
+	direction     Direction
+	background    string // hex background; empty for none
+	parser        string
+}
+
+// renderCache is an LRU cache of rendered art. It is safe for concurrent
+// use, as banners may be rendered from commands.
+type renderCache struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List // of *cacheEntry, most recently used at the front
+	items map[renderKey]*list.Element
+}
+
+type cacheEntry struct {
+	key renderKey
+	art string
+}
+
+// renders caches the art returned by Render.
+var renders = newRenderCache(CacheSize)
+
+func newRenderCache(size int) *renderCache {
+	return &renderCache{size: size, order: list.New(), items: make(map[renderKey]*list.Element)}
+}
+
+// get returns the art cached for k and marks it recently used.
+func (c *renderCache) get(k renderKey) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[k]
+	if !ok {
+		return "", false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*cacheEntry).art, true
+}
+
+// put caches art for k, dropping the least recently used render when full.
+func (c *renderCache) put(k renderKey, art string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[k]; ok {
+		e.Value.(*cacheEntry).art = art
+		c.order.MoveToFront(e)
+		return
+	}
+	c.items[k] = c.order.PushFront(&cacheEntry{key: k, art: art})
+	if c.order.Len() > c.size {
+		last := c.order.Back()
+		c.order.Remove(last)
+		delete(c.items, last.Value.(*cacheEntry).key)
+	}
+}
+
+// clear empties c.
+func (c *renderCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.order.Init()
+	clear(c.items)
+}
+
+// ClearCache drops every cached render, e.g. after the fonts in a
+// Config.FontDir were edited.
+func ClearCache() {
+	renders.clear()
+}
@@ -0,0 +1,52 @@
+package banner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := newRenderCache(2)
+	a, b, d := renderKey{text: "a"}, renderKey{text: "b"}, renderKey{text: "d"}
+	c.put(a, "A")
+	c.put(b, "B")
+	_, _ = c.get(a) // a is now the most recently used
+	c.put(d, "D")
+
+	_, ok := c.get(b)
+	assert.False(t, ok, "the least recently used render is dropped")
+	art, ok := c.get(a)
+	assert.True(t, ok)
+	assert.Equal(t, "A", art)
+	_, ok = c.get(d)
+	assert.True(t, ok)
+}
+
+func TestRender_Cached(t *testing.T) {
+	ClearCache()
+	t.Cleanup(ClearCache)
+	cfg := testConfig()
+	art, err := Render(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, 1, renders.order.Len())
+
+	again, err := Render(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, art, again)
+	assert.Equal(t, 1, renders.order.Len(), "an identical render is reused")
+
+	cfg.Width = 60
+	_, err = Render(cfg)
+	require.NoError(t, err)
+	cfg.Gradient = &Gradient{Name: "other", Colors: []string{"00FF00", "0000FF"}}
+	_, err = Render(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, 3, renders.order.Len(), "width and gradient are part of the key")
+
+	cfg.Font = "no-such-font"
+	_, err = Render(cfg)
+	require.Error(t, err)
+	assert.Equal(t, 3, renders.order.Len(), "failed renders are not cached")
+}
@@ -0,0 +1,88 @@
+package banner
+
+import (
+	"image/color"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/rivo/uniseg"
+)
+
+// Colorizer returns the color of the character ch drawn at column col of
+// line line of the rendered art, both counted from 0, or nil to leave it
+// uncolored. Spaces are never asked about.
+type Colorizer func(line, col int, ch rune) color.Color
+
+// artSize returns the number of lines of art, leaving out the blank lines
+// figlet pads it with, and the width of the widest.
+func artSize(art string) (rows, width int) {
+	lines := strings.Split(art, "\n")
+	rows = len(lines)
+	for rows > 1 && strings.TrimSpace(lines[rows-1]) == "" {
+		rows--
+	}
+	for _, l := range lines[:rows] {
+		width = max(width, lipgloss.Width(l))
+	}
+	return rows, width
+}
+
+// paint colors plain art cell by cell with colorAt. Runs of cells in the
+// same color share one style, spaces between them included; other spaces and
+// uncolored cells are written as they are.
+func paint(art string, colorAt Colorizer) string {
+	var b strings.Builder
+	for row, line := range strings.Split(art, "\n") {
+		if row > 0 {
+			b.WriteByte('\n')
+		}
+		var run, gap strings.Builder
+		var runColor color.Color
+		flush := func() {
+			if run.Len() > 0 {
+				b.WriteString(lipgloss.NewStyle().Foreground(runColor).Render(run.String()))
+				run.Reset()
+			}
+			b.WriteString(gap.String())
+			gap.Reset()
+		}
+		col := 0
+		gr := uniseg.NewGraphemes(line)
+		for gr.Next() {
+			cluster := gr.Str()
+			if strings.TrimSpace(cluster) == "" {
+				gap.WriteString(cluster)
+				col += gr.Width()
+				continue
+			}
+			c := colorAt(row, col, gr.Runes()[0])
+			col += gr.Width()
+			if c == nil {
+				flush()
+				runColor = nil
+				b.WriteString(cluster)
+				continue
+			}
+			if !sameColor(c, runColor) {
+				flush()
+				runColor = c
+			}
+			run.WriteString(gap.String())
+			gap.Reset()
+			run.WriteString(cluster)
+		}
+		flush()
+	}
+	return b.String()
+}
+
+// sameColor reports whether a and b are the same color; nil matches only
+// nil.
+func sameColor(a, b color.Color) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	r1, g1, b1, a1 := a.RGBA()
+	r2, g2, b2, a2 := b.RGBA()
+	return r1 == r2 && g1 == g2 && b1 == b2 && a1 == a2
+}
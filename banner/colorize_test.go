@@ -0,0 +1,51 @@
+package banner
+
+import (
+	"image/color"
+	"testing"
+
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender_Colorizer(t *testing.T) {
+	cfg := testConfig()
+	cfg.Gradient = nil
+	cfg.Parser = "terminal"
+	plain, err := Render(cfg)
+	require.NoError(t, err)
+
+	var calls int
+	cfg.Parser = ""
+	cfg.Colorizer = func(line, col int, ch rune) color.Color {
+		calls++
+		assert.NotEqual(t, ' ', ch, "spaces are not asked about")
+		if line == 0 {
+			return nil
+		}
+		if col%2 == 0 {
+			return lipgloss.Color("#ff0000")
+		}
+		return lipgloss.Color("#0000ff")
+	}
+	art, err := Render(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, plain, ansi.Strip(art), "painting keeps the art")
+	assert.Positive(t, calls)
+
+	lines := artLines(art)
+	assert.Empty(t, lineColors(lines[0]), "nil leaves a character uncolored")
+	assert.Contains(t, lineColors(lines[1]), "255;0;0")
+	assert.Contains(t, lineColors(lines[1]), "0;0;255")
+
+	calls = 0
+	_, err = Render(cfg)
+	require.NoError(t, err)
+	assert.Positive(t, calls, "renders with a Colorizer are not cached")
+
+	cfg.Color = "red"
+	_, err = Render(cfg)
+	assert.ErrorContains(t, err, "mutually exclusive")
+}
@@ -0,0 +1,78 @@
+package banner
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strings"
+
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// Direction selects which way a gradient runs across the art.
+type Direction string
+
+const (
+	// DirectionHorizontal leaves coloring to figlet-go, which cycles the
+	// stops character by character along each line. It is the default.
+	DirectionHorizontal Direction = "horizontal"
+	// DirectionVertical blends the stops from the top line to the bottom
+	// one, each line in a single color.
+	DirectionVertical Direction = "vertical"
+	// DirectionDiagonal blends the stops from the top-left corner to the
+	// bottom-right one.
+	DirectionDiagonal Direction = "diagonal"
+)
+
+// recolored reports whether d is drawn by recolorArt rather than by figlet-go.
+func (d Direction) recolored() bool {
+	return d == DirectionVertical || d == DirectionDiagonal
+}
+
+// valid reports whether d is a known direction or empty.
+func (d Direction) valid() bool {
+	return d == "" || d == DirectionHorizontal || d.recolored()
+}
+
+// recolorArt colors plain figlet art with the gradient g running in direction
+// d. Each cell's color is blended in HCL between the two stops either side
+// of its position.
+func recolorArt(art string, g *Gradient, d Direction) (string, error) {
+	stops := make([]colorful.Color, len(g.Colors))
+	for i, hex := range g.Colors {
+		c, err := colorful.Hex("#" + strings.TrimPrefix(hex, "#"))
+		if err != nil {
+			return "", fmt.Errorf("invalid hex %q in gradient %q: %w", hex, g.Name, err)
+		}
+		stops[i] = c
+	}
+	if len(stops) == 0 {
+		return art, nil
+	}
+	rows, width := artSize(art)
+	return paint(art, func(row, col int, _ rune) color.Color {
+		t := fraction(row, rows)
+		if d == DirectionDiagonal {
+			t = (t + fraction(col, width)) / 2
+		}
+		return blendStops(stops, t)
+	}), nil
+}
+
+// fraction returns how far i is along n positions, from 0 to 1.
+func fraction(i, n int) float64 {
+	if n <= 1 {
+		return 0
+	}
+	return min(float64(i)/float64(n-1), 1)
+}
+
+// blendStops returns the color at t, from 0 to 1, along stops.
+func blendStops(stops []colorful.Color, t float64) colorful.Color {
+	if len(stops) == 1 {
+		return stops[0]
+	}
+	pos := t * float64(len(stops)-1)
+	i := min(int(math.Floor(pos)), len(stops)-2)
+	return stops[i].BlendHcl(stops[i+1], pos-float64(i)).Clamped()
+}
@@ -0,0 +1,88 @@
+package banner
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fgColor = regexp.MustCompile(`38;2;(\d+;\d+;\d+)m`)
+
+// lineColors returns the truecolor foregrounds set on line, in order.
+func lineColors(line string) []string {
+	var colors []string
+	for _, m := range fgColor.FindAllStringSubmatch(line, -1) {
+		colors = append(colors, m[1])
+	}
+	return colors
+}
+
+// artLines returns the lines of art that are not blank.
+func artLines(art string) []string {
+	var lines []string
+	for l := range strings.SplitSeq(art, "\n") {
+		if strings.TrimSpace(l) != "" {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+func TestRender_Vertical(t *testing.T) {
+	plainCfg := testConfig()
+	plainCfg.Parser = "terminal"
+	plain, err := Render(plainCfg)
+	require.NoError(t, err)
+
+	cfg := testConfig()
+	cfg.Direction = DirectionVertical
+	art, err := Render(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, plain, ansi.Strip(art), "recoloring keeps the art")
+
+	lines := artLines(art)
+	require.Greater(t, len(lines), 2)
+	first := lineColors(lines[0])
+	last := lineColors(lines[len(lines)-1])
+	require.Len(t, first, 1, "a vertical gradient colors each line in one color")
+	require.Len(t, last, 1)
+	assert.Equal(t, "255;0;0", first[0], "the top line is the first stop")
+	assert.Equal(t, "0;0;255", last[0], "the bottom line is the last stop")
+}
+
+func TestRender_Diagonal(t *testing.T) {
+	cfg := testConfig()
+	cfg.Direction = DirectionDiagonal
+	art, err := Render(cfg)
+	require.NoError(t, err)
+
+	lines := artLines(art)
+	assert.Greater(t, len(lineColors(lines[1])), 1, "a diagonal gradient changes along each line")
+	assert.NotEqual(t, lineColors(lines[0])[0], lineColors(lines[len(lines)-1])[0],
+		"and from line to line")
+}
+
+func TestRender_DirectionFallsBack(t *testing.T) {
+	cfg := testConfig()
+	cfg.Direction = DirectionVertical
+	cfg.Parser = "terminal"
+	art, err := Render(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, ansi.Strip(art), art, "plain text stays plain")
+
+	cfg = testConfig()
+	cfg.Gradient = nil
+	cfg.Color = "red"
+	cfg.Direction = DirectionDiagonal
+	_, err = Render(cfg)
+	assert.NoError(t, err, "a single color ignores the direction")
+
+	cfg = testConfig()
+	cfg.Direction = "sideways"
+	_, err = Render(cfg)
+	assert.ErrorContains(t, err, "unknown direction")
+}
@@ -0,0 +1,150 @@
+package banner
+
+import (
+	"fmt"
+	"html"
+	"image/color"
+	"io"
+	"strings"
+
+	uv "github.com/charmbracelet/ultraviolet"
+	"github.com/charmbracelet/x/ansi"
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// Format is a file format Export writes banners in.
+type Format string
+
+const (
+	// FormatHTML is a <pre> block with a colored <span> per run of cells,
+	// for web pages and READMEs that allow inline HTML.
+	FormatHTML Format = "html"
+	// FormatSVG is a standalone SVG image with a colored rectangle per
+	// character cell, which reads as pixel art at any size.
+	FormatSVG Format = "svg"
+	// FormatANSI is the art as shown in the terminal, with ANSI colors, for
+	// cat-ing to a terminal or embedding in a shell script.
+	FormatANSI Format = "ansi"
+)
+
+// Size of a character cell in an SVG export, in pixels. Terminal cells are
+// about twice as tall as wide.
+const (
+	svgCellWidth  = 8
+	svgCellHeight = 16
+)
+
+// Export renders cfg and writes it to w in format. The art is rendered for
+// the terminal and converted, so every Direction and Colorizer comes out as
+// it would on screen; cfg.Parser is ignored.
+func Export(cfg Config, format Format, w io.Writer) error {
+	switch format {
+	case FormatHTML, FormatSVG, FormatANSI:
+	default:
+		return fmt.Errorf("banner: unknown export format %q (use html, svg or ansi)", format)
+	}
+	cfg.Parser = "terminal-color"
+	art, err := Render(cfg)
+	if err != nil {
+		return err
+	}
+	switch format {
+	case FormatHTML:
+		_, err = io.WriteString(w, exportHTML(artCells(art)))
+	case FormatSVG:
+		_, err = io.WriteString(w, exportSVG(artCells(art)))
+	default:
+		_, err = io.WriteString(w, art)
+	}
+	return err
+}
+
+// artCells splits colored art into lines of cells, leaving out the blank
+// lines figlet pads it with.
+func artCells(art string) []uv.Line {
+	rows, _ := artSize(ansi.Strip(art))
+	lines := uv.NewStyledString(art).Lines(ansi.GraphemeWidth)
+	return lines[:min(rows, len(lines))]
+}
+
+// hexColor returns c as "#rrggbb", or "" for no color.
+func hexColor(c color.Color) string {
+	if c == nil {
+		return ""
+	}
+	cf, ok := colorful.MakeColor(c)
+	if !ok {
+		return ""
+	}
+	return cf.Clamped().Hex()
+}
+
+// exportHTML writes lines as a <pre> block. Runs of cells in the same color
+// share a <span>; uncolored cells are left to the page's text color.
+func exportHTML(lines []uv.Line) string {
+	var b strings.Builder
+	b.WriteString(`<pre style="font-family: monospace; line-height: 1.1">`)
+	for i, line := range lines {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		var run strings.Builder
+		runColor := ""
+		flush := func() {
+			if run.Len() == 0 {
+				return
+			}
+			if runColor == "" {
+				b.WriteString(html.EscapeString(run.String()))
+			} else {
+				fmt.Fprintf(&b, `<span style="color: %s">%s</span>`, runColor, html.EscapeString(run.String()))
+			}
+			run.Reset()
+		}
+		for _, cell := range line {
+			if cell.Width == 0 {
+				continue // the second half of a wide character
+			}
+			content := cell.Content
+			if content == "" {
+				content = " "
+			}
+			c := hexColor(cell.Style.Fg)
+			if strings.TrimSpace(content) == "" {
+				c = runColor // spaces join the run around them
+			}
+			if c != runColor {
+				flush()
+				runColor = c
+			}
+			run.WriteString(content)
+		}
+		flush()
+	}
+	b.WriteString("</pre>\n")
+	return b.String()
+}
+
+// exportSVG draws a rectangle for every non-blank cell of lines, in its
+// color, or in the image's current color when it has none.
+func exportSVG(lines []uv.Line) string {
+	var rects strings.Builder
+	width := 0 // in cells, up to the last non-blank one
+	for y, line := range lines {
+		for x, cell := range line {
+			if strings.TrimSpace(cell.Content) == "" {
+				continue
+			}
+			fill := hexColor(cell.Style.Fg)
+			if fill == "" {
+				fill = "currentColor"
+			}
+			fmt.Fprintf(&rects, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"/>`+"\n",
+				x*svgCellWidth, y*svgCellHeight, cell.Width*svgCellWidth, svgCellHeight, fill)
+			width = max(width, x+cell.Width)
+		}
+	}
+	w, h := width*svgCellWidth, len(lines)*svgCellHeight
+	return fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n", w, h, w, h) +
+		rects.String() + "</svg>\n"
+}
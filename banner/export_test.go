@@ -0,0 +1,37 @@
+package banner
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	cfg := testConfig()
+	export := func(f Format) string {
+		var b bytes.Buffer
+		require.NoError(t, Export(cfg, f, &b))
+		return b.String()
+	}
+
+	art, err := Render(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, art, export(FormatANSI))
+
+	page := export(FormatHTML)
+	assert.True(t, strings.HasPrefix(page, "<pre"))
+	assert.Contains(t, page, `<span style="color: #ff0000">| | | (</span><span style="color: #00ff00">_)</span>`,
+		"runs of a color share a span")
+	assert.Equal(t, 5, strings.Count(page, "\n"), "the blank padding line is left out")
+
+	svg := export(FormatSVG)
+	assert.Contains(t, svg, `width="72" height="80"`)
+	assert.Contains(t, svg, `<rect x="8" y="0" width="8" height="16" fill="#ff0000"/>`)
+	assert.Contains(t, svg, `fill="#00ff00"`)
+
+	var b bytes.Buffer
+	assert.Error(t, Export(cfg, "png", &b))
+}
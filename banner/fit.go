@@ -0,0 +1,41 @@
+package banner
+
+import (
+	"errors"
+
+	"charm.land/lipgloss/v2"
+)
+
+// FitFonts are the fonts RenderFit falls back to by default, from the
+// roomiest to the most condensed.
+var FitFonts = []string{"small", "mini", "term"}
+
+// ErrTooWide is returned by RenderFit when no font renders the text within
+// the width.
+var ErrTooWide = errors.New("banner: text does not fit the width in any font")
+
+// RenderFit renders cfg in cfg.Font or, when that is wider than width, in the
+// first of fallbacks that fits, and returns the art with the font used. An
+// empty cfg.Font is first resolved to a random font from cfg.FontPool, as
+// Render does, so the font returned is always a real name. cfg.Width should
+// leave the art room to reach its natural width, so that it is measured
+// rather than wrapped. When nothing fits it returns ErrTooWide, and callers
+// show the text plain.
+func RenderFit(cfg Config, width int, fallbacks ...string) (art, font string, err error) {
+	if cfg.Font == "" {
+		if cfg.Font, err = randomFont(cfg.FontPool); err != nil {
+			return "", "", err
+		}
+	}
+	for _, f := range append([]string{cfg.Font}, fallbacks...) {
+		cfg.Font = f
+		art, err := Render(cfg)
+		if err != nil {
+			return "", "", err
+		}
+		if lipgloss.Width(art) <= width {
+			return art, f, nil
+		}
+	}
+	return "", "", ErrTooWide
+}
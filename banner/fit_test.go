@@ -0,0 +1,47 @@
+package banner
+
+import (
+	"testing"
+
+	"charm.land/lipgloss/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderFit(t *testing.T) {
+	cfg := testConfig()
+	cfg.Text = "Scaffold"
+	cfg.Font = "larry3d"
+	cfg.Width = 200
+	full, err := Render(cfg)
+	require.NoError(t, err)
+	fullW := lipgloss.Width(full)
+
+	art, font, err := RenderFit(cfg, fullW, FitFonts...)
+	require.NoError(t, err)
+	assert.Equal(t, "larry3d", font, "the configured font is kept when it fits")
+	assert.Equal(t, full, art)
+
+	art, font, err = RenderFit(cfg, fullW-1, FitFonts...)
+	require.NoError(t, err)
+	assert.NotEqual(t, "larry3d", font, "a narrower font is tried next")
+	assert.LessOrEqual(t, lipgloss.Width(art), fullW-1)
+
+	_, _, err = RenderFit(cfg, 3, FitFonts...)
+	assert.ErrorIs(t, err, ErrTooWide)
+}
+
+func TestRenderFit_NamesRandomFont(t *testing.T) {
+	cfg := testConfig()
+	cfg.Text = "Hi"
+	cfg.Font = ""
+	cfg.Width = 200
+
+	art, font, err := RenderFit(cfg, 200)
+	require.NoError(t, err)
+	assert.Contains(t, SafeFonts, font, "the font picked is returned, not \"\"")
+	cfg.Font = font
+	want, err := Render(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, want, art)
+}
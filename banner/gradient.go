@@ -0,0 +1,74 @@
+package banner
+
+import (
+	"image/color"
+
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// GradientBuilder builds a Gradient from a few color stops, filling in the
+// colors between them. Create one with NewGradient.
+type GradientBuilder struct {
+	name  string
+	stops []color.Color
+}
+
+// NewGradient starts a gradient running through stops, in order, such as a
+// user's two favourite colors:
+//
+//	cfg.Gradient = banner.NewGradient(from, to).Steps(7)
+func NewGradient(stops ...color.Color) GradientBuilder {
+	return GradientBuilder{name: "custom", stops: stops}
+}
+
+// Named returns b building gradients called name, "custom" by default.
+func (b GradientBuilder) Named(name string) GradientBuilder {
+	b.name = name
+	return b
+}
+
+// Steps returns the gradient of n colors blended in HCL through the stops,
+// the first and last color being the first and last stop, so that a
+// two-color gradient steps as evenly as the predefined ones. The stops are
+// spread evenly along the gradient, as in theme ramps. n defaults to 7;
+// without stops the gradient is a single neutral gray.
+func (b GradientBuilder) Steps(n int) *Gradient {
+	if n <= 0 {
+		n = 7
+	}
+	if len(b.stops) == 0 {
+		return &Gradient{Name: b.name, Colors: []string{"888888"}}
+	}
+	return GradientFromColors(b.name, rampThrough(n, b.stops))
+}
+
+// rampThrough returns n colors blended in HCL through stops, the first and
+// last being the first and last stop.
+func rampThrough(n int, stops []color.Color) []color.Color {
+	out := make([]color.Color, n)
+	for i := range out {
+		if len(stops) == 1 || n == 1 {
+			out[i] = stops[0]
+			continue
+		}
+		// Position along the ramp, in units of stop-to-stop segments.
+		pos := float64(i) / float64(n-1) * float64(len(stops)-1)
+		seg := min(int(pos), len(stops)-2)
+		out[i] = blendHcl(stops[seg], stops[seg+1], pos-float64(seg))
+	}
+	return out
+}
+
+// blendHcl blends a into b by t in HCL, or returns b when either cannot be
+// converted.
+func blendHcl(a, b color.Color, t float64) color.Color {
+	if a == nil || b == nil {
+		return b
+	}
+	ca, okA := colorful.MakeColor(a)
+	cb, okB := colorful.MakeColor(b)
+	if !okA || !okB {
+		return b
+	}
+	return ca.BlendHcl(cb, t).Clamped()
+}
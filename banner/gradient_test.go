@@ -0,0 +1,27 @@
+package banner
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGradient_Steps(t *testing.T) {
+	red := color.RGBA{R: 0xFF, A: 0xFF}
+	blue := color.RGBA{B: 0xFF, A: 0xFF}
+
+	g := NewGradient(red, blue).Steps(5)
+	assert.Equal(t, "custom", g.Name)
+	assert.Len(t, g.Colors, 5)
+	assert.Equal(t, "ff0000", g.Colors[0], "starts on the first stop")
+	assert.Equal(t, "0000ff", g.Colors[4], "ends on the last stop")
+	assert.NotContains(t, g.Colors[1:4], "ff0000", "the steps in between are blended")
+
+	g = NewGradient(red, color.White, blue).Named("flag").Steps(3)
+	assert.Equal(t, &Gradient{Name: "flag", Colors: []string{"ff0000", "ffffff", "0000ff"}}, g,
+		"the middle stop is passed through")
+
+	assert.Len(t, NewGradient(red, blue).Steps(0).Colors, 7, "steps default to 7")
+	assert.Equal(t, []string{"888888"}, NewGradient().Steps(4).Colors)
+}
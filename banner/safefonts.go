@@ -0,0 +1,51 @@
+package banner
+
+import (
+	"fmt"
+	"math/rand/v2"
+)
+
+// FontPool selects the fonts a random font is picked from when Config.Font
+// is empty.
+type FontPool string
+
+const (
+	// FontPoolSafe picks from SafeFonts. It is the default.
+	FontPoolSafe FontPool = "safe"
+	// FontPoolAll picks from every font embedded in figlet-go, including
+	// ones that leave out letters or digits, draw another script, or are too
+	// large for a terminal.
+	FontPoolAll FontPool = "all"
+)
+
+// SafeFonts are the fonts a random banner is picked from by default: Latin
+// fonts with a glyph for every printable ASCII character, legible even for
+// one or two letters, and of a size that fits a terminal header. Like every
+// figlet font they have no glyphs beyond ASCII, so text with other
+// characters is garbled in any of them.
+var SafeFonts = []string{
+	"3-d", "alligator", "alligator2", "avatar", "banner", "banner3",
+	"banner3-D", "banner4", "basic", "big", "bigchief", "block", "bulbhead",
+	"chunky", "colossal", "computer", "cosmic", "cricket", "doom", "epic",
+	"fender", "fuzzy", "gothic", "graffiti", "kban", "larry3d", "lean",
+	"lockergnome", "nancyj", "nancyj-fancy", "ogre", "pebbles", "poison",
+	"puffy", "rectangles", "roman", "rounded", "rozzo", "sblood", "shadow",
+	"slant", "small", "smshadow", "smslant", "speed", "standard", "starwars",
+	"stop", "thick", "univers",
+}
+
+// RandomSafeFont returns a randomly selected font from SafeFonts.
+func RandomSafeFont() string {
+	return SafeFonts[rand.IntN(len(SafeFonts))]
+}
+
+// randomFont returns a random font from pool.
+func randomFont(pool FontPool) (string, error) {
+	switch pool {
+	case "", FontPoolSafe:
+		return RandomSafeFont(), nil
+	case FontPoolAll:
+		return RandomFont(), nil
+	}
+	return "", fmt.Errorf("banner: unknown font pool %q (use safe or all)", pool)
+}
@@ -0,0 +1,36 @@
+package banner
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSafeFonts_CoverPrintableASCII(t *testing.T) {
+	fonts := Fonts()
+	for _, font := range SafeFonts {
+		require.True(t, slices.Contains(fonts, font), "%s is embedded", font)
+		for c := '!'; c <= '~'; c++ {
+			art, err := Render(Config{Text: string(c), Font: font, Parser: "terminal", Width: 200})
+			require.NoError(t, err)
+			assert.NotEmpty(t, strings.TrimSpace(art), "%s has a glyph for %q", font, c)
+		}
+	}
+}
+
+func TestRender_FontPool(t *testing.T) {
+	for range 20 {
+		_, err := Render(Config{Text: "Hi", Parser: "terminal"})
+		require.NoError(t, err)
+	}
+	assert.Contains(t, SafeFonts, RandomSafeFont())
+
+	_, err := Render(Config{Text: "Hi", FontPool: FontPoolAll, Parser: "terminal"})
+	require.NoError(t, err)
+
+	_, err = Render(Config{Text: "Hi", FontPool: "tiny"})
+	assert.ErrorContains(t, err, `unknown font pool "tiny"`)
+}
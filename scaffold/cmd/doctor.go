@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+
+	"github.com/charmbracelet/x/term"
+	"github.com/spf13/cobra"
+
+	"scaffold/config"
+	"scaffold/internal/version"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Diagnose the terminal and configuration",
+	Long: `Doctor prints what scaffold knows about its environment: the
+configuration file, the terminal it runs in, and the environment variables
+that influence colors and key handling.
+
+Doctor cannot talk to the terminal itself. To see what the terminal reports
+about its colors, keyboard, mouse and clipboard support, open the capability
+report, which can be copied into a bug report:
+
+  scaffold --screen capabilities
+
+When a key binding does not fire, run the key debugger to see exactly which
+key events your terminal sends:
+
+  scaffold --screen key-debug`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runDoctor(cmd.OutOrStdout())
+	},
+	PreRun: func(cmd *cobra.Command, args []string) {
+		// Disable UI execution for this subcommand
+		runUI = false
+	},
+}
+
+// doctorEnv lists the environment variables reported by doctor.
+var doctorEnv = []string{"TERM", "COLORTERM", "TERM_PROGRAM", "TMUX", "NO_COLOR", "LANG", "LC_ALL"}
+
+func runDoctor(w io.Writer) {
+	path := GetConfigFile()
+	status := "ok"
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		status = "not found, using defaults"
+	} else if _, err := config.Load(path); err != nil {
+		status = "error: " + err.Error()
+	}
+
+	fmt.Fprintf(w, "scaffold v%s\n\n", version.Version)
+	fmt.Fprintf(w, "Config\n  %s (%s)\n\n", path, status)
+
+	fmt.Fprintln(w, "Terminal")
+	fmt.Fprintf(w, "  %-12s %t\n", "stdin tty", term.IsTerminal(os.Stdin.Fd()))
+	fmt.Fprintf(w, "  %-12s %t\n", "stdout tty", term.IsTerminal(os.Stdout.Fd()))
+	if width, height, err := term.GetSize(os.Stdout.Fd()); err == nil {
+		fmt.Fprintf(w, "  %-12s %dx%d\n", "size", width, height)
+	}
+	for _, name := range doctorEnv {
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			value = "(unset)"
+		}
+		fmt.Fprintf(w, "  %-12s %s\n", name, value)
+	}
+
+	fmt.Fprintln(w, "\nFor what the terminal itself reports (colors, keyboard, mouse,")
+	fmt.Fprintln(w, "clipboard), open the capability report and press c to copy it:")
+	fmt.Fprintln(w, "  scaffold --screen capabilities")
+
+	fmt.Fprintln(w, "\nKey bindings not firing? Run the key debugger to see the raw")
+	fmt.Fprintln(w, "key events your terminal sends (press esc twice to leave):")
+	fmt.Fprintln(w, "  scaffold --screen key-debug")
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"scaffold/config"
+	"scaffold/internal/features"
+	"scaffold/internal/plugin"
+)
+
+var pluginsCmd = &cobra.Command{
+	Use:   "plugins",
+	Short: "List installed plugins",
+	Long: `Plugins lists the plugins in the plugins directory next to the config
+file, with the screens, commands and status segment each contributes.
+
+A plugin is a directory holding a plugin.json manifest and the program it
+runs. Its screens appear in the home menu, its commands as scaffold
+subcommands, and its status segment in the status bar. Turn plugins off
+with SCAFFOLD_FEATURES=-plugins.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load(GetConfigFile())
+		if err != nil {
+			cfg = config.DefaultConfig()
+		}
+		runPlugins(cmd.OutOrStdout(), PluginsDir(), pluginsEnabled(cfg))
+	},
+	PreRun: func(cmd *cobra.Command, args []string) {
+		// Disable UI execution for this subcommand
+		runUI = false
+	},
+}
+
+// PluginsDir returns the directory plugins are installed in, next to the
+// config file.
+func PluginsDir() string {
+	return filepath.Join(filepath.Dir(GetConfigFile()), "plugins")
+}
+
+// pluginsEnabled reports whether the plugins feature flag is on in cfg or
+// $SCAFFOLD_FEATURES. It is for commands, which run before main loads the
+// flags.
+func pluginsEnabled(cfg *config.Config) bool {
+	name := features.Plugins.Name()
+	on := features.Plugins.Default()
+	if v, ok := cfg.Features[name]; ok {
+		on = v
+	}
+	env, _ := features.ParseEnv(os.Getenv(features.EnvVar))
+	if v, ok := env[name]; ok {
+		on = v
+	}
+	return on
+}
+
+func runPlugins(w io.Writer, dir string, enabled bool) {
+	manifests, err := plugin.Discover(dir)
+	fmt.Fprintf(w, "Plugins in %s\n", dir)
+	if !enabled {
+		fmt.Fprintln(w, "  (disabled by the plugins feature flag)")
+	}
+	if err != nil {
+		fmt.Fprintf(w, "  error: %v\n", err)
+	}
+	if len(manifests) == 0 {
+		fmt.Fprintln(w, "  none installed")
+		return
+	}
+	for _, m := range manifests {
+		fmt.Fprintf(w, "\n  %s %s\n", m.Name, m.Version)
+		if m.Description != "" {
+			fmt.Fprintf(w, "    %s\n", m.Description)
+		}
+		for _, s := range m.Screens {
+			fmt.Fprintf(w, "    %-10s %s\n", "screen", s.Title)
+		}
+		for _, c := range m.Commands {
+			name := c.Name
+			if isBuiltinCommand(name) {
+				name += " (hidden by the built-in command)"
+			}
+			fmt.Fprintf(w, "    %-10s scaffold %s\n", "command", name)
+		}
+		if m.Status {
+			fmt.Fprintf(w, "    %-10s yes\n", "status")
+		}
+	}
+}
+
+// builtinCommands is filled before plugin commands are added, so a plugin
+// cannot replace version, theme and the rest.
+var builtinCommands = map[string]bool{}
+
+func isBuiltinCommand(name string) bool { return builtinCommands[name] }
+
+// addPluginCommands registers the plugins' CLI commands on the root
+// command. It runs before the command line is parsed, so --config is not
+// known yet: plugins are looked for next to the default config file, and
+// the plugins feature flag is read from that file and $SCAFFOLD_FEATURES.
+func addPluginCommands() {
+	for _, c := range rootCmd.Commands() {
+		builtinCommands[c.Name()] = true
+	}
+	builtinCommands["help"] = true
+	builtinCommands["completion"] = true
+
+	cfg, err := config.Load(config.DefaultConfigPath())
+	if err != nil {
+		cfg = config.DefaultConfig()
+	}
+	if !pluginsEnabled(cfg) {
+		return
+	}
+	manifests, _ := plugin.Discover(filepath.Join(filepath.Dir(config.DefaultConfigPath()), "plugins"))
+	for _, m := range manifests {
+		for _, spec := range m.Commands {
+			if isBuiltinCommand(spec.Name) {
+				continue
+			}
+			rootCmd.AddCommand(pluginCommand(m, spec))
+		}
+	}
+}
+
+// pluginCommand runs spec by starting the plugin with "run <name>" and the
+// remaining arguments, on the terminal's stdin, stdout and stderr. Flags are
+// passed through untouched for the plugin to parse.
+func pluginCommand(m plugin.Manifest, spec plugin.CommandSpec) *cobra.Command {
+	short := spec.Short
+	if short == "" {
+		short = "Run the " + m.Name + " plugin's " + spec.Name + " command"
+	}
+	return &cobra.Command{
+		Use:                spec.Name,
+		Short:              short,
+		Long:               strings.TrimSpace(spec.Long),
+		DisableFlagParsing: true,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			// Disable UI execution for this subcommand
+			runUI = false
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := m.Cmd(append([]string{"run", spec.Name}, args...)...)
+			c.Stdin, c.Stdout, c.Stderr = os.Stdin, cmd.OutOrStdout(), cmd.ErrOrStderr()
+			if err := c.Run(); err != nil {
+				return fmt.Errorf("plugin %s: %w", m.Name, err)
+			}
+			return nil
+		},
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(pluginsCmd)
+}
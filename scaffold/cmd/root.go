@@ -3,7 +3,10 @@
 package cmd
 
 import (
+	"os"
+
 	"scaffold/config"
+	"scaffold/internal/version"
 
 	"github.com/spf13/cobra"
 )
@@ -18,9 +21,24 @@ var (
 	// skipWelcome suppresses the first-run welcome screen.
 	skipWelcome bool
 
+	// noColor disables colors, as does a non-empty $NO_COLOR.
+	noColor bool
+
+	// startScreen is the route the TUI opens on, e.g. "detail?id=about".
+	startScreen string
+
 	// logLevel sets the logging verbosity.
 	logLevel string
 
+	// snapshotDir is the directory deterministic snapshot frames are written to.
+	snapshotDir string
+
+	// snapshotCompare is the directory of golden frames to compare against.
+	snapshotCompare string
+
+	// tour runs the walkthrough of every screen.
+	tour bool
+
 	// runUI indicates whether to run the TUI after command execution.
 	// This is set to false when running subcommands like version or completion.
 	runUI = true
@@ -49,9 +67,37 @@ This template includes:
   # Run with debug logging
   scaffold --debug --log-level trace
 
+  # Open straight on a screen (or set SCAFFOLD_SCREEN)
+  scaffold --screen settings
+  scaffold --screen 'detail?id=about'
+
+  # Render without colors (or set NO_COLOR)
+  scaffold --no-color
+
+  # Turn experimental feature flags on or off (f9 lists them)
+  SCAFFOLD_FEATURES=animated-banner,-transitions scaffold
+
+  # Walk through every screen (space skips ahead, esc ends the tour)
+  scaffold --tour
+
+  # Diagnose terminal and key handling problems
+  scaffold doctor
+
+  # Export the configured theme's palette for other tools
+  scaffold theme export --format css
+
+  # List installed plugins and what they add
+  scaffold plugins
+
+  # Install the latest release in place of this binary
+  scaffold update
+
+  # Export local usage stats to attach to a bug report
+  scaffold stats export
+
   # Show version information
   scaffold version`,
-	Version: "1.0.0",
+	Version: version.Version,
 	// Run executes the root command.
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// The actual TUI application will be run from the main package
@@ -61,8 +107,10 @@ This template includes:
 }
 
 // Execute runs the root command. This is called from main.go.
-// It returns an error if the command fails.
+// It returns an error if the command fails. Installed plugins' commands are
+// added first.
 func Execute() error {
+	addPluginCommands()
 	return rootCmd.Execute()
 }
 
@@ -100,9 +148,29 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&skipWelcome, "skip-welcome", false,
 		"Skip the first-run welcome screen")
 
+	// No color flag
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false,
+		"Render without colors, using bold and underline only (env: NO_COLOR)")
+
+	// Start screen flag
+	rootCmd.PersistentFlags().StringVar(&startScreen, "screen", "",
+		"Open the TUI on this screen, e.g. settings or 'detail?id=about' (env: "+startScreenEnv+")")
+
 	// Log level flag
 	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "info",
 		"Set logging level (trace, debug, info, warn, error, fatal)")
+
+	// Tour flag
+	rootCmd.Flags().BoolVar(&tour, "tour", false,
+		"Walk through every screen with narration, advancing on its own")
+
+	// Snapshot flags are hidden: they exist for visual regression CI, not users.
+	rootCmd.Flags().StringVar(&snapshotDir, "snapshot-dir", "",
+		"Render deterministic frames as plain text into this directory and exit")
+	rootCmd.Flags().StringVar(&snapshotCompare, "snapshot-compare", "",
+		"Render deterministic frames and compare them against golden files in this directory")
+	_ = rootCmd.Flags().MarkHidden("snapshot-dir")
+	_ = rootCmd.Flags().MarkHidden("snapshot-compare")
 }
 
 // GetConfigFile returns the path to the configuration file, computing default if needed.
@@ -123,6 +191,40 @@ func SkipWelcome() bool {
 	return skipWelcome
 }
 
+// NoColor reports whether colors are disabled: --no-color was passed or
+// $NO_COLOR is set to a non-empty value (see https://no-color.org).
+func NoColor() bool {
+	return noColor || os.Getenv("NO_COLOR") != ""
+}
+
+// startScreenEnv names the environment variable consulted when --screen is
+// not passed.
+const startScreenEnv = "SCAFFOLD_SCREEN"
+
+// StartScreen returns the route to open on: the --screen flag when passed,
+// else $SCAFFOLD_SCREEN, else "".
+func StartScreen() string {
+	if rootCmd.PersistentFlags().Changed("screen") {
+		return startScreen
+	}
+	return os.Getenv(startScreenEnv)
+}
+
+// Tour reports whether the --tour flag was passed.
+func Tour() bool {
+	return tour
+}
+
+// SnapshotDir returns the --snapshot-dir value, or "" when snapshot mode is off.
+func SnapshotDir() string {
+	return snapshotDir
+}
+
+// SnapshotCompare returns the --snapshot-compare golden directory, or "".
+func SnapshotCompare() string {
+	return snapshotCompare
+}
+
 // WasLogLevelSet reports whether --log-level was explicitly passed on the command line.
 // Use this to distinguish an explicit flag from Cobra's default value.
 func WasLogLevelSet() bool {
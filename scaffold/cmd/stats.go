@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"scaffold/internal/stats"
+	"scaffold/internal/version"
+)
+
+// statsOutput is the file stats export writes to; "" means stdout.
+var statsOutput string
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Work with local usage stats",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		// Disable UI execution for this subcommand
+		runUI = false
+	},
+}
+
+var statsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export local usage stats as JSON for a bug report",
+	Long: `Export prints the usage stats scaffold keeps on this machine as a JSON
+report you can attach to an issue: how many sessions there were, how many
+iterations (key presses, clicks, scrolls and pastes) were handled, which
+screens were visited, and how many errors were shown on each, along with
+the version and platform.
+
+Nothing is sent anywhere, by this command or by scaffold itself. Stats are
+stored in stats.json next to the config file and contain counts only, no
+screen contents, ids or error messages. Delete the file to reset them, or
+set app.disableStats in the config to stop recording.`,
+	Example: `  scaffold stats export
+  scaffold stats export -o scaffold-stats.json`,
+	Args: cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		// Disable UI execution for this subcommand
+		runUI = false
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		s, err := stats.Load(filepath.Join(filepath.Dir(GetConfigFile()), stats.File))
+		if err != nil {
+			return err
+		}
+		if s.Sessions == 0 {
+			fmt.Fprintln(cmd.ErrOrStderr(), "no usage has been recorded yet")
+		}
+		out, err := json.MarshalIndent(stats.NewReport(s, version.Version, time.Now().UTC()), "", "  ")
+		if err != nil {
+			return err
+		}
+		out = append(out, '\n')
+		if statsOutput == "" {
+			_, err = cmd.OutOrStdout().Write(out)
+			return err
+		}
+		return os.WriteFile(statsOutput, out, 0o644)
+	},
+}
+
+func init() {
+	statsExportCmd.Flags().StringVarP(&statsOutput, "output", "o", "",
+		"Write to this file instead of stdout")
+	statsCmd.AddCommand(statsExportCmd)
+	rootCmd.AddCommand(statsCmd)
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"scaffold/config"
+	"scaffold/internal/ui/theme"
+)
+
+var (
+	// exportFormat is the --format of theme export.
+	exportFormat string
+
+	// exportLight exports the light variant instead of the dark one.
+	exportLight bool
+
+	// exportOutput is the file theme export writes to; "" means stdout.
+	exportOutput string
+)
+
+var themeCmd = &cobra.Command{
+	Use:   "theme",
+	Short: "Work with color themes",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		// Disable UI execution for this subcommand
+		runUI = false
+	},
+}
+
+var themeExportCmd = &cobra.Command{
+	Use:   "export [theme]",
+	Short: "Export a theme's full palette as JSON, CSS or base16",
+	Long: `Export prints every color of a theme's palette, including the colors
+scaffold computes from the theme (OnPrimary, ForegroundMuted, Border and
+so on), so the palette can be reused in other tools and documentation.
+
+The theme defaults to the configured one. Custom themes in the themes
+directory next to the config file can be exported too. The colorblind-safe
+status colors are used when enabled in the config.
+
+Formats:
+  json    {"primary": "#…", "onPrimary": "#…", …}
+  css     :root { --primary: #…; --on-primary: #…; … }
+  base16  a tinted-theming base16 scheme, which scaffold can import again`,
+	Example: `  scaffold theme export --format css > palette.css
+  scaffold theme export ocean --light --format base16 -o ocean-light.yaml`,
+	Args: cobra.MaximumNArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		// Disable UI execution for this subcommand
+		runUI = false
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg := config.DefaultConfig()
+		if fileCfg, err := config.Load(GetConfigFile()); err == nil {
+			cfg = fileCfg
+		}
+		// Custom themes live next to the config file, as in the TUI.
+		specs, _ := theme.LoadThemeDir(filepath.Join(filepath.Dir(GetConfigFile()), "themes"))
+		for _, spec := range specs {
+			theme.RegisterTheme(spec)
+		}
+
+		name := cfg.UI.ThemeName
+		if len(args) > 0 {
+			name = args[0]
+		}
+		if available := theme.AvailableThemes(); !slices.Contains(available, name) {
+			return fmt.Errorf("unknown theme %q (available: %s)", name, strings.Join(available, ", "))
+		}
+		isDark := !exportLight
+		p := theme.NewPalette(name, isDark)
+		if cfg.UI.ColorblindSafe {
+			p = theme.ColorblindSafeStatus(p, isDark)
+		}
+
+		out, err := theme.ExportPalette(p, theme.ExportFormat(exportFormat))
+		if err != nil {
+			return err
+		}
+		if exportOutput == "" {
+			_, err = cmd.OutOrStdout().Write(out)
+			return err
+		}
+		return os.WriteFile(exportOutput, out, 0o644)
+	},
+}
+
+func init() {
+	themeExportCmd.Flags().StringVarP(&exportFormat, "format", "f", string(theme.ExportJSON),
+		"Output format: json, css or base16")
+	themeExportCmd.Flags().BoolVar(&exportLight, "light", false,
+		"Export the light variant instead of the dark one")
+	themeExportCmd.Flags().StringVarP(&exportOutput, "output", "o", "",
+		"Write to this file instead of stdout")
+	themeCmd.AddCommand(themeExportCmd)
+	rootCmd.AddCommand(themeCmd)
+}
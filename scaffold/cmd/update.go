@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"scaffold/config"
+	"scaffold/internal/update"
+	"scaffold/internal/version"
+)
+
+// updateCheckOnly makes update report the latest release without
+// installing it.
+var updateCheckOnly bool
+
+var updateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update scaffold to the latest release",
+	Long: `Update installs the latest GitHub release of scaffold in place of the
+running binary, when it is newer than this one. The installed version is
+the one this binary was built with, as printed by "scaffold version".
+
+The download is checked against the release's checksums.txt, and when this
+build embeds a signing key, checksums.txt is checked against its signature
+first. The new binary is swapped in with a rename, so an interrupted update
+leaves the old one working.
+
+Releases come from the repository set in the config's app.updateRepo.
+Installs managed by a package manager should set app.disableUpdates, which
+turns this command off. A config that cannot be read stops the update
+rather than falling back to the defaults.`,
+	Example: `  scaffold update --check
+  scaffold update`,
+	Args: cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		// Disable UI execution for this subcommand
+		runUI = false
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadUpdateConfig(GetConfigFile())
+		if err != nil {
+			return err
+		}
+		if cfg.App.DisableUpdates {
+			return errors.New("updates are disabled (app.disableUpdates in the config)")
+		}
+		if cfg.App.UpdateRepo == "" {
+			return errors.New("no release repository configured (set app.updateRepo in the config)")
+		}
+		u, err := update.New(cfg.App.UpdateRepo, rootCmd.Name())
+		if err != nil {
+			return err
+		}
+		exe, err := os.Executable()
+		if err != nil {
+			return err
+		}
+		if exe, err = filepath.EvalSymlinks(exe); err != nil {
+			return err
+		}
+		return runUpdate(cmd.Context(), cmd.OutOrStdout(), cmd.ErrOrStderr(), u, version.Version, exe)
+	},
+}
+
+// loadUpdateConfig loads the config at path, or the defaults when there is
+// none. Any other error is returned: a config that cannot be read may be the
+// one setting app.disableUpdates, so update must not carry on without it.
+func loadUpdateConfig(path string) (*config.Config, error) {
+	cfg, err := config.Load(path)
+	if errors.Is(err, config.ErrConfigNotFound) {
+		return config.DefaultConfig(), nil
+	}
+	return cfg, err
+}
+
+// runUpdate installs the latest release of u over exe when it is newer than
+// installed, the version of the running build, or only reports it with
+// --check.
+func runUpdate(ctx context.Context, w, errw io.Writer, u update.Updater, installed, exe string) error {
+	release, err := u.Latest(ctx)
+	if err != nil {
+		return err
+	}
+	if !release.Newer(installed) {
+		fmt.Fprintf(w, "scaffold v%s is up to date (latest: %s)\n", installed, release.Tag)
+		return nil
+	}
+	fmt.Fprintf(w, "scaffold %s is available (installed: v%s)\n", release.Tag, installed)
+	if updateCheckOnly {
+		return nil
+	}
+	if u.PublicKey == nil {
+		fmt.Fprintln(errw, "warning: this build has no signing key; verifying the checksum only")
+	}
+	if err := u.Install(ctx, release, exe); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "updated %s to %s\n", exe, release.Tag)
+	return nil
+}
+
+func init() {
+	updateCmd.Flags().BoolVar(&updateCheckOnly, "check", false,
+		"Only report whether a newer release exists")
+	rootCmd.AddCommand(updateCmd)
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"scaffold/internal/update"
+)
+
+func TestRunUpdate_SecondRunIsNoOp(t *testing.T) {
+	u := update.Updater{Repo: "acme/tool", Binary: "tool"}
+	name := u.AssetName(runtime.GOOS, runtime.GOARCH)
+	bin := []byte("new")
+	sum := sha256.Sum256(bin)
+	downloads := 0
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	mux.HandleFunc("/repos/acme/tool/releases/latest", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"tag_name": "v1.2.0", "assets": [
+			{"name": %q, "browser_download_url": %q},
+			{"name": %q, "browser_download_url": %q}]}`,
+			name, srv.URL+"/dl/bin", update.ChecksumsFile, srv.URL+"/dl/sums")
+	})
+	mux.HandleFunc("/dl/bin", func(w http.ResponseWriter, r *http.Request) {
+		downloads++
+		_, _ = w.Write(bin)
+	})
+	mux.HandleFunc("/dl/sums", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s  %s\n", hex.EncodeToString(sum[:]), name)
+	})
+	u.BaseURL = srv.URL
+
+	exe := filepath.Join(t.TempDir(), "tool")
+	require.NoError(t, os.WriteFile(exe, []byte("old"), 0o755))
+
+	var out bytes.Buffer
+	require.NoError(t, runUpdate(context.Background(), &out, io.Discard, u, "1.0.0", exe))
+	assert.Contains(t, out.String(), "updated")
+	assert.Equal(t, 1, downloads)
+
+	// The installed binary reports the release's version, so running the
+	// update again finds nothing to do.
+	out.Reset()
+	require.NoError(t, runUpdate(context.Background(), &out, io.Discard, u, "1.2.0", exe))
+	assert.Equal(t, "scaffold v1.2.0 is up to date (latest: v1.2.0)\n", out.String())
+	assert.Equal(t, 1, downloads, "nothing is downloaded again")
+}
+
+func TestLoadUpdateConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	cfg, err := loadUpdateConfig(filepath.Join(dir, "missing.json"))
+	require.NoError(t, err, "no config means the defaults")
+	assert.False(t, cfg.App.DisableUpdates)
+
+	bad := filepath.Join(dir, "config.json")
+	require.NoError(t, os.WriteFile(bad, []byte(`{"app": {"disableUpdates": true},`), 0o644))
+	_, err = loadUpdateConfig(bad)
+	assert.Error(t, err, "a malformed config is not replaced by the defaults")
+}
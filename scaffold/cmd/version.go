@@ -6,7 +6,7 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"scaffold/config"
+	"scaffold/internal/version"
 )
 
 var versionCmd = &cobra.Command{
@@ -14,13 +14,7 @@ var versionCmd = &cobra.Command{
 	Short: "Print the version number",
 	Long:  `All software has versions. This one is no exception.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		cfg := config.DefaultConfig()
-		if cfgFile != "" {
-			if fileCfg, err := config.Load(cfgFile); err == nil {
-				cfg = fileCfg
-			}
-		}
-		fmt.Printf("scaffold v%s\n", cfg.App.Version)
+		fmt.Printf("scaffold v%s\n", version.Version)
 	},
 	PreRun: func(cmd *cobra.Command, args []string) {
 		// Disable UI execution for this subcommand
@@ -55,8 +55,19 @@ type Config struct {
 	// Notifications contains notification preferences.
 	Notifications NotificationsConfig `json:"notifications" mapstructure:"notifications" koanf:"notifications" cfg_label:"Notifications"`
 
+	// Breaks contains the break reminder settings.
+	Breaks BreaksConfig `json:"breaks" mapstructure:"breaks" koanf:"breaks" cfg_label:"Breaks"`
+
+	// Idle contains what happens when the TUI is left without input.
+	Idle IdleConfig `json:"idle" mapstructure:"idle" koanf:"idle" cfg_label:"Idle"`
+
 	// App contains general application configuration.
 	App AppConfig `json:"app" mapstructure:"app" koanf:"app" cfg_label:"Application" cfg_exclude:"true"`
+
+	// Features overrides feature flag defaults by name, e.g.
+	// {"animated-banner": true}. $SCAFFOLD_FEATURES takes precedence.
+	// Not shown in the settings UI; see the feature flags screen instead.
+	Features map[string]bool `json:"features,omitempty" mapstructure:"features" koanf:"features" cfg_exclude:"true"`
 }
 
 // UIConfig contains configuration specific to the user interface.
@@ -65,27 +76,60 @@ type UIConfig struct {
 	MouseEnabled bool `json:"mouseEnabled" mapstructure:"mouseEnabled" koanf:"mouseEnabled" cfg_default:"true" cfg_label:"Mouse Support" cfg_desc:"Enable mouse click and scroll events"`
 
 	// CompactMode reduces vertical spacing throughout the UI.
-	CompactMode bool `json:"compactMode" mapstructure:"compactMode" koanf:"compactMode" cfg_label:"Compact Mode" cfg_desc:"Reduce vertical spacing in lists and menus"`
+	CompactMode bool `json:"compactMode" mapstructure:"compactMode" koanf:"compactMode" cfg_label:"Compact Mode" cfg_desc:"Reduce vertical spacing throughout the UI"`
 
 	// OutputFormat controls how structured output is rendered.
 	OutputFormat string `json:"outputFormat" mapstructure:"outputFormat" koanf:"outputFormat" cfg_default:"text" cfg_label:"Output Format" cfg_desc:"Format for structured output" cfg_options:"text,json,table"`
 
-	// DateFormat is the Go time layout used when displaying dates.
-	DateFormat string `json:"dateFormat" mapstructure:"dateFormat" koanf:"dateFormat" cfg_default:"2006-01-02" cfg_label:"Date Format" cfg_desc:"Go time layout, e.g. 2006-01-02"`
+	// DateFormat is the Go time layout used when displaying dates, such as
+	// the day of a time that is not today.
+	DateFormat string `json:"dateFormat" mapstructure:"dateFormat" koanf:"dateFormat" cfg_default:"2006-01-02" cfg_label:"Date Format" cfg_desc:"Go time layout, e.g. 2006-01-02" cfg_validate:"required"`
+
+	// TimeStyle shows times relative to now ("2m ago", "in 42m") or on the
+	// clock ("15:30"), with 12- or 24-hour clocks following the locale.
+	TimeStyle string `json:"timeStyle" mapstructure:"timeStyle" koanf:"timeStyle" cfg_default:"relative" cfg_label:"Time Display" cfg_desc:"Show times relative to now (in 42m) or on the clock (at 15:30)" cfg_options:"relative,absolute"`
 
 	// ThemeName specifies the color theme to use.
 	ThemeName string `json:"themeName" mapstructure:"themeName" koanf:"themeName" cfg_default:"ember" cfg_label:"Color Theme" cfg_desc:"Visual theme for the application" cfg_options:"_themes"`
 
+	// Appearance forces the dark or light variant of the theme; auto follows
+	// the terminal's background color.
+	Appearance string `json:"appearance" mapstructure:"appearance" koanf:"appearance" cfg_default:"auto" cfg_label:"Appearance" cfg_desc:"Dark or light theme variant; auto follows the terminal background" cfg_options:"auto,dark,light"`
+
+	// ColorblindSafe replaces every theme's status colors with a set that
+	// stays distinguishable under common color vision deficiencies.
+	ColorblindSafe bool `json:"colorblindSafe" mapstructure:"colorblindSafe" koanf:"colorblindSafe" cfg_label:"Colorblind-Safe Status" cfg_desc:"Use status colors distinguishable with color vision deficiencies"`
+
 	// ShowBanner controls whether the ASCII art banner is shown in the header.
 	// When false, a styled plain-text title is rendered instead.
 	ShowBanner bool `json:"showBanner" mapstructure:"showBanner" koanf:"showBanner" cfg_default:"true" cfg_label:"ASCII Banner" cfg_desc:"Show ASCII art banner in header"`
 
+	// BannerAutoFit swaps the banner font for a more condensed one when the
+	// terminal is too narrow for it, instead of dropping the banner.
+	BannerAutoFit bool `json:"bannerAutoFit" mapstructure:"bannerAutoFit" koanf:"bannerAutoFit" cfg_default:"true" cfg_label:"Fit Banner to Width" cfg_desc:"Use a smaller font when the banner does not fit" cfg_visible_if:"ui.showBanner=true"`
+
 	// ShowDescription controls whether the app description is shown below the header.
 	ShowDescription bool `json:"showDescription" mapstructure:"showDescription" koanf:"showDescription" cfg_default:"true" cfg_label:"Show Description" cfg_desc:"Show app description below the header"`
 
+	// ReducedMotion shows animations as still frames: the banner stays put,
+	// and screen transitions and theme fades are skipped.
+	ReducedMotion bool `json:"reducedMotion" mapstructure:"reducedMotion" koanf:"reducedMotion" cfg_label:"Reduced Motion" cfg_desc:"Show the banner, transitions and theme changes without animation"`
+
+	// BannerAnimation selects how the banner moves while the animated-banner
+	// feature flag is on: its gradient scrolls, or it is typed in.
+	BannerAnimation string `json:"bannerAnimation" mapstructure:"bannerAnimation" koanf:"bannerAnimation" cfg_default:"scroll" cfg_label:"Banner Animation" cfg_desc:"How the banner moves (animated-banner feature flag)" cfg_options:"scroll,typewriter" cfg_visible_if:"ui.reducedMotion=false"`
+
 	// AnimationSpeed controls the speed of UI animations.
 	AnimationSpeed string `json:"animationSpeed" mapstructure:"animationSpeed" koanf:"animationSpeed" cfg_default:"normal" cfg_label:"Animation Speed" cfg_desc:"Speed of transitions and animations" cfg_options:"slow,normal,fast,none"`
 
+	// Transition selects the animation played when navigating between
+	// screens. Off by default; "none" also suits reduced-motion users.
+	Transition string `json:"transition" mapstructure:"transition" koanf:"transition" cfg_default:"none" cfg_label:"Screen Transition" cfg_desc:"Animation when opening or closing screens" cfg_options:"none,slide,fade"`
+
+	// StateStripe shows a line above the header colored by the app's global
+	// state, for apps that report one.
+	StateStripe bool `json:"stateStripe" mapstructure:"stateStripe" koanf:"stateStripe" cfg_label:"State Stripe" cfg_desc:"Color a line above the header by the app's state"`
+
 	// ShowHelpBar controls whether the persistent help bar is shown.
 	ShowHelpBar bool `json:"showHelpBar" mapstructure:"showHelpBar" koanf:"showHelpBar" cfg_default:"true" cfg_label:"Show Help Bar" cfg_desc:"Display keybinding hints at the bottom"`
 
@@ -96,10 +140,10 @@ type UIConfig struct {
 // EditorConfig contains editor-related configuration.
 type EditorConfig struct {
 	// EditorCommand is the command to launch the external editor.
-	EditorCommand string `json:"editorCommand" mapstructure:"editorCommand" koanf:"editorCommand" cfg_default:"vim" cfg_label:"Editor Command" cfg_desc:"External editor command (e.g., vim, nano, code)"`
+	EditorCommand string `json:"editorCommand" mapstructure:"editorCommand" koanf:"editorCommand" cfg_default:"vim" cfg_label:"Editor Command" cfg_desc:"External editor command (e.g., vim, nano, code)" cfg_validate:"required"`
 
 	// TabWidth is the number of spaces per tab.
-	TabWidth int `json:"tabWidth" mapstructure:"tabWidth" koanf:"tabWidth" cfg_default:"4" cfg_label:"Tab Width" cfg_desc:"Number of spaces per tab stop"`
+	TabWidth int `json:"tabWidth" mapstructure:"tabWidth" koanf:"tabWidth" cfg_default:"4" cfg_label:"Tab Width" cfg_desc:"Number of spaces per tab stop" cfg_validate:"min=1,max=16"`
 
 	// ExpandTabs converts tabs to spaces.
 	ExpandTabs bool `json:"expandTabs" mapstructure:"expandTabs" koanf:"expandTabs" cfg_default:"true" cfg_label:"Expand Tabs" cfg_desc:"Convert tabs to spaces"`
@@ -108,7 +152,7 @@ type EditorConfig struct {
 	AutoSave bool `json:"autoSave" mapstructure:"autoSave" koanf:"autoSave" cfg_label:"Auto Save" cfg_desc:"Automatically save changes"`
 
 	// AutoSaveInterval is the interval in seconds between auto-saves.
-	AutoSaveInterval int `json:"autoSaveInterval" mapstructure:"autoSaveInterval" koanf:"autoSaveInterval" cfg_default:"30" cfg_label:"Auto Save Interval" cfg_desc:"Seconds between auto-saves (if enabled)"`
+	AutoSaveInterval int `json:"autoSaveInterval" mapstructure:"autoSaveInterval" koanf:"autoSaveInterval" cfg_default:"30" cfg_label:"Auto Save Interval" cfg_desc:"Seconds between auto-saves (if enabled)" cfg_validate:"min=1" cfg_advanced:"true" cfg_visible_if:"editor.autoSave=true"`
 
 	// ShowLineNumbers displays line numbers in editors.
 	ShowLineNumbers bool `json:"showLineNumbers" mapstructure:"showLineNumbers" koanf:"showLineNumbers" cfg_default:"true" cfg_label:"Line Numbers" cfg_desc:"Show line numbers in text editors"`
@@ -117,19 +161,19 @@ type EditorConfig struct {
 // NetworkConfig contains network-related configuration.
 type NetworkConfig struct {
 	// APIEndpoint is the base URL for API requests.
-	APIEndpoint string `json:"apiEndpoint" mapstructure:"apiEndpoint" koanf:"apiEndpoint" cfg_default:"https://api.example.com" cfg_label:"API Endpoint" cfg_desc:"Base URL for API requests"`
+	APIEndpoint string `json:"apiEndpoint" mapstructure:"apiEndpoint" koanf:"apiEndpoint" cfg_default:"https://api.example.com" cfg_label:"API Endpoint" cfg_desc:"Base URL for API requests" cfg_validate:"required,url"`
 
 	// Timeout is the request timeout in seconds.
-	Timeout int `json:"timeout" mapstructure:"timeout" koanf:"timeout" cfg_default:"30" cfg_label:"Request Timeout" cfg_desc:"HTTP request timeout in seconds"`
+	Timeout int `json:"timeout" mapstructure:"timeout" koanf:"timeout" cfg_default:"30" cfg_label:"Request Timeout" cfg_desc:"HTTP request timeout in seconds" cfg_validate:"min=1,max=600" cfg_advanced:"true"`
 
 	// RetryCount is the number of times to retry failed requests.
-	RetryCount int `json:"retryCount" mapstructure:"retryCount" koanf:"retryCount" cfg_default:"3" cfg_label:"Retry Count" cfg_desc:"Number of retry attempts for failed requests"`
+	RetryCount int `json:"retryCount" mapstructure:"retryCount" koanf:"retryCount" cfg_default:"3" cfg_label:"Retry Count" cfg_desc:"Number of retry attempts for failed requests" cfg_validate:"min=0,max=10" cfg_advanced:"true"`
 
 	// ProxyURL is the HTTP proxy URL (optional).
-	ProxyURL string `json:"proxyUrl" mapstructure:"proxyUrl" koanf:"proxyUrl" cfg_label:"Proxy URL" cfg_desc:"HTTP proxy URL (leave empty for direct connection)"`
+	ProxyURL string `json:"proxyUrl" mapstructure:"proxyUrl" koanf:"proxyUrl" cfg_label:"Proxy URL" cfg_desc:"HTTP proxy URL (leave empty for direct connection)" cfg_validate:"url" cfg_advanced:"true"`
 
 	// VerifySSL enables SSL certificate verification.
-	VerifySSL bool `json:"verifySSL" mapstructure:"verifySSL" koanf:"verifySSL" cfg_default:"true" cfg_label:"Verify SSL" cfg_desc:"Verify SSL certificates (disable for self-signed)"`
+	VerifySSL bool `json:"verifySSL" mapstructure:"verifySSL" koanf:"verifySSL" cfg_default:"true" cfg_label:"Verify SSL" cfg_desc:"Verify SSL certificates (disable for self-signed)" cfg_advanced:"true"`
 }
 
 // NotificationsConfig contains notification preferences.
@@ -138,19 +182,47 @@ type NotificationsConfig struct {
 	EnableNotifications bool `json:"enableNotifications" mapstructure:"enableNotifications" koanf:"enableNotifications" cfg_default:"true" cfg_label:"Enable Notifications" cfg_desc:"Show desktop notifications"`
 
 	// SoundEnabled controls notification sounds.
-	SoundEnabled bool `json:"soundEnabled" mapstructure:"soundEnabled" koanf:"soundEnabled" cfg_default:"true" cfg_label:"Notification Sound" cfg_desc:"Play sound with notifications"`
+	SoundEnabled bool `json:"soundEnabled" mapstructure:"soundEnabled" koanf:"soundEnabled" cfg_default:"true" cfg_label:"Notification Sound" cfg_desc:"Play sound with notifications" cfg_visible_if:"notifications.enableNotifications=true"`
 
 	// NotifyOnError sends notifications on errors.
-	NotifyOnError bool `json:"notifyOnError" mapstructure:"notifyOnError" koanf:"notifyOnError" cfg_default:"true" cfg_label:"Error Notifications" cfg_desc:"Notify when errors occur"`
+	NotifyOnError bool `json:"notifyOnError" mapstructure:"notifyOnError" koanf:"notifyOnError" cfg_default:"true" cfg_label:"Error Notifications" cfg_desc:"Notify when errors occur" cfg_visible_if:"notifications.enableNotifications=true"`
 
 	// NotifyOnComplete sends notifications when tasks complete.
-	NotifyOnComplete bool `json:"notifyOnComplete" mapstructure:"notifyOnComplete" koanf:"notifyOnComplete" cfg_default:"true" cfg_label:"Completion Notifications" cfg_desc:"Notify when long tasks finish"`
+	NotifyOnComplete bool `json:"notifyOnComplete" mapstructure:"notifyOnComplete" koanf:"notifyOnComplete" cfg_default:"true" cfg_label:"Completion Notifications" cfg_desc:"Notify when long tasks finish" cfg_visible_if:"notifications.enableNotifications=true"`
 
 	// QuietHoursStart is the start of quiet hours (24h format, e.g., "22:00").
-	QuietHoursStart string `json:"quietHoursStart" mapstructure:"quietHoursStart" koanf:"quietHoursStart" cfg_default:"22:00" cfg_label:"Quiet Hours Start" cfg_desc:"Start time for quiet hours (HH:MM format)"`
+	QuietHoursStart string `json:"quietHoursStart" mapstructure:"quietHoursStart" koanf:"quietHoursStart" cfg_default:"22:00" cfg_label:"Quiet Hours Start" cfg_desc:"Start time for quiet hours (HH:MM format)" cfg_pattern:"^([01][0-9]|2[0-3]):[0-5][0-9]$" cfg_visible_if:"notifications.enableNotifications=true"`
 
 	// QuietHoursEnd is the end of quiet hours (24h format, e.g., "07:00").
-	QuietHoursEnd string `json:"quietHoursEnd" mapstructure:"quietHoursEnd" koanf:"quietHoursEnd" cfg_default:"07:00" cfg_label:"Quiet Hours End" cfg_desc:"End time for quiet hours (HH:MM format)"`
+	QuietHoursEnd string `json:"quietHoursEnd" mapstructure:"quietHoursEnd" koanf:"quietHoursEnd" cfg_default:"07:00" cfg_label:"Quiet Hours End" cfg_desc:"End time for quiet hours (HH:MM format)" cfg_pattern:"^([01][0-9]|2[0-3]):[0-5][0-9]$" cfg_visible_if:"notifications.enableNotifications=true"`
+}
+
+// BreaksConfig contains the break reminder settings.
+type BreaksConfig struct {
+	// Enabled turns on break reminders and the time-to-break segment in the
+	// footer.
+	Enabled bool `json:"enabled" mapstructure:"enabled" koanf:"enabled" cfg_label:"Break Reminders" cfg_desc:"Suggest a break during long sessions"`
+
+	// WorkMinutes is how long to work before a break is suggested.
+	WorkMinutes int `json:"workMinutes" mapstructure:"workMinutes" koanf:"workMinutes" cfg_default:"90" cfg_label:"Work Period" cfg_desc:"Minutes between break reminders" cfg_validate:"min=5,max=480" cfg_visible_if:"breaks.enabled=true"`
+
+	// BreakMinutes is how long a break lasts.
+	BreakMinutes int `json:"breakMinutes" mapstructure:"breakMinutes" koanf:"breakMinutes" cfg_default:"10" cfg_label:"Break Length" cfg_desc:"Minutes a break lasts" cfg_validate:"min=1,max=120" cfg_visible_if:"breaks.enabled=true"`
+}
+
+// IdleConfig contains the idle timeout settings, for instances left running
+// on shared screens.
+type IdleConfig struct {
+	// Enabled returns to the home screen after Minutes without input. The
+	// first key pressed afterwards only wakes the UI, so a passer-by cannot
+	// trigger anything with it.
+	Enabled bool `json:"enabled" mapstructure:"enabled" koanf:"enabled" cfg_label:"Idle Timeout" cfg_desc:"Return to the home screen when left without input"`
+
+	// Minutes is how long without input before the UI goes idle.
+	Minutes int `json:"minutes" mapstructure:"minutes" koanf:"minutes" cfg_default:"10" cfg_label:"Idle After" cfg_desc:"Minutes without input before going idle" cfg_validate:"min=1,max=240" cfg_visible_if:"idle.enabled=true"`
+
+	// Screensaver covers the screen with the animated banner while idle.
+	Screensaver bool `json:"screensaver" mapstructure:"screensaver" koanf:"screensaver" cfg_label:"Screensaver" cfg_desc:"Show the animated banner while idle" cfg_visible_if:"idle.enabled=true"`
 }
 
 // AppConfig contains general application configuration.
@@ -162,8 +234,24 @@ type AppConfig struct {
 	// Description is the application description.
 	Description string `json:"description" mapstructure:"description" koanf:"description" cfg_default:"A scaffold application"`
 
-	// Version is the application version.
+	// Version is no longer read; the version reported everywhere is the
+	// build's.
+	//
+	// Deprecated: use [scaffold/internal/version.Version], set when the
+	// binary is built.
 	Version string `json:"version" mapstructure:"version" koanf:"version" cfg_default:"1.0.0"`
+
+	// UpdateRepo is the GitHub repository, as "owner/name", whose releases
+	// the update command installs. Empty means the app has no releases.
+	UpdateRepo string `json:"updateRepo" mapstructure:"updateRepo" koanf:"updateRepo"`
+
+	// DisableUpdates turns the update command off, for installs managed by
+	// a package manager that must not be replaced behind its back.
+	DisableUpdates bool `json:"disableUpdates" mapstructure:"disableUpdates" koanf:"disableUpdates"`
+
+	// DisableStats stops the TUI from counting usage in stats.json. The
+	// counts never leave the machine unless exported with "stats export".
+	DisableStats bool `json:"disableStats" mapstructure:"disableStats" koanf:"disableStats"`
 }
 
 // loadDefaults populates k with values from DefaultConfig.
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"scaffold/internal/formgen"
 )
 
 // TestSchema_ExcludedFieldsAbsent verifies that fields tagged cfg_exclude:"true"
@@ -106,3 +108,20 @@ func TestSchema_TopLevelNonStructFieldsGroupedAsGeneral(t *testing.T) {
 	assert.True(t, keys["logLevel"], "logLevel must be in General group")
 	assert.True(t, keys["debug"], "debug must be in General group")
 }
+
+// TestSchema_DefaultsPassValidation verifies that the defaults satisfy every
+// cfg_validate and cfg_pattern rule, so a fresh config can always be saved.
+func TestSchema_DefaultsPassValidation(t *testing.T) {
+	assert.NoError(t, formgen.Check(Schema(DefaultConfig())))
+}
+
+// TestSchema_ValidationRules spot-checks the rules on the Network group.
+func TestSchema_ValidationRules(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Network.RetryCount = 11
+	assert.EqualError(t, formgen.Check(Schema(cfg)), "Retry Count must be at most 10")
+
+	cfg = DefaultConfig()
+	cfg.Notifications.QuietHoursEnd = "7am"
+	assert.ErrorContains(t, formgen.Check(Schema(cfg)), "Quiet Hours End must match")
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"banner"
+)
+
+func Example() {
+	b, err := banner.Render(banner.Config{Text: "Hi", Font: "standard", Parser: "terminal"})
+	if err != nil {
+		panic(err)
+	}
+	// Trailing spaces are trimmed to keep the expected output readable.
+	for _, line := range strings.Split(strings.TrimRight(b, "\n"), "\n") {
+		fmt.Println(strings.TrimRight(line, " "))
+	}
+	// Output:
+	//  _   _ _
+	// | | | (_)
+	// | |_| | |
+	// |  _  | |
+	// |_| |_|_|
+}
+
+func Example_width() {
+	// The header shows a banner only when it fits, so it measures it first.
+	b, _ := banner.Render(banner.Config{Text: "scaffold", Font: "larry3d", Parser: "terminal"})
+	fmt.Println(lipgloss.Width(b))
+	// Output:
+	// 60
+}
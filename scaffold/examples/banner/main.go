@@ -0,0 +1,44 @@
+// Command banner renders text as an ASCII art banner with a gradient taken
+// from a theme palette, as the scaffold's header does.
+//
+//	go run ./examples/banner [-font name] [-plain] [text]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"charm.land/lipgloss/v2"
+
+	"banner"
+	"scaffold/internal/ui/theme"
+)
+
+func main() {
+	font := flag.String("font", "larry3d", "figlet font name")
+	plain := flag.Bool("plain", false, "render without colors")
+	flag.Parse()
+
+	text := flag.Arg(0)
+	if text == "" {
+		text = "scaffold"
+	}
+
+	p := theme.NewPalette("default", true)
+	cfg := banner.Config{
+		Text:     text,
+		Font:     *font,
+		Width:    120,
+		Gradient: banner.GradientThemed(p.Primary, p.Secondary),
+	}
+	if *plain {
+		cfg.Parser = "terminal"
+	}
+	b, err := banner.Render(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	lipgloss.Println(b)
+}
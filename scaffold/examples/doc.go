@@ -0,0 +1,17 @@
+// Package examples holds small runnable programs built on the scaffold's own
+// packages. They are the reference for the patterns the skills describe:
+// copying a snippet from here gets code that compiles against the current
+// tree, because every example is built and its documented output checked by
+//
+//	go test ./examples/...
+//
+// Each subdirectory is a main package that can be run with go run, e.g.
+// go run ./examples/screen, and has Example functions whose // Output
+// comments are the documentation:
+//
+//   - screen: a themed screen on a navigation stack
+//   - task: background work reported back as messages
+//   - theme: palettes, contrast checks and monochrome styles
+//   - banner: ASCII art banners
+//   - plugin: a plugin adding a screen, a command and a status segment
+package examples
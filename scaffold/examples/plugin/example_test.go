@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"scaffold/internal/plugin"
+	"scaffold/internal/ui/screens"
+)
+
+func Example() {
+	// The host writes one request per line and reads one response per line;
+	// here the example plays the host.
+	requests := strings.Join([]string{
+		`{"id": 1, "method": "initialize", "params": {"protocol": 1}}`,
+		`{"id": 2, "method": "key", "params": {"screen": "counter", "key": "+", "width": 20}}`,
+		`{"id": 3, "method": "status"}`,
+		`{"id": 4, "method": "shutdown"}`,
+	}, "\n")
+	t := &tally{}
+	if err := plugin.Serve(strings.NewReader(requests), os.Stdout, t.handler()); err != nil {
+		panic(err)
+	}
+	// Output:
+	// {"id":1}
+	// {"id":2,"result":{"body":"Count: 1\n▮\n\n+/space add one • - take one away • 0 reset • x close"}}
+	// {"id":3,"result":{"text":"tally 1"}}
+	// {"id":4}
+}
+
+func Example_manifest() {
+	m, err := plugin.LoadManifest("plugin.json")
+	if err != nil {
+		panic(err)
+	}
+	for _, s := range m.Screens {
+		fmt.Printf("menu item %q opens %s\n", s.Title, screens.PluginScreenID(m.Name, s.ID))
+	}
+	for _, c := range m.Commands {
+		fmt.Printf("scaffold %s runs %s run %s\n", c.Name, strings.Join(m.Exec, " "), c.Name)
+	}
+	// Output:
+	// menu item "Tally" opens plugin:tally/counter
+	// scaffold tally runs ./tally run tally
+}
@@ -0,0 +1,68 @@
+// Command plugin is a scaffold plugin written with the plugin package. It
+// contributes a counter screen to the home menu, a "tally" subcommand and
+// a status bar segment. To install it, build it into a directory under the
+// plugins directory next to the config file (scaffold plugins prints it),
+// beside its manifest:
+//
+//	dir=~/.config/scaffold/plugins/tally
+//	mkdir -p $dir && cp examples/plugin/plugin.json $dir
+//	go build -o $dir/tally ./examples/plugin
+//	scaffold plugins
+//	scaffold tally a b c
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"scaffold/internal/plugin"
+)
+
+// tally is the plugin's state. The host keeps one process per plugin for
+// the whole session, so it lasts until the TUI exits.
+type tally struct {
+	count int
+}
+
+func (t *tally) body(width int) string {
+	bar := strings.Repeat("▮", min(t.count, max(width, 1)))
+	return fmt.Sprintf("Count: %d\n%s\n\n+/space add one • - take one away • 0 reset • x close", t.count, bar)
+}
+
+func (t *tally) handler() plugin.Handler {
+	return plugin.Handler{
+		Render: func(p plugin.RenderParams) (plugin.Reply, error) {
+			return plugin.Reply{Body: t.body(p.Width)}, nil
+		},
+		Key: func(p plugin.KeyParams) (plugin.Reply, error) {
+			switch p.Key {
+			case "+", "space":
+				t.count++
+			case "-":
+				t.count = max(t.count-1, 0)
+			case "0":
+				t.count = 0
+				return plugin.Reply{Body: t.body(p.Width), Status: "Tally reset"}, nil
+			case "x":
+				return plugin.Reply{Close: true}, nil
+			}
+			return plugin.Reply{Body: t.body(p.Width)}, nil
+		},
+		Status: func() (string, error) {
+			return fmt.Sprintf("tally %d", t.count), nil
+		},
+		Commands: map[string]func([]string) error{
+			"tally": func(args []string) error {
+				for i, arg := range args {
+					fmt.Printf("%d. %s\n", i+1, arg)
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func main() {
+	t := &tally{}
+	plugin.Main(t.handler())
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// run delivers msg to a and then the messages its commands produce, the way a
+// tea.Program would, and returns the updated model.
+func run(a app, msg tea.Msg) app {
+	for queue := []tea.Msg{msg}; len(queue) > 0; queue = queue[1:] {
+		m, cmd := a.Update(queue[0])
+		a = m.(app)
+		queue = append(queue, drain(cmd)...)
+	}
+	return a
+}
+
+// drain runs cmd and flattens batches into their messages.
+func drain(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	switch msg := cmd().(type) {
+	case nil:
+		return nil
+	case tea.BatchMsg:
+		var msgs []tea.Msg
+		for _, c := range msg {
+			msgs = append(msgs, drain(c)...)
+		}
+		return msgs
+	default:
+		return []tea.Msg{msg}
+	}
+}
+
+// press returns the key press for k.
+func press(k string) tea.KeyPressMsg {
+	switch k {
+	case "enter":
+		return tea.KeyPressMsg{Code: tea.KeyEnter}
+	case "esc":
+		return tea.KeyPressMsg{Code: tea.KeyEscape}
+	}
+	return tea.KeyPressMsg{Code: rune(k[0]), Text: k}
+}
+
+func Example() {
+	a := newApp()
+	for _, k := range []string{"+", "+", "enter", "-"} {
+		a = run(a, press(k))
+	}
+	fmt.Println(ansi.Strip(a.stack.Top().Body()))
+
+	a = run(a, press("esc"))
+	fmt.Println(ansi.Strip(a.stack.Top().Body()))
+	fmt.Println("depth:", a.stack.Len())
+	// Output:
+	// Counter 1
+	// count: -1
+	// Counter 0
+	// count: 2
+	// depth: 1
+}
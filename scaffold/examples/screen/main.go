@@ -0,0 +1,110 @@
+// Command screen shows how a themed screen lives on a navigation stack. Each
+// counter screen embeds theme.ThemeAware, so the stack styles it, and enter
+// pushes a deeper counter that esc pops again.
+//
+//	go run ./examples/screen
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"scaffold/internal/ui/nav"
+	"scaffold/internal/ui/theme"
+)
+
+// popMsg asks the host to pop the active screen. Screens never touch the
+// stack themselves.
+type popMsg struct{}
+
+// pushMsg asks the host to push screen.
+type pushMsg struct{ screen nav.Screen }
+
+// Counter is a screen with a count that + and - change.
+type Counter struct {
+	theme.ThemeAware
+
+	depth int
+	count int
+}
+
+// ApplyTheme implements theme.Themeable; the stack calls it.
+func (c *Counter) ApplyTheme(state theme.State) {
+	c.ApplyThemeState(state)
+}
+
+// Init implements tea.Model.
+func (c *Counter) Init() tea.Cmd { return nil }
+
+// Update implements tea.Model.
+func (c *Counter) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyPressMsg); ok {
+		switch msg.String() {
+		case "+":
+			c.count++
+		case "-":
+			c.count--
+		case "enter":
+			return c, func() tea.Msg { return pushMsg{&Counter{depth: c.depth + 1}} }
+		case "esc":
+			return c, func() tea.Msg { return popMsg{} }
+		}
+	}
+	return c, nil
+}
+
+// View implements tea.Model.
+func (c *Counter) View() tea.View { return tea.NewView(c.Body()) }
+
+// Body implements nav.Screen: the content the host lays out.
+func (c *Counter) Body() string {
+	title := lipgloss.NewStyle().Bold(true).Foreground(c.Palette().Primary)
+	return title.Render(fmt.Sprintf("Counter %d", c.depth)) + "\n" + fmt.Sprintf("count: %d", c.count)
+}
+
+// app hosts the stack, the role rootModel plays in the scaffold.
+type app struct {
+	stack nav.Stack
+	quit  key.Binding
+}
+
+func newApp() app {
+	a := app{
+		stack: nav.NewStack(&Counter{}),
+		quit:  key.NewBinding(key.WithKeys("q", "ctrl+c")),
+	}
+	a.stack.SetTheme(theme.State{Name: "default", IsDark: true, Palette: theme.NewPalette("default", true)})
+	return a
+}
+
+func (a app) Init() tea.Cmd { return nil }
+
+func (a app) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		if key.Matches(msg, a.quit) {
+			return a, tea.Quit
+		}
+	case pushMsg:
+		return a, tea.Batch(a.stack.Push(msg.screen), msg.screen.Init())
+	case popMsg:
+		return a, a.stack.Pop()
+	}
+	return a, a.stack.Update(msg)
+}
+
+func (a app) View() tea.View {
+	help := fmt.Sprintf("\n\ndepth %d • +/- count • enter push • esc pop • q quit", a.stack.Len())
+	return tea.NewView(a.stack.Top().Body() + help)
+}
+
+func main() {
+	if _, err := tea.NewProgram(newApp()).Run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"scaffold/internal/task"
+)
+
+func Example() {
+	m := model{ctx: context.Background(), loading: true}
+	// A tea.Program runs the command and delivers its message; here the
+	// example does both.
+	next, _ := m.Update(m.Init()())
+	fmt.Println(next.View().Content)
+	// Output:
+	// lookup: 42 results
+	//
+	// r retry with timeout • q quit
+}
+
+func Example_timeout() {
+	cmd := task.RunWithTimeout(context.Background(), "lookup", 10*time.Millisecond, lookup(time.Second))
+	switch msg := cmd().(type) {
+	case task.DoneMsg[string]:
+		fmt.Println("done:", msg.Value)
+	case task.ErrMsg:
+		fmt.Println("error:", msg.Err)
+	}
+	// Output:
+	// error: context deadline exceeded
+}
@@ -0,0 +1,76 @@
+// Command task shows how background work reports back through the message
+// loop with the task package. A slow lookup starts in Init; the model renders
+// "loading" until a task.DoneMsg or task.ErrMsg arrives, and r runs it again
+// with a timeout shorter than the work.
+//
+//	go run ./examples/task
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"scaffold/internal/task"
+)
+
+// lookup stands in for slow I/O. It honours ctx, as task functions must, so
+// a timeout or quit stops it.
+func lookup(delay time.Duration) func(context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		select {
+		case <-time.After(delay):
+			return "42 results", nil
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+}
+
+type model struct {
+	ctx     context.Context
+	loading bool
+	result  string
+}
+
+func (m model) Init() tea.Cmd {
+	return task.Run(m.ctx, "lookup", lookup(500*time.Millisecond))
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case task.DoneMsg[string]:
+		m.loading, m.result = false, msg.Label+": "+msg.Value
+	case task.ErrMsg:
+		m.loading, m.result = false, msg.Label+" failed: "+msg.Err.Error()
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "r":
+			m.loading = true
+			return m, task.RunWithTimeout(m.ctx, "lookup", 100*time.Millisecond, lookup(time.Second))
+		case "q", "ctrl+c":
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() tea.View {
+	if m.loading {
+		return tea.NewView("loading…")
+	}
+	return tea.NewView(m.result + "\n\nr retry with timeout • q quit")
+}
+
+func main() {
+	// Cancelling ctx on exit stops work still running in the background.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if _, err := tea.NewProgram(model{ctx: ctx, loading: true}, tea.WithContext(ctx)).Run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
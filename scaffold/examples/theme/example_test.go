@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+
+	"scaffold/internal/ui/theme"
+)
+
+func Example_contrast() {
+	fmt.Printf("%.0f:1\n", theme.ContrastRatio(color.Black, color.White))
+
+	// Generated palettes are corrected to at least theme.MinContrast.
+	p := theme.NewPalette("default", true)
+	fmt.Println(theme.ContrastRatio(p.Foreground, p.Background) >= theme.MinContrast())
+	// Output:
+	// 21:1
+	// true
+}
+
+func Example_monochrome() {
+	// main.go of the scaffold does this for NO_COLOR and --no-color.
+	theme.SetMonochrome(true)
+	defer theme.SetMonochrome(false)
+
+	s := theme.NewFromPalette(theme.NewPalette("default", true), 80)
+	fmt.Printf("%q\n", s.StatusLeft.Render("saved"))
+	fmt.Println(theme.ValidatePalette(theme.NewPalette("default", true)) == nil)
+	// Output:
+	// "\x1b[1msaved\x1b[m"
+	// true
+}
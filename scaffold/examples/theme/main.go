@@ -0,0 +1,62 @@
+// Command theme prints the palette of a built-in theme as swatches, with the
+// text contrast ratio and any validation warnings. It needs no TTY input.
+//
+//	go run ./examples/theme [-light] [name]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"image/color"
+	"os"
+
+	"charm.land/lipgloss/v2"
+
+	"scaffold/internal/ui/theme"
+)
+
+// roles are the palette fields printed, in order.
+func roles(p theme.Palette) []struct {
+	name string
+	c    color.Color
+} {
+	return []struct {
+		name string
+		c    color.Color
+	}{
+		{"Primary", p.Primary}, {"Secondary", p.Secondary},
+		{"Background", p.Background}, {"Foreground", p.Foreground},
+		{"Success", p.Success}, {"Error", p.Error},
+		{"Warning", p.Warning}, {"Info", p.Info},
+	}
+}
+
+// describe renders the swatch table for name.
+func describe(name string, isDark bool) string {
+	p := theme.NewPalette(name, isDark)
+	out := fmt.Sprintf("%s (dark: %t)\n", name, isDark)
+	for _, r := range roles(p) {
+		swatch := lipgloss.NewStyle().Background(r.c).Render("    ")
+		out += fmt.Sprintf("  %s %-10s\n", swatch, r.name)
+	}
+	out += fmt.Sprintf("  text contrast %.1f:1\n", theme.ContrastRatio(p.Foreground, p.Background))
+	for _, w := range theme.ValidatePalette(p) {
+		out += "  warning: " + w + "\n"
+	}
+	return out
+}
+
+func main() {
+	light := flag.Bool("light", false, "show the light variant")
+	flag.Parse()
+
+	name := flag.Arg(0)
+	if name == "" {
+		name = "default"
+	}
+	if _, ok := theme.Spec(name); !ok {
+		fmt.Fprintf(os.Stderr, "unknown theme %q; available: %v\n", name, theme.AvailableThemes())
+		os.Exit(1)
+	}
+	lipgloss.Print(describe(name, !*light))
+}
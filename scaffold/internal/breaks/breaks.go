@@ -0,0 +1,127 @@
+// Package breaks keeps the break reminder timer for long sessions: it
+// counts the time worked since the last break, says when a reminder is due,
+// and times the break the user agrees to take.
+//
+// The timer holds no clock of its own. Callers pass the current time to
+// every method, so it can be driven by a tick and tested without waiting.
+package breaks
+
+import (
+	"time"
+
+	"scaffold/internal/timefmt"
+)
+
+// Snooze is how long a declined reminder waits before asking again.
+const Snooze = 15 * time.Minute
+
+// Phase is where the timer is in the work and break cycle.
+type Phase int
+
+const (
+	// Working counts down to the next reminder.
+	Working Phase = iota
+	// Due has reminded the user and waits for an answer.
+	Due
+	// OnBreak counts down to the end of the break.
+	OnBreak
+)
+
+// Event is what a Tick found to have happened.
+type Event int
+
+const (
+	// None means nothing changed.
+	None Event = iota
+	// ReminderDue means the work period is over: ask the user to take a
+	// break, then call TakeBreak or Decline with the answer.
+	ReminderDue
+	// BreakOver means the break has ended and a new work period started.
+	BreakOver
+)
+
+// Timer is the break reminder timer. The zero value is not usable; create
+// one with New.
+type Timer struct {
+	work  time.Duration // length of a work period
+	brk   time.Duration // length of a break
+	phase Phase
+	until time.Time // end of the current work period or break
+	start time.Time // start of the work period being timed, for Worked
+}
+
+// New returns a timer that reminds after work and offers breaks of brk,
+// with its first work period starting at now.
+func New(work, brk time.Duration, now time.Time) Timer {
+	return Timer{work: work, brk: brk, phase: Working, until: now.Add(work), start: now}
+}
+
+// Phase returns where t is in the cycle.
+func (t Timer) Phase() Phase {
+	return t.phase
+}
+
+// Break returns the length of a break.
+func (t Timer) Break() time.Duration {
+	return t.brk
+}
+
+// Worked returns how long has been worked since the last break, as of now.
+func (t Timer) Worked(now time.Time) time.Duration {
+	if t.phase == OnBreak {
+		return 0
+	}
+	return now.Sub(t.start)
+}
+
+// Remaining returns the time left until the next reminder, or until the end
+// of the break, as of now. It is zero while a reminder is due.
+func (t Timer) Remaining(now time.Time) time.Duration {
+	if t.phase == Due {
+		return 0
+	}
+	return max(t.until.Sub(now), 0)
+}
+
+// Tick moves t on to now and reports what happened.
+func (t Timer) Tick(now time.Time) (Timer, Event) {
+	if now.Before(t.until) {
+		return t, None
+	}
+	switch t.phase {
+	case Working:
+		t.phase = Due
+		return t, ReminderDue
+	case OnBreak:
+		t = New(t.work, t.brk, now)
+		return t, BreakOver
+	}
+	return t, None
+}
+
+// TakeBreak starts a break at now.
+func (t Timer) TakeBreak(now time.Time) Timer {
+	t.phase = OnBreak
+	t.until = now.Add(t.brk)
+	return t
+}
+
+// Decline goes back to work, asking again after Snooze or a whole work
+// period, whichever is shorter. The time worked keeps counting.
+func (t Timer) Decline(now time.Time) Timer {
+	t.phase = Working
+	t.until = now.Add(min(Snooze, t.work))
+	return t
+}
+
+// Segment returns the status-bar text for t as of now, in the style of f,
+// e.g. "break in 42m" or "back at 15:40".
+func (t Timer) Segment(now time.Time, f timefmt.Formatter) string {
+	switch t.phase {
+	case Due:
+		return "break due"
+	case OnBreak:
+		return "back " + f.Until(t.until, now)
+	}
+	return "break " + f.Until(t.until, now)
+}
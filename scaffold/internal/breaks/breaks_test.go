@@ -0,0 +1,62 @@
+package breaks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"scaffold/internal/timefmt"
+)
+
+func TestTimer_Cycle(t *testing.T) {
+	start := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+	tm := New(90*time.Minute, 10*time.Minute, start)
+	assert.Equal(t, "break in 1h30m", tm.Segment(start, timefmt.Formatter{}))
+
+	tm, ev := tm.Tick(start.Add(time.Hour))
+	assert.Equal(t, None, ev)
+	assert.Equal(t, Working, tm.Phase())
+	assert.Equal(t, "break in 30m", tm.Segment(start.Add(time.Hour), timefmt.Formatter{}))
+
+	now := start.Add(90 * time.Minute)
+	tm, ev = tm.Tick(now)
+	assert.Equal(t, ReminderDue, ev)
+	assert.Equal(t, Due, tm.Phase())
+	assert.Equal(t, "break due", tm.Segment(now, timefmt.Formatter{}))
+	assert.Equal(t, 90*time.Minute, tm.Worked(now))
+
+	tm = tm.TakeBreak(now)
+	assert.Equal(t, OnBreak, tm.Phase())
+	assert.Equal(t, "back in 8m", tm.Segment(now.Add(2*time.Minute), timefmt.Formatter{}))
+	assert.Equal(t, "back at 10:40", tm.Segment(now.Add(2*time.Minute), timefmt.New(timefmt.Absolute, "", "")))
+
+	now = now.Add(10 * time.Minute)
+	tm, ev = tm.Tick(now)
+	assert.Equal(t, BreakOver, ev)
+	assert.Equal(t, Working, tm.Phase())
+	assert.Equal(t, time.Duration(0), tm.Worked(now))
+	assert.Equal(t, 90*time.Minute, tm.Remaining(now))
+}
+
+func TestTimer_Decline(t *testing.T) {
+	start := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+	tm := New(90*time.Minute, 10*time.Minute, start)
+	now := start.Add(90 * time.Minute)
+	tm, _ = tm.Tick(now)
+
+	tm = tm.Decline(now)
+	assert.Equal(t, Working, tm.Phase())
+	assert.Equal(t, Snooze, tm.Remaining(now))
+
+	now = now.Add(Snooze)
+	tm, ev := tm.Tick(now)
+	assert.Equal(t, ReminderDue, ev)
+	assert.Equal(t, 105*time.Minute, tm.Worked(now), "time worked keeps counting")
+
+	short := New(5*time.Minute, time.Minute, start)
+	short, _ = short.Tick(start.Add(5 * time.Minute))
+	short = short.Decline(start.Add(5 * time.Minute))
+	assert.Equal(t, 5*time.Minute, short.Remaining(start.Add(5*time.Minute)),
+		"a short work period snoozes for no longer than itself")
+}
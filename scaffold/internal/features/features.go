@@ -0,0 +1,212 @@
+// Package features gates experimental subsystems behind named flags.
+//
+// Flags are defined in code with Define, each with a default. At startup
+// Load applies overrides from the config file's "features" map and then
+// from $SCAFFOLD_FEATURES, so the environment wins. Set changes a flag at
+// runtime, as the hidden feature flags screen does. Code checks a flag with
+// Enabled at the point of use, so runtime changes take effect on the next
+// check.
+//
+// Flags are process-wide and safe for concurrent use.
+package features
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// EnvVar names the environment variable Load reads overrides from: a
+// comma-separated list such as "animated-banner,-transitions" or
+// "animated-banner=true,transitions=false".
+const EnvVar = "SCAFFOLD_FEATURES"
+
+// ErrUnknown is returned for a flag name that was never defined.
+var ErrUnknown = errors.New("unknown feature flag")
+
+// Source records where a flag's current value came from.
+type Source uint8
+
+const (
+	SourceDefault Source = iota // the default given to Define
+	SourceConfig                // the config file's "features" map
+	SourceEnv                   // $SCAFFOLD_FEATURES
+	SourceRuntime               // Set, e.g. from the feature flags screen
+)
+
+// String returns the source's name, as shown on the feature flags screen.
+func (s Source) String() string {
+	switch s {
+	case SourceConfig:
+		return "config"
+	case SourceEnv:
+		return "env"
+	case SourceRuntime:
+		return "runtime"
+	}
+	return "default"
+}
+
+// Flag is a named on/off switch. Create flags with Define.
+type Flag struct {
+	name string
+	desc string
+	def  bool
+}
+
+// Name returns the flag's name, as used in config, env and logs.
+func (f *Flag) Name() string { return f.name }
+
+// Desc returns a one-line description of what the flag gates.
+func (f *Flag) Desc() string { return f.desc }
+
+// Default returns the value the flag has when nothing overrides it.
+func (f *Flag) Default() bool { return f.def }
+
+// Enabled reports whether the flag is currently on.
+func (f *Flag) Enabled() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	return values[f.name].on
+}
+
+// Source reports where the flag's current value came from.
+func (f *Flag) Source() Source {
+	mu.RLock()
+	defer mu.RUnlock()
+	return values[f.name].src
+}
+
+// ChangedMsg reports that a flag was changed at runtime. Components that
+// start work when a flag turns on, such as the banner animation, listen for
+// it.
+type ChangedMsg struct {
+	Flag    *Flag
+	Enabled bool
+}
+
+type value struct {
+	on  bool
+	src Source
+}
+
+var (
+	mu     sync.RWMutex
+	flags  []*Flag // in definition order
+	values = make(map[string]value)
+)
+
+// Define registers a flag with its default and returns it. It is meant for
+// package-level vars and panics if name is already defined.
+func Define(name, desc string, def bool) *Flag {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := values[name]; ok {
+		panic("features: flag " + name + " defined twice")
+	}
+	f := &Flag{name: name, desc: desc, def: def}
+	flags = append(flags, f)
+	values[name] = value{on: def}
+	return f
+}
+
+// All returns every defined flag in definition order.
+func All() []*Flag {
+	mu.RLock()
+	defer mu.RUnlock()
+	return slices.Clone(flags)
+}
+
+// Lookup returns the flag named name.
+func Lookup(name string) (*Flag, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, f := range flags {
+		if f.name == name {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// Set turns the flag named name on or off, recording src as the source.
+func Set(name string, on bool, src Source) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := values[name]; !ok {
+		return fmt.Errorf("%w %q", ErrUnknown, name)
+	}
+	values[name] = value{on: on, src: src}
+	return nil
+}
+
+// Reset returns every flag to its default.
+func Reset() {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, f := range flags {
+		values[f.name] = value{on: f.def}
+	}
+}
+
+// Load applies the config file's overrides and then those in
+// $SCAFFOLD_FEATURES. Unknown names and unparsable entries are skipped and
+// reported together in the returned error; the rest still apply.
+func Load(cfg map[string]bool) error {
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(cfg)) {
+		errs = append(errs, Set(name, cfg[name], SourceConfig))
+	}
+	env, err := ParseEnv(os.Getenv(EnvVar))
+	errs = append(errs, err)
+	for _, name := range slices.Sorted(maps.Keys(env)) {
+		errs = append(errs, Set(name, env[name], SourceEnv))
+	}
+	return errors.Join(errs...)
+}
+
+// ParseEnv parses a $SCAFFOLD_FEATURES value. Each comma-separated entry is
+// a name, which turns the flag on, a name prefixed with "-", which turns it
+// off, or name=bool.
+func ParseEnv(s string) (map[string]bool, error) {
+	out := make(map[string]bool)
+	var errs []error
+	for entry := range strings.SplitSeq(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if name, ok := strings.CutPrefix(entry, "-"); ok {
+			out[name] = false
+			continue
+		}
+		name, arg, ok := strings.Cut(entry, "=")
+		if !ok {
+			out[name] = true
+			continue
+		}
+		on, err := strconv.ParseBool(arg)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %q is not a bool", EnvVar, entry))
+			continue
+		}
+		out[name] = on
+	}
+	return out, errors.Join(errs...)
+}
+
+// Active returns the names of the flags that are on, in definition order.
+func Active() []string {
+	var names []string
+	for _, f := range All() {
+		if f.Enabled() {
+			names = append(names, f.name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,73 @@
+package features
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnv(t *testing.T) {
+	got, err := ParseEnv(" animated-banner, -transitions,theme-hot-reload=false,,x=1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{
+		"animated-banner":  true,
+		"transitions":      false,
+		"theme-hot-reload": false,
+		"x":                true,
+	}, got)
+
+	_, err = ParseEnv("animated-banner=maybe")
+	assert.ErrorContains(t, err, `"animated-banner=maybe" is not a bool`)
+}
+
+func TestLoad_EnvOverridesConfig(t *testing.T) {
+	t.Cleanup(Reset)
+	t.Setenv(EnvVar, "-animated-banner")
+
+	err := Load(map[string]bool{"animated-banner": true, "transitions": false})
+	require.NoError(t, err)
+
+	assert.False(t, AnimatedBanner.Enabled())
+	assert.Equal(t, SourceEnv, AnimatedBanner.Source())
+	assert.False(t, Transitions.Enabled())
+	assert.Equal(t, SourceConfig, Transitions.Source())
+	assert.True(t, ThemeHotReload.Enabled())
+	assert.Equal(t, SourceDefault, ThemeHotReload.Source())
+}
+
+func TestLoad_UnknownNamesAreReported(t *testing.T) {
+	t.Cleanup(Reset)
+	t.Setenv(EnvVar, "nope")
+
+	err := Load(map[string]bool{"animated-banner": true})
+	assert.ErrorIs(t, err, ErrUnknown)
+	assert.ErrorContains(t, err, `"nope"`)
+	assert.True(t, AnimatedBanner.Enabled(), "known names still apply")
+}
+
+func TestSetAndReset(t *testing.T) {
+	t.Cleanup(Reset)
+
+	require.NoError(t, Set("animated-banner", true, SourceRuntime))
+	assert.True(t, AnimatedBanner.Enabled())
+	assert.Equal(t, "runtime", AnimatedBanner.Source().String())
+	assert.Contains(t, Active(), "animated-banner")
+
+	Reset()
+	assert.Equal(t, AnimatedBanner.Default(), AnimatedBanner.Enabled())
+	assert.Equal(t, SourceDefault, AnimatedBanner.Source())
+}
+
+func TestDefine_Duplicate(t *testing.T) {
+	assert.Panics(t, func() { Define("transitions", "again", false) })
+}
+
+func TestLookup(t *testing.T) {
+	f, ok := Lookup("theme-hot-reload")
+	require.True(t, ok)
+	assert.Same(t, ThemeHotReload, f)
+
+	_, ok = Lookup("nope")
+	assert.False(t, ok)
+}
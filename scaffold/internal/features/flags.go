@@ -0,0 +1,30 @@
+package features
+
+// The flags gating this app's experimental subsystems.
+var (
+	// Transitions allows the slide and fade push/pop transitions chosen in
+	// Settings › Screen Transition. Off, screens switch instantly.
+	Transitions = Define("transitions",
+		"Animate screen pushes and pops with the configured transition", true)
+
+	// ThemeHotReload restyles the running UI when a file in the themes
+	// directory changes.
+	ThemeHotReload = Define("theme-hot-reload",
+		"Restyle the UI when theme files in the themes directory change", true)
+
+	// AnimatedBanner animates the header banner as chosen in Settings ›
+	// Banner Animation, unless motion is reduced.
+	AnimatedBanner = Define("animated-banner",
+		"Animate the header's ASCII banner", false)
+
+	// ThemeFade cross-fades between palettes when the theme changes, at the
+	// configured animation speed.
+	ThemeFade = Define("theme-fade",
+		"Cross-fade between themes when switching", false)
+
+	// Plugins starts the plugins in the plugins directory with the TUI and
+	// adds their commands to the CLI. It is read once at startup, so changing
+	// it at runtime takes effect on the next launch.
+	Plugins = Define("plugins",
+		"Load plugins from the plugins directory (takes effect on restart)", true)
+)
@@ -0,0 +1,142 @@
+package formgen
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"charm.land/huh/v2"
+)
+
+// reflectAccessor bridges reflect.Value to huh.Accessor[T]. The field may
+// have a named type whose underlying type is T.
+type reflectAccessor[T any] struct {
+	v reflect.Value
+}
+
+func (a *reflectAccessor[T]) Get() T {
+	return a.v.Convert(reflect.TypeFor[T]()).Interface().(T)
+}
+
+func (a *reflectAccessor[T]) Set(val T) {
+	a.v.Set(reflect.ValueOf(val).Convert(a.v.Type()))
+}
+
+// textAccessor bridges a string, number, duration, text-marshalling or slice
+// field to huh.Accessor[string]. Slices are edited as comma-separated lists.
+// Text that does not parse leaves the field unchanged; the field's validator
+// reports it.
+type textAccessor struct {
+	v reflect.Value
+}
+
+// TextAccessor returns a huh.Accessor that reads and writes v as text, the
+// way the form's inputs do.
+func TextAccessor(v reflect.Value) huh.Accessor[string] {
+	return &textAccessor{v: v}
+}
+
+func (a *textAccessor) Get() string {
+	if !isList(a.v.Type()) {
+		return formatValue(a.v)
+	}
+	parts := make([]string, a.v.Len())
+	for i := range parts {
+		parts[i] = formatValue(a.v.Index(i))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (a *textAccessor) Set(s string) {
+	if !isList(a.v.Type()) {
+		if nv, err := parseValue(a.v.Type(), s); err == nil {
+			a.v.Set(nv)
+		}
+		return
+	}
+	items := splitList(s)
+	sv := reflect.MakeSlice(a.v.Type(), 0, len(items))
+	for _, item := range items {
+		nv, err := parseValue(a.v.Type().Elem(), item)
+		if err != nil {
+			return
+		}
+		sv = reflect.Append(sv, nv)
+	}
+	a.v.Set(sv)
+}
+
+// splitList splits a comma-separated list, dropping empty items.
+func splitList(s string) []string {
+	var items []string
+	for item := range strings.SplitSeq(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func formatValue(v reflect.Value) string {
+	if v.Type() == durationType {
+		return time.Duration(v.Int()).String()
+	}
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		b, err := m.MarshalText()
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// parseValue parses s into a new value of type t.
+func parseValue(t reflect.Type, s string) (reflect.Value, error) {
+	nv := reflect.New(t).Elem()
+	if t == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return nv, fmt.Errorf("must be a duration such as 30s or 5m")
+		}
+		nv.SetInt(int64(d))
+		return nv, nil
+	}
+	if u, ok := nv.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return nv, u.UnmarshalText([]byte(s))
+	}
+	switch t.Kind() {
+	case reflect.String:
+		nv.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nv, fmt.Errorf("must be true or false")
+		}
+		nv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(strings.TrimSpace(s), 10, t.Bits())
+		if err != nil {
+			return nv, fmt.Errorf("must be a whole number")
+		}
+		nv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(strings.TrimSpace(s), 10, t.Bits())
+		if err != nil {
+			return nv, fmt.Errorf("must be a whole number of at least 0")
+		}
+		nv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), t.Bits())
+		if err != nil {
+			return nv, fmt.Errorf("must be a number")
+		}
+		nv.SetFloat(f)
+	default:
+		return nv, fmt.Errorf("cannot edit a %s", t)
+	}
+	return nv, nil
+}
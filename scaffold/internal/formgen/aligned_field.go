@@ -1,4 +1,4 @@
-package screens
+package formgen
 
 import (
 	"io"
@@ -9,7 +9,7 @@ import (
 	"charm.land/lipgloss/v2"
 )
 
-// fieldAlignment holds shared column-alignment data for inline settings fields.
+// fieldAlignment holds shared column-alignment data for inline form fields.
 // Both alignedField and inlineSelect embed this to render title, description,
 // and control in fixed-width columns.
 type fieldAlignment struct {
@@ -23,9 +23,13 @@ type fieldAlignment struct {
 const columnGap = 4
 
 // renderAligned joins title, description, and control content into a
-// horizontally aligned row with fixed-width columns and spacing gaps.
+// horizontally aligned row with fixed-width columns and spacing gaps. A zero
+// descW drops the description column (compact layout).
 func (a *fieldAlignment) renderAligned(styles *huh.FieldStyles, content string) string {
 	title := styles.Title.Width(a.titleW).MarginRight(columnGap).Render(a.label)
+	if a.descW == 0 {
+		return lipgloss.JoinHorizontal(lipgloss.Left, title, content)
+	}
 	desc := styles.Description.Width(a.descW).MarginRight(columnGap).Render(a.desc)
 	return lipgloss.JoinHorizontal(lipgloss.Left, title, desc, content)
 }
@@ -33,6 +37,9 @@ func (a *fieldAlignment) renderAligned(styles *huh.FieldStyles, content string)
 // alignmentOverhead returns the total horizontal space consumed by the
 // title column, description column, and inter-column gaps.
 func (a *fieldAlignment) alignmentOverhead() int {
+	if a.descW == 0 {
+		return a.titleW + columnGap
+	}
 	return a.titleW + a.descW + columnGap*2
 }
 
@@ -0,0 +1,177 @@
+package formgen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"charm.land/huh/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// Factory builds the control for f, such as a huh.Input with a custom
+// validator or a picker of its own. The control must read and write
+// f.Value and carry f.Key; the Builder aligns it with the other fields.
+// Returning nil falls back to the default control.
+type Factory func(f Field) huh.Field
+
+// Builder turns groups into a huh form: one page per group, with title,
+// description and control aligned in columns. The zero value is not usable;
+// create one with New.
+type Builder struct {
+	compact bool
+	sources map[string]func() []string
+	byKey   map[string]Factory
+	byType  map[reflect.Type]Factory
+}
+
+// New creates a Builder with the default controls.
+func New() *Builder {
+	return &Builder{
+		sources: make(map[string]func() []string),
+		byKey:   make(map[string]Factory),
+		byType:  make(map[reflect.Type]Factory),
+	}
+}
+
+// Compact leaves out the description column, to fit narrow terminals.
+func (b *Builder) Compact(on bool) *Builder {
+	b.compact = on
+	return b
+}
+
+// OptionSource supplies the choices of fields tagged cfg_options:"_name".
+// fn is called each time a form is built.
+func (b *Builder) OptionSource(name string, fn func() []string) *Builder {
+	b.sources[name] = fn
+	return b
+}
+
+// FieldFactory builds the field with the given key using f.
+func (b *Builder) FieldFactory(key string, f Factory) *Builder {
+	b.byKey[key] = f
+	return b
+}
+
+// TypeFactory builds every field of type t using f, including types that
+// would otherwise be read-only. FieldFactory takes precedence.
+func (b *Builder) TypeFactory(t reflect.Type, f Factory) *Builder {
+	b.byType[t] = f
+	return b
+}
+
+// minControlWidth is the minimum width reserved for the interactive control column.
+const minControlWidth = 20
+
+// Form builds a huh.Form from groups.
+// Uses LayoutDefault for pagination (one group per page) to handle many fields.
+// The form width is set dynamically based on the widest group's alignment needs.
+// Fields whose cfg_visible_if conditions do not hold are left out; see Shown.
+func (b *Builder) Form(groups []Group) *huh.Form {
+	huhGroups := make([]*huh.Group, 0, len(groups))
+	for _, g := range groups {
+		titleW, descW := b.columnWidths(g)
+		fields := make([]huh.Field, 0, len(g.Fields))
+		for _, f := range g.Fields {
+			if !f.Visible() {
+				continue
+			}
+			if hf := b.field(f, titleW, descW); hf != nil {
+				fields = append(fields, hf)
+			}
+		}
+		if len(fields) > 0 {
+			huhGroups = append(huhGroups, huh.NewGroup(fields...))
+		}
+	}
+	if len(huhGroups) > 0 {
+		return huh.NewForm(huhGroups...).
+			WithLayout(huh.LayoutDefault).
+			WithWidth(b.Width(groups))
+	}
+	return huh.NewForm()
+}
+
+// Width returns the width of the form built from groups: the widest group's
+// alignment columns plus room for the control.
+func (b *Builder) Width(groups []Group) int {
+	var maxOverhead int
+	for _, g := range groups {
+		titleW, descW := b.columnWidths(g)
+		a := fieldAlignment{titleW: titleW, descW: descW}
+		maxOverhead = max(maxOverhead, a.alignmentOverhead())
+	}
+	return maxOverhead + minControlWidth
+}
+
+// columnWidths returns the title and description column widths for a
+// group: the widest label and description. The description column is empty
+// in compact forms.
+func (b *Builder) columnWidths(group Group) (titleW, descW int) {
+	for _, f := range group.Fields {
+		titleW = max(titleW, lipgloss.Width(f.Label))
+		if !b.compact {
+			descW = max(descW, lipgloss.Width(f.Desc))
+		}
+	}
+	return titleW, descW
+}
+
+// field maps f to a huh.Field wrapped in an aligned container so that title,
+// description, and control columns align vertically across all fields in a
+// group.
+func (b *Builder) field(f Field, titleW, descW int) huh.Field {
+	factory, ok := b.byKey[f.Key]
+	if !ok {
+		factory, ok = b.byType[f.Value.Type()]
+	}
+	if ok {
+		if control := factory(f); control != nil {
+			return newAlignedField(f.Label, f.Desc, titleW, descW, control)
+		}
+	}
+
+	switch f.Kind {
+	case KindSelect:
+		options := f.Options
+		if fn, ok := b.sources[f.OptionSource]; ok {
+			options = fn()
+		}
+		opts := make([]huh.Option[string], 0, len(options))
+		for _, o := range options {
+			if o != "" {
+				opts = append(opts, huh.NewOption(strings.ToUpper(o[:1])+o[1:], o))
+			}
+		}
+		sel := huh.NewSelect[string]().
+			Key(f.Key).
+			Options(opts...).Inline(true).
+			Accessor(&reflectAccessor[string]{v: f.Value})
+		return newInlineSelect(f.Label, f.Desc, titleW, descW, sel)
+	case KindConfirm:
+		confirm := huh.NewConfirm().
+			Key(f.Key).
+			Affirmative("Yes").Negative("No").Inline(true).
+			Accessor(&reflectAccessor[bool]{v: f.Value})
+		return newAlignedField(f.Label, f.Desc, titleW, descW, confirm)
+	case KindReadOnly:
+		note := huh.NewNote().
+			Title(fmt.Sprint(f.Value.Interface()))
+		return newAlignedField(f.Label, f.Desc, titleW, descW, note)
+	default: // KindInput
+		input := huh.NewInput().
+			Key(f.Key).Inline(true).
+			Accessor(TextAccessor(f.Value)).
+			Validate(f.Validate)
+		return newAlignedField(f.Label, f.Desc, titleW, descW, input)
+	}
+}
+
+// Control returns the control inside a field built by a Builder, such as the
+// *huh.Input of a text field, or field itself when it is not one.
+func Control(field huh.Field) huh.Field {
+	if af, ok := field.(*alignedField); ok {
+		return af.inner
+	}
+	return field
+}
@@ -0,0 +1,237 @@
+package formgen
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"charm.land/huh/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type level int
+
+type server struct {
+	Host string `koanf:"host" cfg_label:"Host" cfg_validate:"required"`
+	Port int    `koanf:"port" cfg_label:"Port" cfg_validate:"min=1,max=65535"`
+	TLS  struct {
+		Enabled bool   `koanf:"enabled" cfg_label:"Enabled"`
+		Cert    string `koanf:"cert" cfg_label:"Certificate"`
+	} `koanf:"tls" cfg_label:"TLS"`
+}
+
+type job struct {
+	Name     string        `json:"name" cfg_label:"Name" cfg_desc:"Lowercase job name" cfg_pattern:"^[a-z-]+$"`
+	Kind     string        `json:"kind" cfg_label:"Kind" cfg_options:"batch, cron"`
+	Level    level         `json:"level" cfg_label:"Level"`
+	Every    time.Duration `json:"every" cfg_label:"Every" cfg_validate:"min=60"`
+	Tags     []string      `json:"tags" cfg_label:"Tags" cfg_validate:"max=3"`
+	Owner    string        `json:"owner,omitempty" cfg_readonly:"true"`
+	Bind     net.IP        `json:"bind"`
+	Hidden   string        `json:"hidden" cfg_exclude:"true"`
+	Untagged string
+	Server   server `json:"server" cfg_label:"Server"`
+}
+
+func newJob() *job {
+	return &job{Name: "nightly", Kind: "cron", Every: time.Hour, Tags: []string{"db", "ops"}, Owner: "ops"}
+}
+
+// fields indexes the fields of groups by key.
+func fields(groups []Group) map[string]Field {
+	m := make(map[string]Field)
+	for _, g := range groups {
+		for _, f := range g.Fields {
+			m[f.Key] = f
+		}
+	}
+	return m
+}
+
+func TestSchema_Layout(t *testing.T) {
+	groups := Schema(newJob())
+
+	require.Len(t, groups, 2)
+	assert.Equal(t, "General", groups[0].Label)
+	assert.Equal(t, "Server", groups[1].Label)
+
+	var keys []string
+	for _, f := range groups[1].Fields {
+		keys = append(keys, f.Key)
+	}
+	assert.Equal(t, []string{"server.host", "server.port", "server.tls.enabled", "server.tls.cert"}, keys,
+		"deeper structs are flattened with dotted keys")
+	assert.Equal(t, "TLS › Certificate", groups[1].Fields[3].Label)
+
+	byKey := fields(groups)
+	assert.NotContains(t, byKey, "hidden")
+	assert.NotContains(t, byKey, "Untagged")
+	assert.Equal(t, "Bind", byKey["bind"].Label, "label falls back to the field name")
+}
+
+func TestSchema_Kinds(t *testing.T) {
+	byKey := fields(Schema(newJob()))
+
+	assert.Equal(t, KindSelect, byKey["kind"].Kind)
+	assert.Equal(t, []string{"batch", "cron"}, byKey["kind"].Options)
+	assert.Equal(t, KindInput, byKey["level"].Kind, "named types edit as their underlying type")
+	assert.Equal(t, KindInput, byKey["every"].Kind)
+	assert.Equal(t, KindInput, byKey["tags"].Kind)
+	assert.Equal(t, KindInput, byKey["bind"].Kind, "text-marshalling types edit as text")
+	assert.Equal(t, KindReadOnly, byKey["owner"].Kind)
+	assert.Equal(t, KindConfirm, byKey["server.tls.enabled"].Kind)
+	assert.Equal(t, 10, FieldCount(Schema(newJob())))
+}
+
+func TestSchema_RequiresStructPointer(t *testing.T) {
+	assert.Panics(t, func() { Schema(job{}) })
+}
+
+func TestTextAccessor_RoundTrip(t *testing.T) {
+	j := newJob()
+	byKey := fields(Schema(j))
+
+	tags := TextAccessor(byKey["tags"].Value)
+	assert.Equal(t, "db, ops", tags.Get())
+	tags.Set("a, , b,c")
+	assert.Equal(t, []string{"a", "b", "c"}, j.Tags)
+
+	every := TextAccessor(byKey["every"].Value)
+	assert.Equal(t, "1h0m0s", every.Get())
+	every.Set("90s")
+	assert.Equal(t, 90*time.Second, j.Every)
+	every.Set("soon")
+	assert.Equal(t, 90*time.Second, j.Every, "unparsable text leaves the field unchanged")
+
+	TextAccessor(byKey["level"].Value).Set("3")
+	assert.Equal(t, level(3), j.Level)
+
+	TextAccessor(byKey["bind"].Value).Set("127.0.0.1")
+	assert.Equal(t, "127.0.0.1", j.Bind.String())
+}
+
+func TestField_Validate(t *testing.T) {
+	byKey := fields(Schema(newJob()))
+
+	tests := []struct {
+		key, text string
+		wantErr   string
+	}{
+		{"server.port", "8080", ""},
+		{"server.port", "0", "must be at least 1"},
+		{"server.port", "http", "must be a whole number"},
+		{"server.host", " ", "is required"},
+		{"every", "2m", ""},
+		{"every", "30s", "must be at least 60s"},
+		{"tags", "a, b, c, d", "must be at most 3 items"},
+		{"name", "Nightly", "must match ^[a-z-]+$"},
+		{"name", "", ""},
+		{"bind", "localhost", "invalid IP address"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.text, func(t *testing.T) {
+			err := byKey[tt.key].Validate(tt.text)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	j := newJob()
+	j.Server.Host = "example.com"
+	j.Server.Port = 443
+	require.NoError(t, Check(Schema(j)))
+
+	j.Server.Port = 70000
+	assert.EqualError(t, Check(Schema(j)), "Port must be at most 65535")
+}
+
+func TestBuilder_Factories(t *testing.T) {
+	j := newJob()
+	groups := Schema(j)
+
+	var built []string
+	form := New().
+		FieldFactory("name", func(f Field) huh.Field {
+			built = append(built, f.Key)
+			return huh.NewInput().Key(f.Key).Accessor(TextAccessor(f.Value))
+		}).
+		TypeFactory(reflect.TypeFor[net.IP](), func(f Field) huh.Field {
+			built = append(built, f.Key)
+			return nil // fall back to the default control
+		}).
+		Form(groups)
+
+	assert.Equal(t, []string{"name", "bind"}, built)
+	_, ok := Control(form.GetFocusedField()).(*huh.Input)
+	assert.True(t, ok, "the name factory's input is focused first")
+}
+
+func TestBuilder_OptionSource(t *testing.T) {
+	type prefs struct {
+		Theme string `koanf:"theme" cfg_options:"_themes"`
+	}
+	groups := Schema(&prefs{Theme: "dark"})
+	require.Equal(t, "themes", groups[0].Fields[0].OptionSource)
+
+	calls := 0
+	New().OptionSource("themes", func() []string {
+		calls++
+		return []string{"dark", "light"}
+	}).Form(groups)
+	assert.Equal(t, 1, calls)
+}
+
+func TestBuilder_CompactIsNarrower(t *testing.T) {
+	groups := Schema(newJob())
+	assert.Less(t, New().Compact(true).Width(groups), New().Width(groups))
+}
+
+func TestWithoutAdvanced(t *testing.T) {
+	var v struct {
+		Name  string `koanf:"name"`
+		Debug bool   `koanf:"debug" cfg_advanced:"true"`
+		Tune  struct {
+			Retries int `koanf:"retries" cfg_advanced:"true"`
+		} `koanf:"tune"`
+	}
+	groups := Schema(&v)
+	require.Len(t, groups, 2)
+	assert.Equal(t, 2, AdvancedCount(groups))
+
+	basic := WithoutAdvanced(groups)
+	require.Len(t, basic, 1, "groups with only advanced fields are dropped")
+	require.Len(t, basic[0].Fields, 1)
+	assert.Equal(t, "name", basic[0].Fields[0].Key)
+	assert.Len(t, groups[0].Fields, 2, "the original groups are unchanged")
+}
+
+func TestVisibleIf(t *testing.T) {
+	var v struct {
+		Agent   string `koanf:"agent"`
+		Sandbox bool   `koanf:"sandbox"`
+		Model   string `koanf:"model" cfg_visible_if:"agent=claude"`
+		Image   string `koanf:"image" cfg_visible_if:"agent!=claude, sandbox=true" cfg_validate:"required"`
+	}
+	v.Agent = "claude"
+	groups := Schema(&v)
+	assert.Equal(t, []string{"agent", "sandbox", "model"}, Shown(groups))
+	require.NoError(t, Check(groups), "hidden fields are not validated")
+
+	v.Agent, v.Sandbox = "codex", true
+	assert.Equal(t, []string{"agent", "sandbox", "image"}, Shown(groups))
+	assert.EqualError(t, Check(groups), "Image is required")
+}
+
+func TestVisibleIf_UnknownKey(t *testing.T) {
+	var v struct {
+		Model string `koanf:"model" cfg_visible_if:"agent=claude"`
+	}
+	assert.PanicsWithValue(t, `formgen: cfg_visible_if of model names unknown field "agent"`, func() { Schema(&v) })
+}
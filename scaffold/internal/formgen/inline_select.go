@@ -1,4 +1,4 @@
-package screens
+package formgen
 
 import (
 	"charm.land/bubbles/v2/key"
@@ -8,7 +8,7 @@ import (
 )
 
 // inlineSelect wraps huh.Select to render label, description, and options
-// on a single line for compact settings forms with column alignment.
+// on a single line for compact forms with column alignment.
 // Navigation is delegated to the underlying Select via Update().
 type inlineSelect struct {
 	*huh.Select[string]
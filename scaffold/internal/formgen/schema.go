@@ -0,0 +1,285 @@
+// Package formgen builds huh forms from tagged structs. Schema reflects over a
+// struct and describes its editable fields; a Builder turns that description
+// into a form whose fields write straight back into the struct. The settings
+// screen uses it for config.Config, and apps can use it for their own domain
+// objects.
+//
+// Fields are described by struct tags:
+//
+//	koanf           key segment; json is used when absent, untagged fields are skipped
+//	cfg_label       label shown in the form; defaults to the field name
+//	cfg_desc        description shown next to or below the field
+//	cfg_options     comma-separated choices, making the field a select; "_name"
+//	                takes the choices from the Builder's option source "name"
+//	cfg_readonly    "true" shows the value without letting it be edited
+//	cfg_exclude     "true" leaves the field out
+//	cfg_advanced    "true" marks a tuning knob most users never need; see
+//	                WithoutAdvanced
+//	cfg_visible_if  comma-separated conditions such as "ui.mode=custom" or
+//	                "network.proxy!=" on other fields' keys; the field is left
+//	                out of forms unless all of them hold
+//	cfg_validate    comma-separated rules: required, min=N, max=N, url
+//	cfg_pattern     regular expression the value must match
+//
+// Struct fields of the top-level struct become groups, one form page each,
+// and its other fields are collected in a leading "General" group. Deeper
+// structs are flattened into their group with dotted keys. Strings, bools,
+// numbers, durations and slices of those are editable; other types are shown
+// read-only unless a Builder has a factory for them.
+package formgen
+
+import (
+	"encoding"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Kind classifies how a field is rendered.
+type Kind uint8
+
+const (
+	KindInput    Kind = iota // strings, numbers, slices → text input
+	KindSelect               // cfg_options              → select
+	KindConfirm              // bool                     → yes/no toggle
+	KindReadOnly             // cfg_readonly or unsupported type → note
+)
+
+// Field describes a single editable struct field.
+type Field struct {
+	Key          string // dot-path key, e.g. "ui.themeName"
+	Label        string // cfg_label, falling back to the struct field name
+	Desc         string // cfg_desc
+	Kind         Kind
+	Options      []string // non-nil only for KindSelect
+	OptionSource string   // Builder option source supplying Options; "" for none
+	ReadOnly     bool
+	Advanced     bool          // cfg_advanced
+	VisibleIf    string        // cfg_visible_if
+	Rules        string        // cfg_validate
+	Pattern      string        // cfg_pattern
+	Value        reflect.Value // settable Value pointing into the struct
+
+	conds []condition // parsed VisibleIf, resolved by Schema
+}
+
+// Group is a labelled set of fields, shown as one page of the form.
+type Group struct {
+	Label  string
+	Fields []Field
+}
+
+// Schema reflects over v, which must be a non-nil pointer to a struct so that
+// the returned Values are settable, and returns its fields in groups.
+func Schema(v any) []Group {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		panic("formgen: Schema needs a pointer to a struct, got " + rv.Type().String())
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	var groups []Group
+	var topFields []Field
+
+	for i := range rt.NumField() {
+		sf := rt.Field(i)
+		key, ok := fieldKey(sf)
+		if !ok {
+			continue
+		}
+		if sv, ok := structValue(rv.Field(i)); ok {
+			groups = append(groups, Group{
+				Label:  tagOrName(sf, "cfg_label"),
+				Fields: nestedFields(sv, key, ""),
+			})
+		} else {
+			topFields = append(topFields, leafField(sf, rv.Field(i), key, ""))
+		}
+	}
+
+	if len(topFields) > 0 {
+		groups = slices.Insert(groups, 0, Group{
+			Label:  "General",
+			Fields: topFields,
+		})
+	}
+	resolveConditions(groups)
+	return groups
+}
+
+// FieldCount returns the total number of interactive (non-readonly) fields.
+func FieldCount(groups []Group) int {
+	n := 0
+	for _, g := range groups {
+		for _, f := range g.Fields {
+			if !f.ReadOnly {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// WithoutAdvanced returns groups without their advanced fields, dropping
+// groups left empty, so a form can start short and show the rest on
+// request. The fields still point into the same struct.
+func WithoutAdvanced(groups []Group) []Group {
+	var basic []Group
+	for _, g := range groups {
+		fields := slices.DeleteFunc(slices.Clone(g.Fields), func(f Field) bool { return f.Advanced })
+		if len(fields) > 0 {
+			basic = append(basic, Group{Label: g.Label, Fields: fields})
+		}
+	}
+	return basic
+}
+
+// AdvancedCount returns the number of advanced fields in groups.
+func AdvancedCount(groups []Group) int {
+	n := 0
+	for _, g := range groups {
+		for _, f := range g.Fields {
+			if f.Advanced {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// nestedFields flattens the fields of rv, prefixing keys with prefix and
+// labels with labelPrefix.
+func nestedFields(rv reflect.Value, prefix, labelPrefix string) []Field {
+	rt := rv.Type()
+	fields := make([]Field, 0, rt.NumField())
+	for i := range rt.NumField() {
+		sf := rt.Field(i)
+		key, ok := fieldKey(sf)
+		if !ok {
+			continue
+		}
+		key = prefix + "." + key
+		if sv, ok := structValue(rv.Field(i)); ok {
+			label := labelPrefix + tagOrName(sf, "cfg_label") + " › "
+			fields = append(fields, nestedFields(sv, key, label)...)
+			continue
+		}
+		fields = append(fields, leafField(sf, rv.Field(i), key, labelPrefix))
+	}
+	return fields
+}
+
+func leafField(sf reflect.StructField, fv reflect.Value, key, labelPrefix string) Field {
+	readOnly := sf.Tag.Get("cfg_readonly") == "true" || !editable(fv.Type())
+	options, source := parseOptions(sf.Tag.Get("cfg_options"))
+	return Field{
+		Key:          key,
+		Label:        labelPrefix + tagOrName(sf, "cfg_label"),
+		Desc:         sf.Tag.Get("cfg_desc"),
+		ReadOnly:     readOnly,
+		Advanced:     sf.Tag.Get("cfg_advanced") == "true",
+		VisibleIf:    sf.Tag.Get("cfg_visible_if"),
+		conds:        parseConditions(sf.Tag.Get("cfg_visible_if")),
+		Options:      options,
+		OptionSource: source,
+		Kind:         deriveKind(fv.Kind(), options, readOnly),
+		Rules:        sf.Tag.Get("cfg_validate"),
+		Pattern:      sf.Tag.Get("cfg_pattern"),
+		Value:        fv,
+	}
+}
+
+// fieldKey returns the key segment for sf, or false when sf is skipped.
+func fieldKey(sf reflect.StructField) (string, bool) {
+	if !sf.IsExported() || sf.Tag.Get("cfg_exclude") == "true" {
+		return "", false
+	}
+	key := sf.Tag.Get("koanf")
+	if key == "" {
+		key, _, _ = strings.Cut(sf.Tag.Get("json"), ",")
+	}
+	if key == "" || key == "-" {
+		return "", false
+	}
+	return key, true
+}
+
+// structValue returns the struct fv holds, directly or through a non-nil
+// pointer. Types that edit as text, such as time.Time, are not expanded.
+func structValue(fv reflect.Value) (reflect.Value, bool) {
+	if fv.Kind() == reflect.Pointer && !fv.IsNil() {
+		fv = fv.Elem()
+	}
+	if fv.Kind() != reflect.Struct || isText(fv.Type()) {
+		return reflect.Value{}, false
+	}
+	return fv, true
+}
+
+var (
+	durationType = reflect.TypeFor[time.Duration]()
+	textType     = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// isText reports whether values of t read and write themselves as text.
+func isText(t reflect.Type) bool {
+	return reflect.PointerTo(t).Implements(textType)
+}
+
+// isList reports whether t is edited as a comma-separated list. Slices that
+// marshal themselves as text, such as net.IP, are not.
+func isList(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && !isText(t)
+}
+
+// editable reports whether formgen has a control for t.
+func editable(t reflect.Type) bool {
+	if isList(t) {
+		return t.Elem().Kind() != reflect.Slice && editable(t.Elem())
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return isText(t)
+}
+
+func deriveKind(k reflect.Kind, options []string, readOnly bool) Kind {
+	switch {
+	case readOnly:
+		return KindReadOnly
+	case k == reflect.Bool:
+		return KindConfirm
+	case k == reflect.String && options != nil:
+		return KindSelect
+	}
+	return KindInput
+}
+
+func tagOrName(sf reflect.StructField, tag string) string {
+	if v := sf.Tag.Get(tag); v != "" {
+		return v
+	}
+	return sf.Name
+}
+
+// parseOptions splits a cfg_options tag. A "_name" tag returns a non-nil
+// empty slice, which still makes the field a select, and the source name.
+func parseOptions(s string) (options []string, source string) {
+	if s == "" {
+		return nil, ""
+	}
+	if name, ok := strings.CutPrefix(s, "_"); ok {
+		return []string{}, name
+	}
+	parts := strings.Split(s, ",")
+	for i, p := range parts {
+		parts[i] = strings.TrimSpace(p)
+	}
+	return parts, ""
+}
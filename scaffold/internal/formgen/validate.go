@@ -0,0 +1,111 @@
+package formgen
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Validate checks s, the text an input holds for f, against f's type and its
+// cfg_validate and cfg_pattern rules. For numbers min and max bound the
+// value, for strings its length and for slices the number of items.
+func (f Field) Validate(s string) error {
+	t := f.Value.Type()
+	var items []string
+	if isList(t) {
+		items = splitList(s)
+		for _, item := range items {
+			if _, err := parseValue(t.Elem(), item); err != nil {
+				return fmt.Errorf("%q %w", item, err)
+			}
+		}
+	} else if _, err := parseValue(t, s); err != nil {
+		return err
+	}
+
+	for rule := range strings.SplitSeq(f.Rules, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		var err error
+		switch name {
+		case "":
+		case "required":
+			if strings.TrimSpace(s) == "" {
+				err = errors.New("is required")
+			}
+		case "min", "max":
+			err = checkBound(t, s, items, name, arg)
+		case "url":
+			if u, perr := url.Parse(s); s != "" && (perr != nil || u.Scheme == "" || u.Host == "") {
+				err = errors.New("must be a URL such as https://example.com")
+			}
+		default:
+			err = fmt.Errorf("has unknown rule %q", name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if f.Pattern != "" && s != "" {
+		re, err := regexp.Compile(f.Pattern)
+		if err != nil {
+			return fmt.Errorf("has invalid pattern: %w", err)
+		}
+		if !re.MatchString(s) {
+			return fmt.Errorf("must match %s", f.Pattern)
+		}
+	}
+	return nil
+}
+
+// checkBound applies a min or max rule with bound arg.
+func checkBound(t reflect.Type, s string, items []string, rule, arg string) error {
+	bound, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return fmt.Errorf("has invalid rule %s=%s", rule, arg)
+	}
+	var n float64
+	unit := ""
+	switch {
+	case isList(t):
+		n, unit = float64(len(items)), " items"
+	case t.Kind() == reflect.String:
+		n, unit = float64(utf8.RuneCountInString(s)), " characters"
+	case t == durationType:
+		// Bounds on durations are in seconds.
+		v, _ := parseValue(t, s)
+		n, unit = time.Duration(v.Int()).Seconds(), "s"
+	default:
+		n, _ = strconv.ParseFloat(strings.TrimSpace(s), 64)
+	}
+	switch {
+	case rule == "min" && n < bound:
+		return fmt.Errorf("must be at least %s%s", arg, unit)
+	case rule == "max" && n > bound:
+		return fmt.Errorf("must be at most %s%s", arg, unit)
+	}
+	return nil
+}
+
+// Check validates the current value of every visible editable field in
+// groups and returns the first failure, prefixed with the field's label.
+// Hidden fields are not in the form, so their values are not checked.
+func Check(groups []Group) error {
+	for _, g := range groups {
+		for _, f := range g.Fields {
+			if f.Kind != KindInput || !f.Visible() {
+				continue
+			}
+			if err := f.Validate(TextAccessor(f.Value).Get()); err != nil {
+				return fmt.Errorf("%s %w", f.Label, err)
+			}
+		}
+	}
+	return nil
+}
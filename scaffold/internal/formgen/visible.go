@@ -0,0 +1,89 @@
+package formgen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// condition is one clause of a cfg_visible_if tag: the field with the given
+// key must (or, negated, must not) hold value.
+type condition struct {
+	key    string
+	value  string
+	negate bool
+	target reflect.Value // the field named by key, set by Schema
+}
+
+// parseConditions parses a cfg_visible_if tag: comma-separated clauses of
+// the form key=value or key!=value, all of which must hold.
+func parseConditions(tag string) []condition {
+	var conds []condition
+	for clause := range strings.SplitSeq(tag, ",") {
+		clause = strings.TrimSpace(clause)
+		if clause == "" {
+			continue
+		}
+		c := condition{}
+		if key, value, ok := strings.Cut(clause, "!="); ok {
+			c.key, c.value, c.negate = key, value, true
+		} else {
+			c.key, c.value, _ = strings.Cut(clause, "=")
+		}
+		c.key, c.value = strings.TrimSpace(c.key), strings.TrimSpace(c.value)
+		conds = append(conds, c)
+	}
+	return conds
+}
+
+// resolveConditions points the conditions of every field in groups at the
+// fields they name. It panics when a condition names no field, as that is a
+// mistake in the struct tags.
+func resolveConditions(groups []Group) {
+	byKey := make(map[string]reflect.Value)
+	for _, g := range groups {
+		for _, f := range g.Fields {
+			byKey[f.Key] = f.Value
+		}
+	}
+	for _, g := range groups {
+		for i := range g.Fields {
+			f := &g.Fields[i]
+			for j := range f.conds {
+				target, ok := byKey[f.conds[j].key]
+				if !ok {
+					panic(fmt.Sprintf("formgen: cfg_visible_if of %s names unknown field %q", f.Key, f.conds[j].key))
+				}
+				f.conds[j].target = target
+			}
+		}
+	}
+}
+
+// Visible reports whether f's cfg_visible_if conditions hold for the
+// current values of the fields they name. Fields without conditions are
+// always visible. Values are compared as the form shows them, so bools
+// compare as "true" and "false".
+func (f Field) Visible() bool {
+	for _, c := range f.conds {
+		if (TextAccessor(c.target).Get() == c.value) == c.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// Shown returns the keys of the visible fields in groups, in order. Forms
+// built from groups leave hidden fields out, so a caller can rebuild its
+// form when the result changes.
+func Shown(groups []Group) []string {
+	var keys []string
+	for _, g := range groups {
+		for _, f := range g.Fields {
+			if f.Visible() {
+				keys = append(keys, f.Key)
+			}
+		}
+	}
+	return keys
+}
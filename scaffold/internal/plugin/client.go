@@ -0,0 +1,244 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+	"time"
+
+	"scaffold/internal/logger"
+)
+
+// CallTimeout bounds each call a Client makes when the caller's context has
+// no earlier deadline, so a stuck plugin cannot hang the UI's work forever.
+const CallTimeout = 5 * time.Second
+
+// shutdownGrace is how long Close waits for a plugin to exit after shutdown
+// before killing it.
+const shutdownGrace = 2 * time.Second
+
+// ErrClosed is returned by calls on a plugin whose process has exited.
+var ErrClosed = errors.New("plugin: process exited")
+
+// Client is a running plugin process. Calls are serialised, so a Client is
+// safe for concurrent use, but a slow call holds up the ones behind it.
+type Client struct {
+	Manifest Manifest
+
+	cmd     *exec.Cmd
+	stdin   io.WriteCloser
+	replies chan Response
+	done    chan struct{} // closed once the process has been waited for
+
+	mu     sync.Mutex // held for a whole request/response exchange
+	nextID int64
+}
+
+// Start runs the plugin in serve mode and initializes it. hostVersion is
+// passed on in InitializeParams. The plugin's stderr goes to the debug log.
+func Start(ctx context.Context, m Manifest, hostVersion string) (*Client, error) {
+	cmd := m.Cmd("serve")
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: %w", m.Name, err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: %w", m.Name, err)
+	}
+	cmd.Stderr = logWriter{name: m.Name}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("plugin %s: %w", m.Name, err)
+	}
+	c := &Client{
+		Manifest: m,
+		cmd:      cmd,
+		stdin:    stdin,
+		replies:  make(chan Response),
+		done:     make(chan struct{}),
+	}
+	go c.read(stdout)
+
+	params := InitializeParams{Protocol: ProtocolVersion, HostVersion: hostVersion}
+	if err := c.Call(ctx, MethodInitialize, params, nil); err != nil {
+		c.kill()
+		return nil, err
+	}
+	return c, nil
+}
+
+// read decodes responses until stdout closes, then reaps the process.
+func (c *Client) read(stdout io.Reader) {
+	sc := bufio.NewScanner(stdout)
+	sc.Buffer(make([]byte, 64*1024), 4*1024*1024) // rendered screens can be large
+	for sc.Scan() {
+		var resp Response
+		if err := json.Unmarshal(sc.Bytes(), &resp); err != nil {
+			logger.Debug("plugin %s: bad response: %v", c.Manifest.Name, err)
+			continue
+		}
+		select {
+		case c.replies <- resp:
+		case <-time.After(CallTimeout):
+			// Nobody is waiting: the call it answers has timed out.
+		}
+	}
+	err := c.cmd.Wait()
+	logger.Debug("plugin %s exited: %v", c.Manifest.Name, err)
+	close(c.done)
+}
+
+// Call sends method with params and decodes the plugin's result into
+// result, which may be nil to discard it. The call fails when ctx is done,
+// after CallTimeout, or when the plugin answers with an error.
+func (c *Client) Call(ctx context.Context, method string, params, result any) error {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("plugin %s: %s: %w", c.Manifest.Name, method, err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, CallTimeout)
+	defer cancel()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nextID++
+	id := c.nextID
+	line, _ := json.Marshal(Request{ID: id, Method: method, Params: raw})
+	if _, err := c.stdin.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("plugin %s: %s: %w", c.Manifest.Name, method, ErrClosed)
+	}
+	for {
+		select {
+		case resp := <-c.replies:
+			if resp.ID != id {
+				continue // a late answer to a call that timed out
+			}
+			if resp.Error != "" {
+				return fmt.Errorf("plugin %s: %s: %s", c.Manifest.Name, method, resp.Error)
+			}
+			if result == nil || len(resp.Result) == 0 {
+				return nil
+			}
+			if err := json.Unmarshal(resp.Result, result); err != nil {
+				return fmt.Errorf("plugin %s: %s: %w", c.Manifest.Name, method, err)
+			}
+			return nil
+		case <-c.done:
+			return fmt.Errorf("plugin %s: %s: %w", c.Manifest.Name, method, ErrClosed)
+		case <-ctx.Done():
+			return fmt.Errorf("plugin %s: %s: %w", c.Manifest.Name, method, ctx.Err())
+		}
+	}
+}
+
+// Render asks for the body of the plugin's screen.
+func (c *Client) Render(ctx context.Context, screen string, width, height int) (Reply, error) {
+	var r Reply
+	err := c.Call(ctx, MethodRender, RenderParams{Screen: screen, Width: width, Height: height}, &r)
+	return r, err
+}
+
+// Key delivers a key press to the plugin's screen.
+func (c *Client) Key(ctx context.Context, screen, key string, width, height int) (Reply, error) {
+	var r Reply
+	err := c.Call(ctx, MethodKey, KeyParams{Screen: screen, Key: key, Width: width, Height: height}, &r)
+	return r, err
+}
+
+// Status asks for the plugin's status bar segment.
+func (c *Client) Status(ctx context.Context) (string, error) {
+	var r StatusResult
+	err := c.Call(ctx, MethodStatus, nil, &r)
+	return r.Text, err
+}
+
+// Close calls shutdown, closes the plugin's stdin and waits for it to exit,
+// killing it if it has not after a short grace period.
+func (c *Client) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownGrace)
+	defer cancel()
+	err := c.Call(ctx, MethodShutdown, nil, nil)
+	_ = c.stdin.Close()
+	select {
+	case <-c.done:
+	case <-ctx.Done():
+		c.kill()
+	}
+	if errors.Is(err, ErrClosed) {
+		return nil // exited on its own before answering
+	}
+	return err
+}
+
+func (c *Client) kill() {
+	_ = c.cmd.Process.Kill()
+	<-c.done
+}
+
+// logWriter sends a plugin's stderr to the debug log.
+type logWriter struct{ name string }
+
+func (w logWriter) Write(p []byte) (int, error) {
+	logger.Debug("plugin %s: %s", w.name, p)
+	return len(p), nil
+}
+
+// Host owns the running plugins. The nil Host has no plugins, so code that
+// works with plugins need not check whether any were loaded.
+type Host struct {
+	clients []*Client
+}
+
+// StartHost starts every plugin in manifests. Plugins that fail to start
+// are skipped and reported together in the returned error; the Host holds
+// the rest.
+func StartHost(ctx context.Context, manifests []Manifest, hostVersion string) (*Host, error) {
+	h := &Host{}
+	var errs []error
+	for _, m := range manifests {
+		c, err := Start(ctx, m, hostVersion)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		h.clients = append(h.clients, c)
+	}
+	return h, errors.Join(errs...)
+}
+
+// Plugins returns the running plugins in start order.
+func (h *Host) Plugins() []*Client {
+	if h == nil {
+		return nil
+	}
+	return h.clients
+}
+
+// Lookup returns the running plugin named name.
+func (h *Host) Lookup(name string) (*Client, bool) {
+	for _, c := range h.Plugins() {
+		if c.Manifest.Name == name {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// Shutdown closes every plugin. It is the counterpart of StartHost and is
+// meant to run when the TUI exits.
+func (h *Host) Shutdown() {
+	var wg sync.WaitGroup
+	for _, c := range h.Plugins() {
+		wg.Go(func() {
+			if err := c.Close(); err != nil {
+				logger.Debug("plugin %s: shutdown: %v", c.Manifest.Name, err)
+			}
+		})
+	}
+	wg.Wait()
+}
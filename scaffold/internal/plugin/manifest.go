@@ -0,0 +1,182 @@
+// Package plugin lets separate programs extend the app without a fork.
+//
+// A plugin is a directory under <configdir>/plugins holding a plugin.json
+// manifest and usually the plugin's executable:
+//
+//	{
+//	  "name": "weather",
+//	  "version": "0.1.0",
+//	  "description": "Local forecast",
+//	  "exec": ["./weather"],
+//	  "screens": [{"id": "forecast", "title": "Forecast", "icon": "☀", "shortcut": "w"}],
+//	  "commands": [{"name": "weather", "short": "Print the forecast"}],
+//	  "status": true
+//	}
+//
+// Each screen becomes a Home menu item, each command a CLI subcommand, and
+// with "status" set the plugin contributes a segment to the status bar.
+//
+// The host runs the exec argv with "serve" appended and talks to it over
+// stdin and stdout, one JSON object per line (see [Request] and
+// [Response]). The plugin is initialized when the TUI starts and shut down
+// when it exits. CLI commands are run separately as "<exec> run <name>
+// args..." with the terminal's stdio, so they need no protocol at all.
+// Plugins written in Go can use [Main] to handle both.
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+)
+
+// ManifestFile is the name of the manifest in each plugin directory.
+const ManifestFile = "plugin.json"
+
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+// Manifest describes a plugin and what it contributes.
+type Manifest struct {
+	Name        string        `json:"name"`
+	Version     string        `json:"version,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Exec        []string      `json:"exec"` // argv; a relative program path is resolved against Dir
+	Screens     []ScreenSpec  `json:"screens,omitempty"`
+	Commands    []CommandSpec `json:"commands,omitempty"`
+	Status      bool          `json:"status,omitempty"` // polled for a status bar segment
+
+	Dir string `json:"-"` // directory the manifest was loaded from
+}
+
+// ScreenSpec is a screen a plugin renders, listed in the Home menu.
+type ScreenSpec struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Icon        string `json:"icon,omitempty"`
+	Shortcut    string `json:"shortcut,omitempty"`
+}
+
+// CommandSpec is a CLI subcommand a plugin runs.
+type CommandSpec struct {
+	Name  string `json:"name"`
+	Short string `json:"short,omitempty"`
+	Long  string `json:"long,omitempty"`
+}
+
+// LoadManifest reads and validates the manifest at path.
+func LoadManifest(path string) (Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("plugin: reading %s: %w", path, err)
+	}
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return Manifest{}, fmt.Errorf("plugin: parsing %s: %w", path, err)
+	}
+	if m.Dir, err = filepath.Abs(filepath.Dir(path)); err != nil {
+		return Manifest{}, fmt.Errorf("plugin: %s: %w", path, err)
+	}
+	if err := m.Validate(); err != nil {
+		return Manifest{}, fmt.Errorf("plugin: %s: %w", path, err)
+	}
+	return m, nil
+}
+
+// Validate reports the first problem that would stop the manifest from
+// being used: a bad name, no exec, or a screen or command without a name or
+// listed twice.
+func (m Manifest) Validate() error {
+	if !namePattern.MatchString(m.Name) {
+		return fmt.Errorf("name %q must be 1-32 lowercase letters, digits, or dashes", m.Name)
+	}
+	if len(m.Exec) == 0 || m.Exec[0] == "" {
+		return errors.New("exec is empty")
+	}
+	seen := make(map[string]bool)
+	for _, s := range m.Screens {
+		if s.ID == "" || s.Title == "" {
+			return errors.New("every screen needs an id and a title")
+		}
+		if seen[s.ID] {
+			return fmt.Errorf("screen %q listed twice", s.ID)
+		}
+		seen[s.ID] = true
+	}
+	clear(seen)
+	for _, c := range m.Commands {
+		if !namePattern.MatchString(c.Name) {
+			return fmt.Errorf("command name %q must be 1-32 lowercase letters, digits, or dashes", c.Name)
+		}
+		if seen[c.Name] {
+			return fmt.Errorf("command %q listed twice", c.Name)
+		}
+		seen[c.Name] = true
+	}
+	return nil
+}
+
+// Screen returns the screen with the given ID.
+func (m Manifest) Screen(id string) (ScreenSpec, bool) {
+	for _, s := range m.Screens {
+		if s.ID == id {
+			return s, true
+		}
+	}
+	return ScreenSpec{}, false
+}
+
+// Cmd returns the plugin's exec argv, with args appended, as a command run
+// from the plugin directory.
+func (m Manifest) Cmd(args ...string) *exec.Cmd {
+	prog := m.Exec[0]
+	if !filepath.IsAbs(prog) && filepath.Base(prog) != prog {
+		prog = filepath.Join(m.Dir, prog) // "./bin/x", not "python3" from $PATH
+	}
+	c := exec.Command(prog, append(m.Exec[1:len(m.Exec):len(m.Exec)], args...)...)
+	c.Dir = m.Dir
+	return c
+}
+
+// Discover loads the manifest of every plugin directory in dir. A missing
+// directory yields no plugins. Manifests that fail to load are skipped and
+// reported together in the returned error, alongside the plugins that did
+// load. Plugins are returned in directory order; a name already taken is
+// reported and skipped.
+func Discover(dir string) ([]Manifest, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("plugin: listing %s: %w", dir, err)
+	}
+	var out []Manifest
+	var errs []error
+	names := make(map[string]bool)
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, e.Name(), ManifestFile)
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		m, err := LoadManifest(path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if names[m.Name] {
+			errs = append(errs, fmt.Errorf("plugin: %s: name %q already taken", path, m.Name))
+			continue
+		}
+		names[m.Name] = true
+		out = append(out, m)
+	}
+	return out, errors.Join(errs...)
+}
@@ -0,0 +1,177 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// helperEnv makes the test binary act as a plugin: the tests start
+// themselves as the plugin process.
+const helperEnv = "SCAFFOLD_PLUGIN_HELPER"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(helperEnv) == "1" {
+		Main(counterPlugin())
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// counterPlugin counts key presses and closes its screen on x.
+func counterPlugin() Handler {
+	count := 0
+	body := func(p RenderParams) string {
+		return fmt.Sprintf("%s: %d (%dx%d)", p.Screen, count, p.Width, p.Height)
+	}
+	return Handler{
+		Initialize: func(p InitializeParams) error {
+			if p.Protocol != ProtocolVersion {
+				return fmt.Errorf("protocol %d", p.Protocol)
+			}
+			return nil
+		},
+		Render: func(p RenderParams) (Reply, error) {
+			return Reply{Body: body(p)}, nil
+		},
+		Key: func(p KeyParams) (Reply, error) {
+			switch p.Key {
+			case "x":
+				return Reply{Close: true, Status: "bye"}, nil
+			case "!":
+				return Reply{}, errors.New("boom")
+			}
+			count++
+			return Reply{Body: body(RenderParams{Screen: p.Screen, Width: p.Width, Height: p.Height})}, nil
+		},
+		Status: func() (string, error) { return fmt.Sprintf("%d presses", count), nil },
+		Commands: map[string]func([]string) error{
+			"echo": func(args []string) error {
+				fmt.Println(strings.Join(args, " "))
+				return nil
+			},
+		},
+	}
+}
+
+// helperManifest returns a manifest that runs the test binary as a plugin.
+func helperManifest(t *testing.T) Manifest {
+	t.Helper()
+	t.Setenv(helperEnv, "1")
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	return Manifest{Name: "counter", Exec: []string{exe}, Dir: t.TempDir(), Status: true}
+}
+
+func writeManifest(t *testing.T, dir, name, body string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, name), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name, ManifestFile), []byte(body), 0o644))
+}
+
+func TestDiscover(t *testing.T) {
+	dir := t.TempDir()
+	writeManifest(t, dir, "a", `{"name": "alpha", "exec": ["./alpha"],
+		"screens": [{"id": "main", "title": "Alpha"}], "commands": [{"name": "alpha"}]}`)
+	writeManifest(t, dir, "b", `{"name": "Bad Name", "exec": ["x"]}`)
+	writeManifest(t, dir, "c", `{"name": "alpha", "exec": ["x"]}`)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "no-manifest"), 0o755))
+
+	got, err := Discover(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Bad Name")
+	assert.Contains(t, err.Error(), "already taken")
+	require.Len(t, got, 1)
+	assert.Equal(t, "alpha", got[0].Name)
+	assert.True(t, filepath.IsAbs(got[0].Dir))
+	assert.Equal(t, filepath.Join(got[0].Dir, "alpha"), got[0].Cmd("serve").Path)
+
+	got, err = Discover(filepath.Join(dir, "missing"))
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestValidate(t *testing.T) {
+	ok := Manifest{Name: "ok", Exec: []string{"x"}}
+	assert.NoError(t, ok.Validate())
+
+	cases := map[string]Manifest{
+		"no exec":          {Name: "ok"},
+		"untitled screen":  {Name: "ok", Exec: []string{"x"}, Screens: []ScreenSpec{{ID: "a"}}},
+		"duplicate screen": {Name: "ok", Exec: []string{"x"}, Screens: []ScreenSpec{{ID: "a", Title: "A"}, {ID: "a", Title: "B"}}},
+		"bad command name": {Name: "ok", Exec: []string{"x"}, Commands: []CommandSpec{{Name: "Run It"}}},
+	}
+	for name, m := range cases {
+		assert.Error(t, m.Validate(), name)
+	}
+}
+
+func TestClientLifecycle(t *testing.T) {
+	ctx := context.Background()
+	h, err := StartHost(ctx, []Manifest{helperManifest(t)}, "test")
+	require.NoError(t, err)
+	c, ok := h.Lookup("counter")
+	require.True(t, ok)
+
+	r, err := c.Render(ctx, "main", 40, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "main: 0 (40x10)", r.Body)
+
+	r, err = c.Key(ctx, "main", "j", 40, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "main: 1 (40x10)", r.Body)
+
+	_, err = c.Key(ctx, "main", "!", 40, 10)
+	assert.ErrorContains(t, err, "boom")
+
+	r, err = c.Key(ctx, "main", "x", 40, 10)
+	require.NoError(t, err)
+	assert.True(t, r.Close)
+	assert.Equal(t, "bye", r.Status)
+
+	text, err := c.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "1 presses", text)
+
+	h.Shutdown()
+	_, err = c.Status(ctx)
+	assert.ErrorIs(t, err, ErrClosed)
+}
+
+func TestStartHostSkipsBrokenPlugins(t *testing.T) {
+	broken := Manifest{Name: "broken", Exec: []string{"./does-not-exist"}, Dir: t.TempDir()}
+	h, err := StartHost(context.Background(), []Manifest{broken, helperManifest(t)}, "test")
+	assert.ErrorContains(t, err, "broken")
+	require.Len(t, h.Plugins(), 1)
+	assert.Equal(t, "counter", h.Plugins()[0].Manifest.Name)
+	h.Shutdown()
+
+	var none *Host
+	assert.Empty(t, none.Plugins())
+	none.Shutdown()
+}
+
+func TestRunCommand(t *testing.T) {
+	out, err := helperManifest(t).Cmd("run", "echo", "hello", "world").Output()
+	require.NoError(t, err)
+	assert.Equal(t, "hello world\n", string(out))
+}
+
+func TestServeUnsupportedMethod(t *testing.T) {
+	in := strings.NewReader(`{"id": 1, "method": "render", "params": {"screen": "x"}}` + "\n" +
+		`{"id": 2, "method": "shutdown"}` + "\n" +
+		`{"id": 3, "method": "status"}` + "\n")
+	var out bytes.Buffer
+	require.NoError(t, Serve(in, &out, Handler{}))
+	assert.Equal(t,
+		`{"id":1,"error":"method \"render\" not supported"}`+"\n"+`{"id":2}`+"\n",
+		out.String(), "nothing is read after shutdown")
+}
@@ -0,0 +1,80 @@
+package plugin
+
+import "encoding/json"
+
+// ProtocolVersion is sent with initialize so plugins can refuse a host that
+// speaks a protocol they do not know.
+const ProtocolVersion = 1
+
+// Methods the host calls. Plugins answer the ones they do not implement with
+// an error; only initialize and shutdown are sent to every plugin.
+const (
+	// MethodInitialize is the first call, with InitializeParams. It is the
+	// plugin's start hook.
+	MethodInitialize = "initialize"
+	// MethodRender asks for a screen's body, with RenderParams, and expects
+	// a Reply.
+	MethodRender = "render"
+	// MethodKey delivers a key press on a screen, with KeyParams, and
+	// expects a Reply holding the new body.
+	MethodKey = "key"
+	// MethodStatus asks for the status bar segment and expects a
+	// StatusResult. It is only sent to plugins whose manifest sets status.
+	MethodStatus = "status"
+	// MethodShutdown is the last call, made when the TUI exits. The plugin
+	// should answer and then exit; it is killed if it has not shortly after.
+	MethodShutdown = "shutdown"
+)
+
+// Request is one line the host writes to the plugin's stdin.
+type Request struct {
+	ID     int64           `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// Response is one line the plugin writes to stdout in answer to the Request
+// with the same ID. Error, when set, fails the call and Result is ignored.
+type Response struct {
+	ID     int64           `json:"id"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// InitializeParams are the params of initialize.
+type InitializeParams struct {
+	Protocol    int    `json:"protocol"`
+	HostVersion string `json:"hostVersion"`
+}
+
+// RenderParams are the params of render. Width and Height are the space the
+// body has; lines past them are cut off.
+type RenderParams struct {
+	Screen string `json:"screen"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// KeyParams are the params of key. Key is written as Bubble Tea names keys,
+// e.g. "j", "enter" or "ctrl+r". esc never arrives, as it always goes back,
+// and neither do the app's global keys such as q.
+type KeyParams struct {
+	Screen string `json:"screen"`
+	Key    string `json:"key"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// Reply is the result of render and key. Body may contain ANSI styling.
+// Close asks for the screen to be closed, and Status, when set, is shown as
+// a status message.
+type Reply struct {
+	Body   string `json:"body"`
+	Close  bool   `json:"close,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// StatusResult is the result of status. An empty Text hides the segment.
+type StatusResult struct {
+	Text string `json:"text"`
+}
@@ -0,0 +1,134 @@
+package plugin
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Handler implements a plugin in Go. Nil funcs answer their method with an
+// error, except Initialize and Shutdown, which are optional hooks.
+type Handler struct {
+	Initialize func(InitializeParams) error
+	Render     func(RenderParams) (Reply, error)
+	Key        func(KeyParams) (Reply, error)
+	Status     func() (string, error)
+	Shutdown   func() error
+
+	// Commands run the manifest's CLI commands, keyed by name, with the
+	// arguments that followed the command on the command line.
+	Commands map[string]func(args []string) error
+}
+
+// Main is the entry point of a Go plugin. It serves the protocol on stdin
+// and stdout when run with "serve", runs a command when run with "run
+// <name> args...", and exits non-zero on failure.
+func Main(h Handler) {
+	if err := dispatch(os.Args[1:], os.Stdin, os.Stdout, h); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func dispatch(args []string, in io.Reader, out io.Writer, h Handler) error {
+	if len(args) == 0 {
+		return errors.New(`usage: serve | run <command> [args...]`)
+	}
+	switch args[0] {
+	case "serve":
+		return Serve(in, out, h)
+	case "run":
+		if len(args) < 2 {
+			return errors.New("run: missing command name")
+		}
+		fn, ok := h.Commands[args[1]]
+		if !ok {
+			return fmt.Errorf("run: unknown command %q", args[1])
+		}
+		return fn(args[2:])
+	}
+	return fmt.Errorf("unknown mode %q", args[0])
+}
+
+// Serve answers requests read from in on out until shutdown or the end of
+// in.
+func Serve(in io.Reader, out io.Writer, h Handler) error {
+	sc := bufio.NewScanner(in)
+	sc.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	enc := json.NewEncoder(out)
+	for sc.Scan() {
+		var req Request
+		if err := json.Unmarshal(sc.Bytes(), &req); err != nil {
+			return fmt.Errorf("plugin: bad request: %w", err)
+		}
+		result, err := h.handle(req)
+		resp := Response{ID: req.ID}
+		if err != nil {
+			resp.Error = err.Error()
+		} else if result != nil {
+			resp.Result, _ = json.Marshal(result)
+		}
+		if err := enc.Encode(resp); err != nil {
+			return err
+		}
+		if req.Method == MethodShutdown {
+			return nil
+		}
+	}
+	return sc.Err()
+}
+
+// handle runs the func for req's method and returns its result.
+func (h Handler) handle(req Request) (any, error) {
+	switch req.Method {
+	case MethodInitialize:
+		var p InitializeParams
+		if err := decodeParams(req, &p); err != nil || h.Initialize == nil {
+			return nil, err
+		}
+		return nil, h.Initialize(p)
+	case MethodRender:
+		var p RenderParams
+		if err := decodeParams(req, &p); err != nil {
+			return nil, err
+		}
+		if h.Render == nil {
+			break
+		}
+		return h.Render(p)
+	case MethodKey:
+		var p KeyParams
+		if err := decodeParams(req, &p); err != nil {
+			return nil, err
+		}
+		if h.Key == nil {
+			break
+		}
+		return h.Key(p)
+	case MethodStatus:
+		if h.Status == nil {
+			break
+		}
+		text, err := h.Status()
+		return StatusResult{Text: text}, err
+	case MethodShutdown:
+		if h.Shutdown == nil {
+			return nil, nil
+		}
+		return nil, h.Shutdown()
+	}
+	return nil, fmt.Errorf("method %q not supported", req.Method)
+}
+
+func decodeParams(req Request, v any) error {
+	if len(req.Params) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(req.Params, v); err != nil {
+		return fmt.Errorf("%s: bad params: %w", req.Method, err)
+	}
+	return nil
+}
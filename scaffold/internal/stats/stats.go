@@ -0,0 +1,185 @@
+// Package stats keeps usage counts on the local machine so that users can
+// attach them to bug reports. Nothing is ever sent anywhere: the counts are
+// written to a file next to the config and only leave the machine when the
+// user exports them (see Report) and shares the result themselves.
+//
+// Only counts are kept. Screens are recorded by route, without parameters,
+// and errors by the screen they were shown on, never by their text.
+package stats
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// File is the name of the stats file, stored next to the config file.
+const File = "stats.json"
+
+// Stats are the usage counts accumulated across sessions.
+type Stats struct {
+	Sessions   int            `json:"sessions"`   // times the TUI was started
+	Iterations int            `json:"iterations"` // user inputs handled: keys, clicks, scrolls, pastes
+	Screens    map[string]int `json:"screens"`    // visits per screen route
+	Errors     map[string]int `json:"errors"`     // errors shown, per screen
+	FirstSeen  time.Time      `json:"firstSeen"`  // start of the first session
+	LastSeen   time.Time      `json:"lastSeen"`   // start of the latest session
+}
+
+// Load reads the stats at path. A missing file yields empty stats.
+func Load(path string) (Stats, error) {
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Stats{}, nil
+	}
+	if err != nil {
+		return Stats{}, fmt.Errorf("stats: reading %s: %w", path, err)
+	}
+	var s Stats
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return Stats{}, fmt.Errorf("stats: decoding %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Save writes s to path.
+func (s Stats) Save(path string) error {
+	out, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("stats: encoding: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("stats: creating directory: %w", err)
+	}
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		return fmt.Errorf("stats: writing %s: %w", path, err)
+	}
+	return nil
+}
+
+// Recorder counts usage during a session and saves it to its file. A nil
+// Recorder, for when recording is off, ignores every call.
+type Recorder struct {
+	mu    sync.Mutex
+	path  string
+	stats Stats
+}
+
+// Open returns a Recorder adding to the stats saved at path. Unreadable
+// stats are reported and started afresh, so the returned Recorder is always
+// usable.
+func Open(path string) (*Recorder, error) {
+	s, err := Load(path)
+	return &Recorder{path: path, stats: s}, err
+}
+
+// StartSession counts a session starting at now.
+func (r *Recorder) StartSession(now time.Time) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stats.Sessions++
+	if r.stats.FirstSeen.IsZero() {
+		r.stats.FirstSeen = now
+	}
+	r.stats.LastSeen = now
+}
+
+// Iteration counts one user input handled by the update loop, such as a key
+// press or a click.
+func (r *Recorder) Iteration() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stats.Iterations++
+}
+
+// Screen counts a visit to the screen with the given route.
+func (r *Recorder) Screen(route string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	increment(&r.stats.Screens, route)
+}
+
+// Error counts an error shown while the screen with the given route was
+// active.
+func (r *Recorder) Error(route string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	increment(&r.stats.Errors, route)
+}
+
+// increment adds one to (*m)[key], creating the map if needed.
+func increment(m *map[string]int, key string) {
+	if *m == nil {
+		*m = make(map[string]int)
+	}
+	(*m)[key]++
+}
+
+// Stats returns a copy of the counts so far.
+func (r *Recorder) Stats() Stats {
+	if r == nil {
+		return Stats{}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s := r.stats
+	s.Screens = maps.Clone(s.Screens)
+	s.Errors = maps.Clone(s.Errors)
+	return s
+}
+
+// Save writes the counts to the Recorder's file.
+func (r *Recorder) Save() error {
+	if r == nil {
+		return nil
+	}
+	return r.Stats().Save(r.path)
+}
+
+// Report is the shareable form of Stats, with the build and platform they
+// were recorded on.
+type Report struct {
+	GeneratedAt time.Time `json:"generatedAt"`
+	Version     string    `json:"version"`
+	OS          string    `json:"os"`
+	Arch        string    `json:"arch"`
+	GoVersion   string    `json:"goVersion"`
+	Stats
+}
+
+// NewReport returns a report of s for the given app version, generated at
+// now. Empty counts are reported as {} rather than null.
+func NewReport(s Stats, version string, now time.Time) Report {
+	if s.Screens == nil {
+		s.Screens = map[string]int{}
+	}
+	if s.Errors == nil {
+		s.Errors = map[string]int{}
+	}
+	return Report{
+		GeneratedAt: now,
+		Version:     version,
+		OS:          runtime.GOOS,
+		Arch:        runtime.GOARCH,
+		GoVersion:   runtime.Version(),
+		Stats:       s,
+	}
+}
@@ -0,0 +1,80 @@
+package stats
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder_AccumulatesAcrossSessions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", File)
+	first := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	r, err := Open(path)
+	require.NoError(t, err)
+	r.StartSession(first)
+	r.Screen("home")
+	r.Screen("settings")
+	r.Iteration()
+	r.Error("settings")
+	require.NoError(t, r.Save())
+
+	r, err = Open(path)
+	require.NoError(t, err)
+	r.StartSession(first.Add(time.Hour))
+	r.Screen("home")
+	r.Iteration()
+	require.NoError(t, r.Save())
+
+	s, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, Stats{
+		Sessions:   2,
+		Iterations: 2,
+		Screens:    map[string]int{"home": 2, "settings": 1},
+		Errors:     map[string]int{"settings": 1},
+		FirstSeen:  first,
+		LastSeen:   first.Add(time.Hour),
+	}, s)
+}
+
+func TestOpen_StartsAfreshOnCorruptFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), File)
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o644))
+
+	r, err := Open(path)
+	assert.Error(t, err)
+	require.NotNil(t, r)
+	r.Iteration()
+	assert.Equal(t, 1, r.Stats().Iterations)
+}
+
+func TestRecorder_NilIsANoOp(t *testing.T) {
+	var r *Recorder
+	r.StartSession(time.Now())
+	r.Iteration()
+	r.Screen("home")
+	r.Error("home")
+	assert.NoError(t, r.Save())
+	assert.Equal(t, Stats{}, r.Stats())
+}
+
+func TestNewReport_EmbedsStats(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	rep := NewReport(Stats{Sessions: 3, Screens: map[string]int{"home": 1}}, "1.2.3", now)
+	raw, err := json.Marshal(rep)
+	require.NoError(t, err)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(raw, &got))
+	assert.Equal(t, "1.2.3", got["version"])
+	assert.Equal(t, float64(3), got["sessions"], "stats are inlined")
+	assert.Equal(t, map[string]any{"home": float64(1)}, got["screens"])
+	assert.Equal(t, map[string]any{}, got["errors"])
+	assert.Contains(t, got, "os")
+}
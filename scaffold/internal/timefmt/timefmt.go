@@ -0,0 +1,147 @@
+// Package timefmt formats durations and times for display, so every part of
+// the UI says "in 42m" or "at 15:30" the same way.
+//
+// A Formatter shows times either relative to now ("2m ago", "in 42m") or as
+// clock times and dates ("15:30", "2026-01-02 15:30"), as the user prefers.
+// Clock times follow the locale's 12- or 24-hour convention and dates use
+// the configured layout. Like the breaks package, nothing here reads the
+// clock: callers pass now.
+package timefmt
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Style selects how a Formatter shows times.
+type Style string
+
+const (
+	// Relative shows times as a distance from now: "2m ago", "in 42m".
+	Relative Style = "relative"
+	// Absolute shows times on the clock, with the date when it is not today.
+	Absolute Style = "absolute"
+)
+
+// DefaultDateLayout is the date layout used when none is configured.
+const DefaultDateLayout = "2006-01-02"
+
+// Clock layouts for the two locale conventions.
+const (
+	clock24 = "15:04"
+	clock12 = "3:04 PM"
+)
+
+// twelveHour lists the territories whose locales write 12-hour clock times.
+var twelveHour = map[string]bool{
+	"US": true, "CA": true, "AU": true, "NZ": true, "PH": true, "IN": true, "PK": true, "EG": true,
+}
+
+// Formatter formats durations and times in one style. The zero value shows
+// relative times, 24-hour clocks and DefaultDateLayout dates.
+type Formatter struct {
+	style Style
+	date  string // date layout
+	clock string // clock layout
+}
+
+// New returns a Formatter in style, writing dates with the Go layout date
+// and clock times as locale, such as "en_US.UTF-8", does. Unknown styles are
+// relative; an empty layout is DefaultDateLayout.
+func New(style Style, date, locale string) Formatter {
+	return Formatter{style: style, date: date, clock: ClockLayout(locale)}
+}
+
+// Style returns the style f formats in.
+func (f Formatter) Style() Style {
+	if f.style == Absolute {
+		return Absolute
+	}
+	return Relative
+}
+
+// Locale returns the locale that governs time formatting in environ, a list
+// of KEY=value pairs as from os.Environ: LC_ALL, else LC_TIME, else LANG.
+func Locale(environ []string) string {
+	vars := map[string]string{}
+	for _, kv := range environ {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			vars[k] = v
+		}
+	}
+	for _, k := range []string{"LC_ALL", "LC_TIME", "LANG"} {
+		if v := vars[k]; v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// ClockLayout returns the Go layout of a clock time in locale: 12-hour for
+// territories that use it, such as en_US, and 24-hour otherwise, including
+// for the C and POSIX locales.
+func ClockLayout(locale string) string {
+	// language[_TERRITORY][.codeset][@modifier]
+	locale, _, _ = strings.Cut(locale, ".")
+	locale, _, _ = strings.Cut(locale, "@")
+	if _, territory, ok := strings.Cut(locale, "_"); ok && twelveHour[strings.ToUpper(territory)] {
+		return clock12
+	}
+	return clock24
+}
+
+// Duration formats d in whole minutes, rounded up so that a running timer
+// never shows 0m, or as hours and minutes from an hour up: "42m", "1h30m".
+func Duration(d time.Duration) string {
+	m := int((d + time.Minute - 1) / time.Minute)
+	if m < 60 {
+		return fmt.Sprintf("%dm", m)
+	}
+	if m%60 == 0 {
+		return fmt.Sprintf("%dh", m/60)
+	}
+	return fmt.Sprintf("%dh%02dm", m/60, m%60)
+}
+
+// Since formats t, a time before now: "2m ago", or "just now" within the
+// minute, in relative style, and a Stamp in absolute style.
+func (f Formatter) Since(t, now time.Time) string {
+	if f.Style() == Absolute {
+		return f.Stamp(t, now)
+	}
+	d := now.Sub(t)
+	if d < time.Minute {
+		return "just now"
+	}
+	// Elapsed time is rounded down, so a minute has passed when it says so.
+	return Duration(d.Truncate(time.Minute)) + " ago"
+}
+
+// Until formats t, a time after now, to follow a verb: "in 42m" in relative
+// style and "at 15:30" in absolute style.
+func (f Formatter) Until(t, now time.Time) string {
+	if f.Style() == Absolute {
+		return "at " + f.Stamp(t, now)
+	}
+	return "in " + Duration(t.Sub(now))
+}
+
+// Stamp formats t as a clock time when it falls on the same day as now, and
+// as a date and clock time otherwise.
+func (f Formatter) Stamp(t, now time.Time) string {
+	clock := f.clock
+	if clock == "" {
+		clock = clock24
+	}
+	ty, tm, td := t.Date()
+	ny, nm, nd := now.Date()
+	if ty == ny && tm == nm && td == nd {
+		return t.Format(clock)
+	}
+	date := f.date
+	if date == "" {
+		date = DefaultDateLayout
+	}
+	return t.Format(date + " " + clock)
+}
@@ -0,0 +1,59 @@
+package timefmt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "0m"},
+		{time.Second, "1m"},
+		{42 * time.Minute, "42m"},
+		{59*time.Minute + time.Second, "1h"},
+		{time.Hour, "1h"},
+		{65 * time.Minute, "1h05m"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Duration(tt.d), tt.d.String())
+	}
+}
+
+func TestFormatter_Relative(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 30, 0, 0, time.UTC)
+	var f Formatter
+	assert.Equal(t, Relative, f.Style())
+	assert.Equal(t, "just now", f.Since(now.Add(-30*time.Second), now))
+	assert.Equal(t, "2m ago", f.Since(now.Add(-2*time.Minute-50*time.Second), now), "elapsed time rounds down")
+	assert.Equal(t, "1h30m ago", f.Since(now.Add(-90*time.Minute), now))
+	assert.Equal(t, "in 42m", f.Until(now.Add(41*time.Minute+10*time.Second), now), "time left rounds up")
+}
+
+func TestFormatter_Absolute(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 30, 0, 0, time.UTC)
+	f := New(Absolute, "", "de_DE.UTF-8")
+	assert.Equal(t, "15:28", f.Since(now.Add(-2*time.Minute), now))
+	assert.Equal(t, "at 16:12", f.Until(now.Add(42*time.Minute), now))
+	assert.Equal(t, "2026-01-01 15:30", f.Since(now.AddDate(0, 0, -1), now), "other days show the date")
+
+	f = New(Absolute, "Jan 2", "en_US.UTF-8")
+	assert.Equal(t, "3:28 PM", f.Since(now.Add(-2*time.Minute), now))
+	assert.Equal(t, "Jan 3 9:00 AM", f.Stamp(time.Date(2026, 1, 3, 9, 0, 0, 0, time.UTC), now))
+}
+
+func TestLocale(t *testing.T) {
+	assert.Equal(t, "en_GB.UTF-8", Locale([]string{"LANG=en_GB.UTF-8", "HOME=/root"}))
+	assert.Equal(t, "en_US.UTF-8", Locale([]string{"LANG=en_GB.UTF-8", "LC_TIME=en_US.UTF-8"}))
+	assert.Equal(t, "C", Locale([]string{"LC_TIME=en_US.UTF-8", "LC_ALL=C"}))
+	assert.Empty(t, Locale(nil))
+
+	assert.Equal(t, "3:04 PM", ClockLayout("en_US.UTF-8"))
+	assert.Equal(t, "3:04 PM", ClockLayout("en_au@euro"))
+	assert.Equal(t, "15:04", ClockLayout("en_GB"))
+	assert.Equal(t, "15:04", ClockLayout("C"))
+}
@@ -0,0 +1,127 @@
+// Package ui — break reminders for rootModel.
+package ui
+
+import (
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"scaffold/internal/breaks"
+	"scaffold/internal/timefmt"
+	"scaffold/internal/ui/modal"
+	"scaffold/internal/ui/status"
+	"scaffold/internal/ui/statusbar"
+)
+
+// breakInterval is how often the break timer is checked and its status bar
+// segment, which shows whole minutes, refreshed.
+const breakInterval = 20 * time.Second
+
+// breakModalID identifies the break reminder dialog.
+const breakModalID = "break-reminder"
+
+// breakSegment is the status bar segment source of the break timer.
+const breakSegment = "breaks"
+
+// breakTickMsg checks the break timer. Ticks from a timer that has since
+// been restarted carry an older gen and are ignored.
+type breakTickMsg struct {
+	gen int
+}
+
+// BreakHooks let the app pause its own work, such as a running loop, for a
+// break the user agreed to take. Either may be nil.
+type BreakHooks struct {
+	Pause  func() // called when the user accepts a break
+	Resume func() // called when the break is over
+}
+
+// WithBreakHooks returns m with h called around breaks.
+func (m rootModel) WithBreakHooks(h BreakHooks) rootModel {
+	m.breakHooks = h
+	return m
+}
+
+// startBreaks starts the break timer from now as the config says, or stops
+// it when break reminders are off. Run the command from breakTick to keep
+// it going.
+func (m *rootModel) startBreaks(now time.Time) {
+	m.breakGen++
+	if !m.cfg.Breaks.Enabled {
+		m.breaks = breaks.Timer{}
+		m.statusbar, _ = m.statusbar.Update(statusbar.SegmentMsg{Source: breakSegment})
+		return
+	}
+	m.breaks = breaks.New(
+		time.Duration(m.cfg.Breaks.WorkMinutes)*time.Minute,
+		time.Duration(m.cfg.Breaks.BreakMinutes)*time.Minute,
+		now,
+	)
+	m.showBreakSegment(now)
+}
+
+// breakTick returns the command for the next check of the break timer, or
+// nil when break reminders are off.
+func (m rootModel) breakTick() tea.Cmd {
+	if !m.cfg.Breaks.Enabled {
+		return nil
+	}
+	gen := m.breakGen
+	return tea.Tick(breakInterval, func(time.Time) tea.Msg { return breakTickMsg{gen: gen} })
+}
+
+// showBreakSegment shows the time to the next break, or left of the current
+// one, in the status bar.
+func (m *rootModel) showBreakSegment(now time.Time) {
+	m.statusbar, _ = m.statusbar.Update(statusbar.SegmentMsg{Source: breakSegment, Text: m.breaks.Segment(now, m.timeFormat())})
+}
+
+func (m rootModel) handleBreakTick(msg breakTickMsg) (tea.Model, tea.Cmd) {
+	if msg.gen != m.breakGen || !m.cfg.Breaks.Enabled {
+		return m, nil
+	}
+	now := time.Now()
+	// Another dialog is being answered; remind on a later tick.
+	if m.modal.Visible() {
+		return m, m.breakTick()
+	}
+	var cmd tea.Cmd
+	var event breaks.Event
+	worked := m.breaks.Worked(now)
+	m.breaks, event = m.breaks.Tick(now)
+	switch event {
+	case breaks.ReminderDue:
+		m.modal = modal.New(modal.ShowMsg{
+			ID:    breakModalID,
+			Kind:  modal.KindConfirm,
+			Title: "Time for a break",
+			Body: "This session has run " + timefmt.Duration(worked) +
+				" — take a " + timefmt.Duration(m.breaks.Break()) + " break?",
+		}, m.themeMgr.State().Palette)
+	case breaks.BreakOver:
+		if m.breakHooks.Resume != nil {
+			m.breakHooks.Resume()
+		}
+		cmd = status.SetInfo("Break over — welcome back", 0)
+	}
+	m.showBreakSegment(now)
+	return m, tea.Batch(cmd, m.breakTick())
+}
+
+// handleBreakAnswer starts the break the user accepted, pausing the app's
+// work, or puts the reminder off.
+func (m rootModel) handleBreakAnswer(accepted bool) (tea.Model, tea.Cmd) {
+	m.modal = modal.Model{}
+	now := time.Now()
+	if !accepted {
+		m.breaks = m.breaks.Decline(now)
+		m.showBreakSegment(now)
+		return m, status.SetInfo("Reminding you again "+m.timeFormat().Until(now.Add(m.breaks.Remaining(now)), now), 0)
+	}
+	m.breaks = m.breaks.TakeBreak(now)
+	if m.breakHooks.Pause != nil {
+		m.breakHooks.Pause()
+	}
+	m.showBreakSegment(now)
+	return m, status.SetInfo("Enjoy your break — back "+m.timeFormat().Until(now.Add(m.breaks.Break()), now), 0)
+}
@@ -0,0 +1,309 @@
+// Package colorpicker provides a theme-aware color editing field: a hex input
+// with a live swatch, HCL sliders adjusted with the arrow keys, and a preview
+// of the nearest ANSI 256 and 16 colors for terminals without true color.
+package colorpicker
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	colorful "github.com/lucasb-eyer/go-colorful"
+
+	"scaffold/internal/ui/theme"
+)
+
+// ChangedMsg is sent whenever the picked color changes, so owners can apply
+// it live.
+type ChangedMsg struct {
+	ID    string
+	Color color.Color
+}
+
+// row identifies the focused line of the picker.
+type row int
+
+const (
+	rowHex row = iota
+	rowHue
+	rowChroma
+	rowLuminance
+	rowCount
+)
+
+const (
+	sliderWidth = 24  // cells a slider track occupies
+	maxChroma   = 1.4 // covers the most saturated sRGB primaries
+)
+
+// KeyMap defines the picker's key bindings.
+type KeyMap struct {
+	Up           key.Binding
+	Down         key.Binding
+	Increase     key.Binding
+	Decrease     key.Binding
+	IncreaseFine key.Binding
+	DecreaseFine key.Binding
+}
+
+// DefaultKeyMap returns the default picker key bindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up"),
+			key.WithHelp("↑/↓", "hex/hue/chroma/luminance"),
+		),
+		Down:         key.NewBinding(key.WithKeys("down")),
+		Increase:     key.NewBinding(key.WithKeys("right"), key.WithHelp("←/→", "adjust")),
+		Decrease:     key.NewBinding(key.WithKeys("left")),
+		IncreaseFine: key.NewBinding(key.WithKeys("shift+right"), key.WithHelp("shift+←/→", "fine adjust")),
+		DecreaseFine: key.NewBinding(key.WithKeys("shift+left")),
+	}
+}
+
+// Model is a color picker field. The zero value is not usable; call New.
+type Model struct {
+	id      string
+	label   string
+	hex     textinput.Model
+	h, c, l float64 // HCL components: h in [0,360), c in [0,maxChroma], l in [0,1]
+	row     row
+	focused bool
+	keys    KeyMap
+	palette theme.Palette
+}
+
+// New creates a picker identified by id in ChangedMsg, showing label and
+// starting at c.
+func New(id, label string, c color.Color) Model {
+	hex := textinput.New()
+	hex.Prompt = ""
+	hex.CharLimit = 7
+	hex.SetWidth(8)
+	m := Model{id: id, label: label, hex: hex, keys: DefaultKeyMap()}
+	m.SetColor(c)
+	return m
+}
+
+// ID returns the identifier sent in ChangedMsg.
+func (m Model) ID() string { return m.id }
+
+// Color returns the picked color.
+func (m Model) Color() color.Color {
+	return colorful.Hcl(m.h, m.c, m.l).Clamped()
+}
+
+// Hex returns the picked color as #rrggbb.
+func (m Model) Hex() string {
+	return colorful.Hcl(m.h, m.c, m.l).Clamped().Hex()
+}
+
+// SetColor replaces the picked color without emitting ChangedMsg.
+func (m *Model) SetColor(c color.Color) {
+	cf, ok := colorful.MakeColor(c)
+	if !ok {
+		return
+	}
+	m.setHcl(cf)
+	m.hex.SetValue(cf.Clamped().Hex())
+}
+
+func (m *Model) setHcl(cf colorful.Color) {
+	h, c, l := cf.Hcl()
+	m.h = wrapHue(h)
+	m.c = math.Max(0, math.Min(maxChroma, c))
+	m.l = clamp01(l)
+}
+
+// Focus focuses the picker. The hex input only takes keys while its row is
+// selected.
+func (m *Model) Focus() tea.Cmd {
+	m.focused = true
+	if m.row == rowHex {
+		return m.hex.Focus()
+	}
+	return nil
+}
+
+// Blur removes focus and normalises the hex input to the picked color.
+func (m *Model) Blur() {
+	m.focused = false
+	m.hex.Blur()
+	m.hex.SetValue(m.Hex())
+}
+
+// Focused reports whether the picker has focus.
+func (m Model) Focused() bool { return m.focused }
+
+// ApplyPalette restyles the picker chrome.
+func (m *Model) ApplyPalette(p theme.Palette) {
+	m.palette = p
+}
+
+// Update handles row selection, slider adjustment, and hex entry. It returns
+// a ChangedMsg command whenever the color changes.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.focused {
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyPressMsg)
+	if !ok {
+		var cmd tea.Cmd
+		m.hex, cmd = m.hex.Update(msg)
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(keyMsg, m.keys.Up):
+		return m, m.selectRow((m.row + rowCount - 1) % rowCount)
+	case key.Matches(keyMsg, m.keys.Down):
+		return m, m.selectRow((m.row + 1) % rowCount)
+	}
+
+	if m.row != rowHex {
+		step := 0.0
+		switch {
+		case key.Matches(keyMsg, m.keys.Increase):
+			step = 1
+		case key.Matches(keyMsg, m.keys.Decrease):
+			step = -1
+		case key.Matches(keyMsg, m.keys.IncreaseFine):
+			step = 0.2
+		case key.Matches(keyMsg, m.keys.DecreaseFine):
+			step = -0.2
+		}
+		if step == 0 {
+			return m, nil
+		}
+		m.adjust(step)
+		m.hex.SetValue(m.Hex())
+		return m, m.changed()
+	}
+
+	before := m.hex.Value()
+	var cmd tea.Cmd
+	m.hex, cmd = m.hex.Update(msg)
+	if m.hex.Value() == before {
+		return m, cmd
+	}
+	c, err := ParseHex(m.hex.Value())
+	if err != nil {
+		return m, cmd // keep typing; the swatch shows the last valid color
+	}
+	cf, _ := colorful.MakeColor(c)
+	m.setHcl(cf)
+	return m, tea.Batch(cmd, m.changed())
+}
+
+func (m *Model) selectRow(r row) tea.Cmd {
+	m.row = r
+	if r == rowHex {
+		return m.hex.Focus()
+	}
+	m.hex.Blur()
+	m.hex.SetValue(m.Hex())
+	return nil
+}
+
+// adjust moves the focused slider by step coarse increments: 5° of hue or
+// 0.02 of chroma/luminance.
+func (m *Model) adjust(step float64) {
+	switch m.row {
+	case rowHue:
+		m.h = wrapHue(m.h + 5*step)
+	case rowChroma:
+		m.c = math.Max(0, math.Min(maxChroma, m.c+0.02*step))
+	case rowLuminance:
+		m.l = clamp01(m.l + 0.02*step)
+	}
+}
+
+func (m Model) changed() tea.Cmd {
+	msg := ChangedMsg{ID: m.id, Color: m.Color()}
+	return func() tea.Msg { return msg }
+}
+
+// View renders the picker.
+func (m Model) View() string {
+	p := m.palette
+	label := lipgloss.NewStyle().Bold(true).Foreground(p.Foreground)
+	muted := lipgloss.NewStyle().Foreground(p.ForegroundMuted)
+	cur := m.Color()
+
+	swatch := lipgloss.NewStyle().Background(cur).Render("      ")
+	ansi256 := ansi.Convert256(cur)
+	ansi16 := ansi.Convert16(cur)
+	nearest := lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.NewStyle().Background(ansi256).Render("  "),
+		muted.Render(fmt.Sprintf(" 256:%-3d ", int(ansi256))),
+		lipgloss.NewStyle().Background(ansi16).Render("  "),
+		muted.Render(fmt.Sprintf(" 16:%d", int(ansi16))),
+	)
+
+	lines := []string{
+		label.Render(m.label),
+		m.marker(rowHex) + swatch + " " + m.hex.View() + "  " + nearest,
+		m.marker(rowHue) + m.slider("H", m.h/360, fmt.Sprintf("%3.0f°", m.h)),
+		m.marker(rowChroma) + m.slider("C", m.c/maxChroma, fmt.Sprintf("%.2f", m.c)),
+		m.marker(rowLuminance) + m.slider("L", m.l, fmt.Sprintf("%.2f", m.l)),
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m Model) marker(r row) string {
+	if m.focused && m.row == r {
+		return lipgloss.NewStyle().Foreground(m.palette.Primary).Render("▸ ")
+	}
+	return "  "
+}
+
+// slider renders a track with a knob at frac (0-1) of its width.
+func (m Model) slider(name string, frac float64, value string) string {
+	pos := int(math.Round(frac * float64(sliderWidth-1)))
+	pos = max(0, min(sliderWidth-1, pos))
+	fill := lipgloss.NewStyle().Foreground(m.palette.Primary)
+	track := lipgloss.NewStyle().Foreground(m.palette.Border)
+	return name + " " +
+		fill.Render(strings.Repeat("━", pos)+"●") +
+		track.Render(strings.Repeat("─", sliderWidth-1-pos)) +
+		" " + value
+}
+
+// ShortHelp returns the picker's key bindings for a help bar.
+func (m Model) ShortHelp() []key.Binding {
+	return []key.Binding{m.keys.Up, m.keys.Increase, m.keys.IncreaseFine}
+}
+
+// ParseHex parses "#rrggbb", "rrggbb", or the short "#rgb" form.
+func ParseHex(s string) (color.Color, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "#") {
+		s = "#" + s
+	}
+	if len(s) != 4 && len(s) != 7 {
+		return nil, fmt.Errorf("colorpicker: %q is not a hex color", s)
+	}
+	c, err := colorful.Hex(s)
+	if err != nil {
+		return nil, fmt.Errorf("colorpicker: %q is not a hex color", s)
+	}
+	return c, nil
+}
+
+func wrapHue(h float64) float64 {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
@@ -0,0 +1,90 @@
+package colorpicker
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func press(m Model, k tea.KeyPressMsg) (Model, tea.Msg) {
+	m, cmd := m.Update(k)
+	if cmd == nil {
+		return m, nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, c := range batch {
+			if c == nil {
+				continue
+			}
+			if changed, ok := c().(ChangedMsg); ok {
+				return m, changed
+			}
+		}
+		return m, nil
+	}
+	return m, msg
+}
+
+func TestParseHex(t *testing.T) {
+	for _, s := range []string{"#10b1ae", "10B1AE", "#fff"} {
+		_, err := ParseHex(s)
+		assert.NoError(t, err, s)
+	}
+	for _, s := range []string{"", "#12345", "zzzzzz", "#1234567"} {
+		_, err := ParseHex(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestModel_RoundTripsColor(t *testing.T) {
+	m := New("primary", "Primary", lipgloss.Color("#10B1AE"))
+	assert.Equal(t, "#10b1ae", m.Hex())
+}
+
+func TestModel_HexEntryEmitsChange(t *testing.T) {
+	m := New("primary", "Primary", lipgloss.Color("#000000"))
+	m.Focus()
+	m.hex.SetValue("#ff000")
+	m.hex.CursorEnd()
+
+	m, msg := press(m, tea.KeyPressMsg{Code: '0', Text: "0"})
+
+	require.IsType(t, ChangedMsg{}, msg)
+	assert.Equal(t, "primary", msg.(ChangedMsg).ID)
+	assert.Equal(t, "#ff0000", m.Hex())
+}
+
+func TestModel_LuminanceSlider(t *testing.T) {
+	m := New("bg", "Background", lipgloss.Color("#404040"))
+	m.Focus()
+	before := m.l
+
+	m, _ = press(m, tea.KeyPressMsg{Code: tea.KeyDown})
+	m, _ = press(m, tea.KeyPressMsg{Code: tea.KeyDown})
+	m, _ = press(m, tea.KeyPressMsg{Code: tea.KeyDown})
+	m, msg := press(m, tea.KeyPressMsg{Code: tea.KeyRight})
+
+	assert.InDelta(t, before+0.02, m.l, 1e-9)
+	assert.IsType(t, ChangedMsg{}, msg)
+	assert.Equal(t, m.Hex(), m.hex.Value(), "hex input follows the sliders")
+}
+
+func TestModel_IgnoresInputWhenBlurred(t *testing.T) {
+	m := New("bg", "Background", lipgloss.Color("#404040"))
+	m, msg := press(m, tea.KeyPressMsg{Code: tea.KeyRight})
+	assert.Nil(t, msg)
+	assert.Equal(t, "#404040", m.Hex())
+}
+
+func TestModel_View_ShowsNearestANSI(t *testing.T) {
+	m := New("p", "Primary", lipgloss.Color("#ff0000"))
+	view := ansi.Strip(m.View())
+	assert.Contains(t, view, "Primary")
+	assert.Contains(t, view, "256:196")
+	assert.Contains(t, view, "16:9")
+}
@@ -0,0 +1,110 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"testing"
+
+	"charm.land/lipgloss/v2"
+	"github.com/stretchr/testify/assert"
+
+	"scaffold/internal/ui/status"
+	"scaffold/internal/ui/theme"
+)
+
+// WCAG 2 contrast thresholds the components are held to. Body text needs
+// 4.5:1. Accents, the Primary, Secondary and status colors that mark titles,
+// selections and errors, are held to the 3:1 WCAG asks of large text and UI
+// components.
+const (
+	textContrast   = theme.DefaultMinContrast
+	accentContrast = 3.0
+)
+
+// accentContrastFloors lowers the accent threshold for the theme variants
+// whose brand colors shipped before this check, to the contrast they have
+// now. It keeps them from getting worse; new themes get no entry.
+var accentContrastFloors = map[string]float64{
+	"ember/dark":  2.0, // #8B1E3F primary
+	"neon/light":  2.8, // derived primary and the bright error
+	"slate/dark":  2.1, // #3A506B primary
+	"sunset/dark": 2.3, // #5F4B8B secondary
+}
+
+// contrastCheck is a piece of a component drawn with style, whose
+// foreground must contrast with its background, or the palette's when it
+// has none, by at least min.
+type contrastCheck struct {
+	element string
+	style   lipgloss.Style
+	min     float64
+}
+
+// contrastChecks returns the checks for representative components in p,
+// styled by the same builders the components use.
+func contrastChecks(p theme.Palette) []contrastCheck {
+	items := theme.ListItemStyles(p)
+	bar := status.NewStyles(p)
+	modal := theme.NewModalStylesFromPalette(p)
+	form := theme.HuhFromPalette(p)
+	return []contrastCheck{
+		{"list item title", items.NormalTitle, accentContrast},
+		{"list item desc", items.NormalDesc, textContrast},
+		{"list item selected title", items.SelectedTitle, accentContrast},
+		{"list item selected desc", items.SelectedDesc, accentContrast},
+
+		{"status bar", bar.Base, textContrast},
+		{"status bar info", bar.Info, textContrast},
+		{"status bar success", bar.Success, textContrast},
+		{"status bar warning", bar.Warning, textContrast},
+		{"status bar error", bar.Error, textContrast},
+		{"status bar segment", lipgloss.NewStyle().Foreground(p.ForegroundSubtle), textContrast},
+
+		{"modal title", modal.Title, accentContrast},
+		{"modal body", modal.Body, textContrast},
+		{"modal hint", modal.Hint, textContrast},
+
+		{"form field title", form.Focused.Title, accentContrast},
+		{"form field desc", form.Focused.Description, textContrast},
+		{"form field text", form.Focused.TextInput.Text, textContrast},
+		{"form field placeholder", form.Focused.TextInput.Placeholder, textContrast},
+		{"form field error", form.Focused.ErrorMessage, accentContrast},
+		{"form field blurred title", form.Blurred.Title, accentContrast},
+		{"form field blurred text", form.Blurred.TextInput.Text, textContrast},
+		{"form button", form.Focused.FocusedButton, textContrast},
+		{"form button blurred", form.Focused.BlurredButton, textContrast},
+	}
+}
+
+// TestThemeContrast checks every component element under every registered
+// theme, dark and light, so a theme with unreadable combinations fails the
+// build.
+func TestThemeContrast(t *testing.T) {
+	for _, name := range theme.AvailableThemes() {
+		for _, isDark := range []bool{true, false} {
+			variant := fmt.Sprintf("%s/%s", name, map[bool]string{true: "dark", false: "light"}[isDark])
+			p := theme.NewPalette(name, isDark)
+			for _, c := range contrastChecks(p) {
+				fg, bg := c.style.GetForeground(), c.style.GetBackground()
+				if isNoColor(fg) {
+					t.Errorf("%s: %s has no foreground", variant, c.element)
+					continue
+				}
+				if isNoColor(bg) {
+					bg = p.Background
+				}
+				minimum := c.min
+				if floor, ok := accentContrastFloors[variant]; ok && c.min == accentContrast {
+					minimum = floor
+				}
+				assert.GreaterOrEqual(t, theme.ContrastRatio(fg, bg), minimum, "%s: %s", variant, c.element)
+			}
+		}
+	}
+}
+
+// isNoColor reports whether c is unset.
+func isNoColor(c color.Color) bool {
+	_, ok := c.(lipgloss.NoColor)
+	return c == nil || ok
+}
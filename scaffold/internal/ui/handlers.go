@@ -2,16 +2,22 @@
 package ui
 
 import (
-	"math/rand"
+	"fmt"
+	"time"
 
 	"charm.land/bubbles/v2/help"
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	colorful "github.com/lucasb-eyer/go-colorful"
 
 	"scaffold/config"
+	"scaffold/internal/features"
+	"scaffold/internal/logger"
 	"scaffold/internal/task"
 	"scaffold/internal/ui/menu"
 	"scaffold/internal/ui/modal"
+	"scaffold/internal/ui/nav"
 	"scaffold/internal/ui/screens"
 	"scaffold/internal/ui/status"
 	"scaffold/internal/ui/theme"
@@ -31,20 +37,59 @@ func (m rootModel) handleWindowSize(msg tea.WindowSizeMsg) (tea.Model, tea.Cmd)
 	cmds = append(cmds, cmd)
 
 	m.bodyH = m.bodyHeight()
-
-	if setter, ok := m.current.(interface{ SetWidth(int) screens.Screen }); ok {
-		m.current = setter.SetWidth(m.width)
-	}
-	if setter, ok := m.current.(interface{ SetHeight(int) screens.Screen }); ok {
-		m.current = setter.SetHeight(m.bodyH)
-	}
+	m.anim = nav.Animation{} // frames captured at the old size are stale
+
+	// The stack caches the window size for screens that enter it later and
+	// delivers it to every screen, covered ones included; the setters then
+	// fit each to the body so none renders at stale dimensions when
+	// re-exposed. The push, pop and forward handlers fit the screen that
+	// becomes active again through sizeTop, once the body height reflects
+	// its help bar.
+	cmds = append(cmds, m.stack.Resize(msg))
+	m.stack.Each(m.sized)
 	return m, tea.Batch(append(cmds, m.themeMgr.SetWidth(m.width))...)
 }
 
 func (m rootModel) handleBgColor(msg tea.BackgroundColorMsg) (tea.Model, tea.Cmd) {
-	isDark := msg.IsDark()
+	m.bgDark = msg.IsDark()
+	m.termColors.Record(msg)
+	// Screens may have asked for the color themselves (see Capabilities).
+	return m, tea.Batch(m.applyAppearance(), m.registerTerminalTheme(), m.stack.Update(msg))
+}
+
+// applyAppearance switches the theme and help to the dark or light variant
+// chosen by the config's Appearance and the terminal background.
+func (m *rootModel) applyAppearance() tea.Cmd {
+	isDark := m.isDark()
 	m.help.Styles = help.DefaultStyles(isDark)
-	return m, m.themeMgr.SetDarkMode(isDark)
+	if theme.Monochrome() {
+		// Keys stand out by weight rather than color.
+		bold := lipgloss.NewStyle().Bold(true)
+		m.help.Styles = help.Styles{ShortKey: bold, FullKey: bold}
+	}
+	return m.themeMgr.SetDarkMode(isDark)
+}
+
+func (m rootModel) handleCopyToClipboard(msg screens.CopyToClipboardMsg) (tea.Model, tea.Cmd) {
+	return m, tea.Batch(
+		tea.SetClipboard(msg.Text),
+		status.SetSuccess(msg.Label+" copied to clipboard", 0),
+	)
+}
+
+// handleCapabilityReply records a terminal reply in the theme manager's
+// capabilities, and palettes follow its color profile, which restyles the
+// UI when they change. The reply is passed on to the screens, such as the
+// capabilities report.
+func (m rootModel) handleCapabilityReply(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var themeCmd tea.Cmd
+	caps := m.themeMgr.Capabilities()
+	if caps.Record(msg) {
+		theme.SetColorProfile(caps.Profile)
+		themeCmd = m.themeMgr.SetCapabilities(caps)
+	}
+	next, cmd := m.broadcast(msg)
+	return next, tea.Batch(themeCmd, cmd)
 }
 
 func (m rootModel) handleThemeChanged(msg theme.ThemeChangedMsg) (tea.Model, tea.Cmd) {
@@ -52,33 +97,73 @@ func (m rootModel) handleThemeChanged(msg theme.ThemeChangedMsg) (tea.Model, tea
 	var cmd tea.Cmd
 
 	m.styles = theme.NewFromPalette(msg.State.Palette, msg.State.Width)
-	m.help.SetWidth(m.styles.MaxWidth)
+	m.help.SetWidth(m.styles.MaxWidth - m.styles.Help.GetHorizontalFrameSize())
 
 	m.header, cmd = m.header.Update(msg)
 	cmds = append(cmds, cmd)
 	m.statusbar, cmd = m.statusbar.Update(msg)
 	cmds = append(cmds, cmd)
 
-	if t, ok := m.current.(theme.Themeable); ok {
-		t.ApplyTheme(msg.State)
-	}
+	m.stack.SetTheme(msg.State)
 
+	// The help bar wraps at the new width, so the body height may change.
 	m.bodyH = m.bodyHeight()
+	m.stack.Each(m.sized)
 	return m, tea.Batch(cmds...)
 }
 
 func (m rootModel) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if m.tour.active {
+		return m.handleTourKey(msg)
+	}
 	if m.modal.Visible() {
 		var cmd tea.Cmd
 		m.modal, cmd = m.modal.Update(msg)
 		return m, cmd
 	}
+	if c, ok := m.stack.Active().(screens.InputCapturer); ok && c.CapturingInput() {
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		return m.broadcast(msg)
+	}
 	if key.Matches(msg, m.keys.Quit) {
 		return m, tea.Quit
 	}
+	if key.Matches(msg, m.keys.Notes) {
+		return m.openNotes()
+	}
+	if key.Matches(msg, m.keys.Messages) {
+		return m.openMessages()
+	}
 	if key.Matches(msg, m.keys.RandomTheme) {
 		return m.handleRandomTheme()
 	}
+	if key.Matches(msg, m.keys.RollTheme) {
+		return m.handleRollTheme()
+	}
+	if key.Matches(msg, m.keys.NextTheme) {
+		return m.handleCycleTheme(1)
+	}
+	if key.Matches(msg, m.keys.PrevTheme) {
+		return m.handleCycleTheme(-1)
+	}
+	if key.Matches(msg, m.keys.Appearance) {
+		return m.handleCycleAppearance()
+	}
+	if key.Matches(msg, m.keys.NavDebug) {
+		m.stack.ToggleDebug()
+		return m, nil
+	}
+	if key.Matches(msg, m.keys.Features) {
+		if m.stack.Contains("features") {
+			return m.handlePopTo(nav.PopToMsg{ID: "features"})
+		}
+		return m.handleNavigate(NavigateMsg{Screen: screens.NewFeatureFlags()})
+	}
+	if key.Matches(msg, m.keys.Forward) && m.stack.CanForward() {
+		return m.handleForward(nav.ForwardMsg{})
+	}
 	return m.broadcast(msg)
 }
 
@@ -92,7 +177,7 @@ func (m rootModel) handleRandomTheme() (tea.Model, tea.Cmd) {
 	currentTheme := m.cfg.UI.ThemeName
 	var candidates []string
 	for _, t := range themes {
-		if t != currentTheme {
+		if t != currentTheme && t != theme.RandomThemeName {
 			candidates = append(candidates, t)
 		}
 	}
@@ -102,7 +187,7 @@ func (m rootModel) handleRandomTheme() (tea.Model, tea.Cmd) {
 		candidates = themes
 	}
 
-	newTheme := candidates[rand.Intn(len(candidates))]
+	newTheme := candidates[m.rng.Intn(len(candidates))]
 	m.cfg.UI.ThemeName = newTheme
 	return m, tea.Batch(
 		status.SetInfo("Theme: "+newTheme, 0),
@@ -110,18 +195,45 @@ func (m rootModel) handleRandomTheme() (tea.Model, tea.Cmd) {
 	)
 }
 
+// handleRollTheme switches to a theme generated around a random color. The
+// theme is registered but never written to disk, so it lasts until the app
+// exits; rolling again replaces it.
+func (m rootModel) handleRollTheme() (tea.Model, tea.Cmd) {
+	seed := colorful.Hcl(m.rng.Float64()*360, 0.3+m.rng.Float64()*0.5, 0.75)
+	spec, err := theme.GenerateRandom(seed)
+	if err != nil {
+		return m, status.SetError(err.Error(), 0)
+	}
+	themeCmd := m.themeMgr.Reload(spec) // restyles when already on a rolled theme
+	if m.themeMgr.State().Name != spec.Name {
+		themeCmd = m.themeMgr.SetThemeName(spec.Name)
+	}
+	m.cfg.UI.ThemeName = spec.Name
+	primary, _ := colorful.MakeColor(spec.Primary)
+	return m, tea.Batch(
+		status.SetInfo("Theme: rolled from "+primary.Hex(), 0),
+		themeCmd,
+	)
+}
+
 func (m rootModel) handleModalShow(msg modal.ShowMsg) (tea.Model, tea.Cmd) {
 	m.modal = modal.New(msg, m.themeMgr.State().Palette)
 	return m, nil
 }
 
 func (m rootModel) handleModalDismiss(msg tea.Msg) (tea.Model, tea.Cmd) {
-	m.modal = modal.Model{}
-	updated, cmd := m.current.Update(msg)
-	if s, ok := updated.(screens.Screen); ok {
-		m.current = s
+	switch msg := msg.(type) {
+	case modal.ConfirmedMsg:
+		if msg.ID == breakModalID {
+			return m.handleBreakAnswer(true)
+		}
+	case modal.CancelledMsg:
+		if msg.ID == breakModalID {
+			return m.handleBreakAnswer(false)
+		}
 	}
-	return m, cmd
+	m.modal = modal.Model{}
+	return m, m.stack.Update(msg)
 }
 
 func (m rootModel) handleTaskErr(msg task.ErrMsg) (tea.Model, tea.Cmd) {
@@ -135,38 +247,58 @@ func (m rootModel) handleWelcomeDone(_ screens.WelcomeDoneMsg) (tea.Model, tea.C
 			return m, status.SetError("Save failed: "+err.Error(), 0)
 		}
 	}
-	if m.stack.Len() > 0 {
-		m.current = m.stack.Pop()
-	}
+	popCmd := m.stack.Pop()
 	m.bodyH = m.bodyHeight()
+	m.sizeTop()
 	if m.configPath != "" {
-		return m, status.SetSuccess("Welcome! Config saved.", 0)
+		return m, tea.Batch(popCmd, status.SetSuccess("Welcome! Config saved.", 0))
 	}
-	return m, status.SetSuccess("Welcome!", 0)
+	return m, tea.Batch(popCmd, status.SetSuccess("Welcome!", 0))
 }
 
 func (m rootModel) handleNavigate(msg NavigateMsg) (tea.Model, tea.Cmd) {
-	m.stack.Push(m.current)
-	m.current = msg.Screen
+	from := m.bodyView()
+	lifecycleCmd := m.stack.Push(msg.Screen)
 	// Recompute bodyH: the incoming screen may have different key bindings,
 	// which changes help height and therefore available body height.
 	m.bodyH = m.bodyHeight()
-	if setter, ok := m.current.(interface{ SetWidth(int) screens.Screen }); ok {
-		m.current = setter.SetWidth(m.width)
-	}
-	if setter, ok := m.current.(interface{ SetHeight(int) screens.Screen }); ok {
-		m.current = setter.SetHeight(m.bodyH)
-	}
-	if t, ok := m.current.(theme.Themeable); ok {
-		t.ApplyTheme(m.themeMgr.State())
+	m.sizeTop()
+	return m, tea.Batch(lifecycleCmd, m.stack.Top().Init(), m.animate(nav.DirectionForward, from))
+}
+
+// handlePush builds the requested screen, deferring construction of lazy
+// pushes until now, and navigates to it.
+func (m rootModel) handlePush(msg nav.PushMsg) (tea.Model, tea.Cmd) {
+	screen := msg.Build()
+	if screen == nil {
+		return m, nil
 	}
-	return m, m.current.Init()
+	return m.handleNavigate(NavigateMsg{Screen: screen})
+}
+
+func (m rootModel) handleReplace(msg nav.ReplaceMsg) (tea.Model, tea.Cmd) {
+	from := m.bodyView()
+	lifecycleCmd := m.stack.Replace(msg.Screen)
+	m.bodyH = m.bodyHeight()
+	m.sizeTop()
+	return m, tea.Batch(lifecycleCmd, m.stack.Top().Init(), m.animate(nav.DirectionForward, from))
 }
 
 func (m rootModel) handleMenuSelection(msg menu.SelectionMsg) (tea.Model, tea.Cmd) {
+	// Return to an existing instance rather than stacking a duplicate.
+	if m.stack.Contains(msg.Item.ScreenID()) {
+		return m.handlePopTo(nav.PopToMsg{ID: msg.Item.ScreenID()})
+	}
+	if next, cmd, ok := m.openPluginScreen(msg.Item.ScreenID()); ok {
+		return next, cmd
+	}
 	switch msg.Item.ScreenID() {
 	case "settings":
 		return m.Update(NavigateMsg{Screen: screens.NewSettings(m.cfg)})
+	case "theme-editor":
+		return m.Update(NavigateMsg{Screen: screens.NewThemeEditor()})
+	case "fonts":
+		return m.Update(NavigateMsg{Screen: screens.NewFontCatalog(m.cfg.App.Name)})
 	default:
 		detail := screens.NewDetail(
 			msg.Item.Title(), msg.Item.Description(), msg.Item.ScreenID(), m.ctx,
@@ -177,12 +309,20 @@ func (m rootModel) handleMenuSelection(msg menu.SelectionMsg) (tea.Model, tea.Cm
 
 func (m rootModel) handleSettingsSaved(msg screens.SettingsSavedMsg) (tea.Model, tea.Cmd) {
 	themeChanged := m.cfg.UI.ThemeName != msg.Cfg.UI.ThemeName
+	colorblindChanged := m.cfg.UI.ColorblindSafe != msg.Cfg.UI.ColorblindSafe
+	compactChanged := m.cfg.UI.CompactMode != msg.Cfg.UI.CompactMode
+	breaksChanged := m.cfg.Breaks != msg.Cfg.Breaks
+	idleChanged := m.cfg.Idle != msg.Cfg.Idle
+	appearanceChanged := m.cfg.UI.Appearance != msg.Cfg.UI.Appearance
+	timesChanged := m.cfg.UI.TimeStyle != msg.Cfg.UI.TimeStyle || m.cfg.UI.DateFormat != msg.Cfg.UI.DateFormat
 	m.cfg = msg.Cfg
 
 	// Propagate new config to the header component. WithCfg handles
 	// clearing the banner when ShowBanner is disabled and re-rendering it
 	// when ShowBanner is newly enabled (using the cached theme state).
 	m.header = m.header.WithCfg(m.cfg)
+	var animCmd tea.Cmd
+	m.header, animCmd = m.header.Animate()
 
 	var saveCmd tea.Cmd
 	if m.configPath != "" {
@@ -195,27 +335,141 @@ func (m rootModel) handleSettingsSaved(msg screens.SettingsSavedMsg) (tea.Model,
 		saveCmd = status.SetInfo("Settings applied (no config file)", 0)
 	}
 
+	popCmd := m.stack.Pop()
+	m.bodyH = m.bodyHeight()
+	m.sizeTop()
+	m.themeMgr.SetFadeFrames(m.themeFadeFrames())
+	cmds := []tea.Cmd{saveCmd, popCmd, animCmd}
+	if colorblindChanged {
+		cmds = append(cmds, m.themeMgr.SetColorblindSafe(m.cfg.UI.ColorblindSafe))
+	}
+	if compactChanged {
+		theme.SetTokens(designTokens(m.cfg))
+		cmds = append(cmds, m.themeMgr.Refresh())
+	}
 	if themeChanged {
-		if m.stack.Len() > 0 {
-			m.current = m.stack.Pop()
-		}
-		m.bodyH = m.bodyHeight()
-		return m, tea.Batch(saveCmd, m.themeMgr.SetThemeName(m.cfg.UI.ThemeName))
+		cmds = append(cmds, m.themeMgr.SetThemeName(m.cfg.UI.ThemeName))
+	}
+	if appearanceChanged {
+		cmds = append(cmds, m.applyAppearance())
 	}
+	if breaksChanged {
+		m.startBreaks(time.Now())
+		cmds = append(cmds, m.breakTick())
+	} else if timesChanged && m.cfg.Breaks.Enabled {
+		m.showBreakSegment(time.Now())
+	}
+	if idleChanged {
+		m.startIdle(time.Now())
+		cmds = append(cmds, m.idleTick(time.Now()))
+	}
+	return m, tea.Batch(cmds...)
+}
 
-	if m.stack.Len() > 0 {
-		m.current = m.stack.Pop()
+// handleFeatureChanged logs a runtime flag change and passes it on to the
+// header and screens.
+func (m rootModel) handleFeatureChanged(msg features.ChangedMsg) (tea.Model, tea.Cmd) {
+	state := "off"
+	if msg.Enabled {
+		state = "on"
 	}
-	m.bodyH = m.bodyHeight()
-	return m, saveCmd
+	logger.Debug("feature %s turned %s at runtime", msg.Flag.Name(), state)
+	m.themeMgr.SetFadeFrames(m.themeFadeFrames())
+	next, cmd := m.broadcast(msg)
+	return next, tea.Batch(cmd, status.SetInfo(msg.Flag.Name()+" "+state, 0))
 }
 
 func (m rootModel) handleBack(_ screens.BackMsg) (tea.Model, tea.Cmd) {
-	if m.stack.Len() > 0 {
-		m.current = m.stack.Pop()
+	if m.stack.Presented() != nil {
+		return m.handleDismiss(nav.DismissMsg{})
 	}
+	from := m.bodyView()
+	cmd := m.stack.Pop()
 	m.bodyH = m.bodyHeight()
-	return m, nil
+	m.sizeTop()
+	return m, tea.Batch(cmd, m.animate(nav.DirectionBackward, from))
+}
+
+func (m rootModel) handlePopTo(msg nav.PopToMsg) (tea.Model, tea.Cmd) {
+	from := m.bodyView()
+	cmd := m.stack.PopTo(msg.ID)
+	m.bodyH = m.bodyHeight()
+	m.sizeTop()
+	return m, tea.Batch(cmd, m.animate(nav.DirectionBackward, from))
+}
+
+// handleForward re-enters the most recently popped screen. The screen keeps
+// the state it had when popped, so it is not re-initialised; screens that
+// cannot resume as they were, such as Settings, reset in WillAppear.
+func (m rootModel) handleForward(_ nav.ForwardMsg) (tea.Model, tea.Cmd) {
+	from := m.bodyView()
+	cmd := m.stack.Forward()
+	m.bodyH = m.bodyHeight()
+	m.sizeTop()
+	return m, tea.Batch(cmd, m.animate(nav.DirectionForward, from))
+}
+
+func (m rootModel) handlePresent(msg nav.PresentMsg) (tea.Model, tea.Cmd) {
+	lifecycleCmd := m.stack.Present(msg.Screen)
+	m.stack.SetPresented(m.sized(m.stack.Presented()))
+	return m, tea.Batch(lifecycleCmd, m.stack.Presented().Init())
+}
+
+func (m rootModel) handleDismiss(_ nav.DismissMsg) (tea.Model, tea.Cmd) {
+	return m, m.stack.Dismiss()
+}
+
+// sizeTop pushes the current width and body height into the active and
+// presented screens when they implement the SetWidth/SetHeight setters.
+func (m *rootModel) sizeTop() {
+	m.stack.SetTop(m.sized(m.stack.Top()))
+	m.stack.SetPresented(m.sized(m.stack.Presented()))
+}
+
+// sized applies the content width and body height to s via its optional
+// SetWidth/SetHeight setters. The width is that of the application frame
+// (see theme.ContentWidth), which screens pad by the body's 3 columns a side.
+func (m rootModel) sized(s screens.Screen) screens.Screen {
+	if setter, ok := s.(interface{ SetWidth(int) screens.Screen }); ok {
+		s = setter.SetWidth(theme.ContentWidth(m.width))
+	}
+	if setter, ok := s.(interface{ SetHeight(int) screens.Screen }); ok {
+		s = setter.SetHeight(m.bodyH)
+	}
+	return s
+}
+
+// handleNavEvent logs a completed transition and passes the event on to the
+// chrome and the active screen like any other message.
+func (m rootModel) handleNavEvent(msg nav.NavEventMsg) (tea.Model, tea.Cmd) {
+	from, to := msg.Screens()
+	logger.Debug("nav: %T %s -> %s", msg, screenName(from), screenName(to))
+	return m.broadcast(msg)
+}
+
+// screenName returns a screen's ID when it has one, else its type.
+func screenName(s screens.Screen) string {
+	if id, ok := s.(nav.Identifiable); ok && id.ScreenID() != "" {
+		return id.ScreenID()
+	}
+	return fmt.Sprintf("%T", s)
+}
+
+// handleBroadcast delivers msg.Msg to the chrome and to every interested
+// screen in the stack, including covered BackgroundAware screens.
+func (m rootModel) handleBroadcast(msg nav.BroadcastMsg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	var cmd tea.Cmd
+
+	m.header, cmd = m.header.Update(msg.Msg)
+	cmds = append(cmds, cmd)
+
+	m.statusbar, cmd = m.statusbar.Update(msg.Msg)
+	cmds = append(cmds, cmd)
+
+	cmds = append(cmds, m.stack.Broadcast(msg.Msg))
+
+	return m, tea.Batch(cmds...)
 }
 
 // broadcast sends msg to all chrome components (header, statusbar) and the
@@ -233,11 +487,10 @@ func (m rootModel) broadcast(msg tea.Msg) (tea.Model, tea.Cmd) {
 	m.statusbar, cmd = m.statusbar.Update(msg)
 	cmds = append(cmds, cmd)
 
-	updated, cmd := m.current.Update(msg)
-	if s, ok := updated.(screens.Screen); ok {
-		m.current = s
-	}
+	m.saver, cmd = m.saver.Update(msg)
 	cmds = append(cmds, cmd)
 
+	cmds = append(cmds, m.stack.Update(msg))
+
 	return m, tea.Batch(cmds...)
 }
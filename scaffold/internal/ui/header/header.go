@@ -4,24 +4,35 @@
 package header
 
 import (
+	"image/color"
+
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/colorprofile"
+	"github.com/charmbracelet/x/ansi"
 
+	"banner"
 	"scaffold/config"
-	"scaffold/internal/ui/banner"
+	"scaffold/internal/features"
 	"scaffold/internal/ui/theme"
 )
 
+// bannerFont is the banner's font, and the first tried when it is fitted to
+// the width.
+const bannerFont = "larry3d"
+
 // Model is the header component. All fields are unexported; callers interact
 // through New, Update, View, Height, and WithCfg.
 type Model struct {
 	cfg        config.Config
 	banner     string
+	font       string // font of banner; a condensed one when fitted to a narrow width
 	headerSty  lipgloss.Style
 	titleSty   lipgloss.Style
 	descSty    lipgloss.Style
 	width      int
-	themeState theme.State // cached for banner re-renders after config changes
+	themeState theme.State           // cached for banner re-renders after config changes
+	anim       banner.AnimatedBanner // the banner in motion; animated-banner only
 }
 
 // New creates a header Model from the given config.
@@ -34,21 +45,69 @@ func New(cfg config.Config) Model {
 // If ShowBanner was just disabled the cached banner is cleared.
 // If ShowBanner was just enabled and a theme state is available the banner is
 // re-rendered immediately so the caller does not need to trigger a theme update.
+// A changed banner animation or reduced-motion setting applies at once.
 func (m Model) WithCfg(cfg config.Config) Model {
+	refit := cfg.UI.BannerAutoFit != m.cfg.UI.BannerAutoFit
 	m.cfg = cfg
 	if !cfg.UI.ShowBanner {
 		m.banner = ""
-	} else if m.banner == "" && m.themeState.Palette.Primary != nil {
-		m.banner = renderBannerStr(cfg, m.themeState)
+	} else if (m.banner == "" || refit) && m.themeState.Palette.Primary != nil {
+		m = m.renderBanner()
+	}
+	return m.withAnim()
+}
+
+// withAnim renders the animated banner while the animated-banner feature
+// flag is on and a banner is shown, carrying on from the frame the previous
+// one was on, and drops it otherwise.
+func (m Model) withAnim() Model {
+	if !features.AnimatedBanner.Enabled() || m.banner == "" {
+		m.anim = banner.AnimatedBanner{}
+		return m
 	}
+	m.anim = newBannerAnim(m.cfg, m.themeState, m.font).WithFrame(m.anim.Frame())
 	return m
 }
 
+// Animate starts the banner animation when the animated-banner feature flag
+// is on, motion is not reduced and there is a banner to animate. It is a
+// no-op while the animation is already running.
+func (m Model) Animate() (Model, tea.Cmd) {
+	if !features.AnimatedBanner.Enabled() {
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.anim, cmd = m.anim.Start()
+	return m, cmd
+}
+
+// Screensaver returns the banner in the header's font with its gradient
+// scrolling, for the idle screensaver, whether or not the animated-banner
+// feature flag is on. Start it to set it moving. It is still with reduced
+// motion, and empty when there is no banner to show.
+func (m Model) Screensaver() banner.AnimatedBanner {
+	if m.banner == "" {
+		return banner.AnimatedBanner{}
+	}
+	b, err := banner.NewAnimated(bannerConfig(m.cfg, themedGradient(m.cfg, m.themeState), m.font), banner.AnimationScroll)
+	if err != nil {
+		return banner.AnimatedBanner{}
+	}
+	return b.WithReducedMotion(m.cfg.UI.ReducedMotion)
+}
+
 // Update handles messages relevant to the header.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
+		if m.cfg.UI.ShowBanner && m.cfg.UI.BannerAutoFit && m.themeState.Palette.Primary != nil {
+			font := m.font
+			m = m.renderBanner()
+			if m.font != font {
+				return m.withAnim().Animate()
+			}
+		}
 
 	case theme.ThemeChangedMsg:
 		m.themeState = msg.State
@@ -71,28 +130,48 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			MarginLeft(3)
 
 		if m.cfg.UI.ShowBanner {
-			m.banner = renderBannerStr(m.cfg, msg.State)
+			m = m.renderBanner()
 		} else {
 			m.banner = ""
 		}
+		return m.withAnim().Animate()
+
+	case features.ChangedMsg:
+		if msg.Flag == features.AnimatedBanner {
+			return m.withAnim().Animate()
+		}
 	}
 
-	return m, nil
+	var cmd tea.Cmd
+	m.anim, cmd = m.anim.Update(msg)
+	return m, cmd
 }
 
 // View renders the header.
 // The ASCII banner is shown only when ShowBanner is enabled, the banner has
-// been rendered, and the terminal is wide enough. Otherwise a plain title is
-// shown.
+// been rendered, and it fits the content width. Otherwise a plain title is
+// shown, truncated if need be, and the description wraps to fit.
 func (m Model) View() tea.View {
+	avail := theme.ContentWidth(m.width) - m.headerSty.GetHorizontalFrameSize()
 	var heading string
-	if m.cfg.UI.ShowBanner && m.banner != "" && m.width > 0 && m.width >= lipgloss.Width(m.banner) {
+	if m.cfg.UI.ShowBanner && m.banner != "" && m.width > 0 && avail >= lipgloss.Width(m.banner) {
 		heading = m.banner
+		if v := m.anim.View(); v != "" {
+			heading = v
+		}
 	} else {
-		heading = m.titleSty.Render(m.cfg.App.Name)
+		title := m.cfg.App.Name
+		if m.width > 0 {
+			title = ansi.Truncate(title, max(avail, 1), "…")
+		}
+		heading = m.titleSty.Render(title)
 	}
 	if m.cfg.UI.ShowDescription && m.cfg.App.Description != "" {
-		heading += "\n" + m.descSty.Render(m.cfg.App.Description)
+		descSty := m.descSty
+		if m.width > 0 {
+			descSty = descSty.Width(max(avail-descSty.GetHorizontalMargins(), 1))
+		}
+		heading += "\n" + descSty.Render(m.cfg.App.Description)
 	}
 	return tea.NewView(m.headerSty.Render(heading))
 }
@@ -102,24 +181,76 @@ func (m Model) Height() int {
 	return lipgloss.Height(m.View().Content)
 }
 
-// renderBannerStr renders the ASCII art banner at a fixed large width and
-// returns the result. Using a large width lets lipgloss.Width(banner) reflect
-// the font's true natural width, which View uses to decide whether the terminal
-// is wide enough to display it.
-func renderBannerStr(cfg config.Config, state theme.State) string {
+// renderBanner renders the ASCII art banner at a fixed large width. Using a
+// large width lets lipgloss.Width(banner) reflect the font's true natural
+// width, which View uses to decide whether the terminal is wide enough to
+// display it. With BannerAutoFit, once the width is known, the banner is
+// rendered in the first of bannerFont and banner.FitFonts that fits, and left
+// empty, for View to show the plain title, when none does. In monochrome
+// mode the banner is plain text.
+func (m Model) renderBanner() Model {
+	g := themedGradient(m.cfg, m.themeState)
+	if !m.cfg.UI.BannerAutoFit || m.width <= 0 {
+		m.font = bannerFont
+		m.banner = renderBannerGradient(m.cfg, g, bannerFont)
+		return m
+	}
+	avail := theme.ContentWidth(m.width) - m.headerSty.GetHorizontalFrameSize()
+	art, font, err := banner.RenderFit(bannerConfig(m.cfg, g, bannerFont), avail, banner.FitFonts...)
+	if err != nil {
+		m.font, m.banner = "", ""
+		return m
+	}
+	m.font, m.banner = font, art
+	return m
+}
+
+// newBannerAnim renders the banner in font for the animated-banner feature flag,
+// moving as cfg's BannerAnimation says, or still with reduced motion. On
+// error the banner is left empty and View shows the static one.
+func newBannerAnim(cfg config.Config, state theme.State, font string) banner.AnimatedBanner {
+	b, err := banner.NewAnimated(bannerConfig(cfg, themedGradient(cfg, state), font), banner.Animation(cfg.UI.BannerAnimation))
+	if err != nil {
+		return banner.AnimatedBanner{}
+	}
+	return b.WithReducedMotion(cfg.UI.ReducedMotion)
+}
+
+// themedGradient returns the banner gradient for the theme in state. On
+// 16-color terminals, where the stops of a gradient collapse into a few
+// clashing colors, the banner is Primary alone and is not animated.
+func themedGradient(cfg config.Config, state theme.State) *banner.Gradient {
 	p := state.Palette
 	if p.Primary == nil {
 		p = theme.NewPalette(cfg.UI.ThemeName, state.IsDark)
 	}
-	b, err := banner.Render(banner.Config{
-		Text:          cfg.App.Name,
-		Font:          "larry3d",
-		Width:         100,
-		Justification: 0,
-		Gradient:      banner.GradientThemed(p.Primary, p.Secondary),
-	})
+	if state.Caps.Profile == colorprofile.ANSI {
+		return banner.GradientFromColors("themed", []color.Color{p.Primary})
+	}
+	return banner.GradientFromColors("themed", theme.Ramp(p, 7))
+}
+
+func renderBannerGradient(cfg config.Config, g *banner.Gradient, font string) string {
+	b, err := banner.Render(bannerConfig(cfg, g, font))
 	if err != nil {
 		return cfg.App.Name
 	}
 	return b
 }
+
+// bannerConfig returns the banner config for cfg's app name in font and
+// gradient g. In monochrome mode the banner is plain text.
+func bannerConfig(cfg config.Config, g *banner.Gradient, font string) banner.Config {
+	parser := "terminal-color"
+	if theme.Monochrome() {
+		parser = "terminal"
+	}
+	return banner.Config{
+		Text:          cfg.App.Name,
+		Font:          font,
+		Width:         100,
+		Justification: 0,
+		Gradient:      g,
+		Parser:        parser,
+	}
+}
@@ -0,0 +1,117 @@
+// Package ui — idle timeout for rootModel.
+package ui
+
+import (
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"banner"
+	"scaffold/internal/logger"
+	"scaffold/internal/ui/nav"
+	"scaffold/internal/ui/status"
+)
+
+// idleTickMsg checks whether the UI has been left without input for the
+// idle timeout. Ticks from before the timer was last restarted carry an
+// older gen and are ignored.
+type idleTickMsg struct {
+	gen int
+	at  time.Time
+}
+
+// startIdle restarts the idle timer from now, with now counted as the last
+// input. Run the command from idleTick to keep it going.
+func (m *rootModel) startIdle(now time.Time) {
+	m.idleGen++
+	m.lastInput = now
+}
+
+// idleTimeout returns how long the UI waits without input before going
+// idle.
+func (m rootModel) idleTimeout() time.Duration {
+	return time.Duration(m.cfg.Idle.Minutes) * time.Minute
+}
+
+// idleTick returns the command for the next check of the idle timer, due
+// when the timeout runs out after the last input, or nil when the idle
+// timeout is off or the UI is already idle.
+func (m rootModel) idleTick(now time.Time) tea.Cmd {
+	if !m.cfg.Idle.Enabled || m.idle {
+		return nil
+	}
+	gen := m.idleGen
+	return tea.Tick(max(m.lastInput.Add(m.idleTimeout()).Sub(now), 0), func(t time.Time) tea.Msg {
+		return idleTickMsg{gen: gen, at: t}
+	})
+}
+
+// isInput reports whether msg is the user's doing: a key, a click, a scroll
+// or a paste.
+func isInput(msg tea.Msg) bool {
+	switch msg.(type) {
+	case tea.KeyPressMsg, tea.MouseMsg, tea.PasteMsg:
+		return true
+	}
+	return false
+}
+
+func (m rootModel) handleIdleTick(msg idleTickMsg) (tea.Model, tea.Cmd) {
+	if msg.gen != m.idleGen || !m.cfg.Idle.Enabled || m.idle {
+		return m, nil
+	}
+	if m.tour.active {
+		m.lastInput = msg.at // the tour showing the app off counts as use
+	}
+	// There was input since this tick was scheduled; check again later.
+	if msg.at.Before(m.lastInput.Add(m.idleTimeout())) {
+		return m, m.idleTick(msg.at)
+	}
+	return m.goIdle()
+}
+
+// goIdle returns to the home screen, with the screensaver over it when the
+// config turns it on, and locks the UI until a key is pressed. A dialog
+// being answered is left as it is, but locked all the same.
+func (m rootModel) goIdle() (tea.Model, tea.Cmd) {
+	logger.Debug("idle: no input for %s", m.idleTimeout())
+	m.idle = true
+	if m.modal.Visible() {
+		return m, status.Persistent("Idle — press any key to continue", status.KindInfo)
+	}
+	cmds := []tea.Cmd{m.stack.Dismiss(), m.stack.PopTo("home")}
+	m.anim = nav.Animation{}
+	m.bodyH = m.bodyHeight()
+	m.sizeTop()
+	if m.cfg.Idle.Screensaver {
+		var cmd tea.Cmd
+		m.saver, cmd = m.header.Screensaver().Start()
+		cmds = append(cmds, cmd)
+	} else {
+		cmds = append(cmds, status.Persistent("Idle — press any key to continue", status.KindInfo))
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// wake unlocks the UI after the key that woke it, which does nothing else,
+// and restarts the idle timer.
+func (m rootModel) wake(now time.Time) (tea.Model, tea.Cmd) {
+	m.idle = false
+	m.saver = banner.AnimatedBanner{}
+	m.startIdle(now)
+	return m, tea.Batch(status.Clear(), m.idleTick(now))
+}
+
+// screensaverView renders the screensaver: the banner, or the app name when
+// the banner does not fit, centered on the screen.
+func (m rootModel) screensaverView() string {
+	p := m.themeMgr.State().Palette
+	art := m.saver.View()
+	if art == "" || lipgloss.Width(art) > m.width {
+		art = lipgloss.NewStyle().Bold(true).Foreground(p.Primary).Render(m.cfg.App.Name)
+	}
+	hint := lipgloss.NewStyle().Foreground(p.ForegroundMuted).Render("Press any key to continue")
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center,
+		lipgloss.JoinVertical(lipgloss.Center, art, "", hint))
+}
@@ -7,7 +7,16 @@ import "charm.land/bubbles/v2/key"
 type GlobalKeyMap struct {
 	Quit        key.Binding
 	Back        key.Binding
+	Forward     key.Binding // full help only
+	Notes       key.Binding // full help only
+	Messages    key.Binding // full help only
 	RandomTheme key.Binding // hidden
+	RollTheme   key.Binding // hidden
+	NextTheme   key.Binding // full help only
+	PrevTheme   key.Binding // full help only
+	Appearance  key.Binding // full help only
+	NavDebug    key.Binding // hidden
+	Features    key.Binding // hidden
 }
 
 // DefaultGlobalKeyMap returns the default global key bindings.
@@ -21,9 +30,42 @@ func DefaultGlobalKeyMap() GlobalKeyMap {
 			key.WithKeys("esc"),
 			key.WithHelp("esc", "back"),
 		),
+		Forward: key.NewBinding(
+			key.WithKeys("alt+right"),
+			key.WithHelp("alt+→", "forward"),
+		),
+		Notes: key.NewBinding(
+			key.WithKeys("ctrl+n"),
+			key.WithHelp("ctrl+n", "notes"),
+		),
+		Messages: key.NewBinding(
+			key.WithKeys("alt+m"),
+			key.WithHelp("alt+m", "messages"),
+		),
 		RandomTheme: key.NewBinding(
 			key.WithKeys("ctrl+t"),
 		),
+		RollTheme: key.NewBinding(
+			key.WithKeys("alt+t"),
+		),
+		NextTheme: key.NewBinding(
+			key.WithKeys("alt+."),
+			key.WithHelp("alt+.", "next theme"),
+		),
+		PrevTheme: key.NewBinding(
+			key.WithKeys("alt+,"),
+			key.WithHelp("alt+,", "prev theme"),
+		),
+		Appearance: key.NewBinding(
+			key.WithKeys("alt+d"),
+			key.WithHelp("alt+d", "dark/light/auto"),
+		),
+		NavDebug: key.NewBinding(
+			key.WithKeys("f12"),
+		),
+		Features: key.NewBinding(
+			key.WithKeys("f9"),
+		),
 	}
 }
 
@@ -34,5 +76,49 @@ func (k GlobalKeyMap) ShortHelp() []key.Binding {
 
 // FullHelp returns grouped bindings for full help view.
 func (k GlobalKeyMap) FullHelp() [][]key.Binding {
-	return [][]key.Binding{{k.Back, k.Quit}}
+	return [][]key.Binding{
+		{k.Back, k.Forward, k.Notes, k.Messages, k.Quit},
+		{k.NextTheme, k.PrevTheme, k.Appearance},
+	}
+}
+
+// TourKeyMap holds the keys that drive the --tour walkthrough. While the
+// tour runs they replace the global and screen keys.
+type TourKeyMap struct {
+	Next key.Binding
+	Prev key.Binding
+	End  key.Binding
+	Quit key.Binding
+}
+
+// DefaultTourKeyMap returns the default tour key bindings.
+func DefaultTourKeyMap() TourKeyMap {
+	return TourKeyMap{
+		Next: key.NewBinding(
+			key.WithKeys("space", "right", "l"),
+			key.WithHelp("space/→", "next"),
+		),
+		Prev: key.NewBinding(
+			key.WithKeys("left", "h"),
+			key.WithHelp("←", "previous"),
+		),
+		End: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "end tour"),
+		),
+		Quit: key.NewBinding(
+			key.WithKeys("q", "ctrl+c"),
+			key.WithHelp("q/ctrl+c", "quit"),
+		),
+	}
+}
+
+// ShortHelp returns a slice of bindings for short help view.
+func (k TourKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Next, k.Prev, k.End, k.Quit}
+}
+
+// FullHelp returns grouped bindings for full help view.
+func (k TourKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
 }
@@ -0,0 +1,118 @@
+package menu
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"charm.land/bubbles/v2/list"
+	"github.com/charmbracelet/x/ansi"
+
+	"scaffold/internal/ui/theme"
+)
+
+// asciiIcons selects each item's ASCII fallback icon instead of its Unicode
+// one. It defaults to true when the locale or terminal cannot be trusted to
+// render emoji.
+var asciiIcons = !unicodeTerminal()
+
+// SetASCIIIcons forces ASCII (true) or Unicode (false) icons for every menu,
+// overriding locale detection. Snapshot rendering uses it for determinism.
+func SetASCIIIcons(ascii bool) {
+	asciiIcons = ascii
+}
+
+// unicodeTerminal reports whether the environment advertises a UTF-8 locale
+// on a terminal other than the Linux console (see theme.Capabilities).
+func unicodeTerminal() bool {
+	return theme.DetectCapabilities(os.Environ()).Unicode
+}
+
+// iconDelegate wraps list.DefaultDelegate to prefix each title with the
+// item's icon and right-align its shortcut hint in a column shared by all
+// items. While the list is filtered items render undecorated so match
+// highlighting stays aligned with the title text.
+//
+// The hint column depends on every item, so it is measured once by withItems
+// rather than per rendered row, which would make each frame O(rows × items).
+type iconDelegate struct {
+	list.DefaultDelegate
+	hintCol int // column where shortcut hints start
+	hintW   int // width of the widest shortcut hint
+}
+
+// withItems returns d with the hint column measured for items.
+func (d iconDelegate) withItems(items []list.Item) iconDelegate {
+	d.hintCol = hintColumn(items)
+	d.hintW = shortcutWidth(items)
+	return d
+}
+
+// decoratedItem overrides Title with the icon/shortcut layout.
+type decoratedItem struct {
+	Item
+	title string
+}
+
+func (d decoratedItem) Title() string { return d.title }
+
+// Render implements list.ItemDelegate.
+func (d iconDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	it, ok := item.(Item)
+	if !ok || m.FilterState() != list.Unfiltered {
+		d.DefaultDelegate.Render(w, m, index, item)
+		return
+	}
+	s := d.Styles.NormalTitle
+	width := m.Width() - s.GetPaddingLeft() - s.GetPaddingRight()
+	width = min(width, d.hintCol+d.hintW)
+	d.DefaultDelegate.Render(w, m, index, decoratedItem{Item: it, title: it.decoratedTitle(width)})
+}
+
+// hintGap is the minimum space between the longest title and the hints.
+const hintGap = 4
+
+// hintColumn returns the column at which shortcut hints start: just past the
+// widest icon+title among items.
+func hintColumn(items []list.Item) int {
+	col := 0
+	for _, li := range items {
+		if it, ok := li.(Item); ok {
+			col = max(col, ansi.StringWidth(it.label()))
+		}
+	}
+	return col + hintGap
+}
+
+// shortcutWidth returns the width of the widest shortcut hint among items.
+func shortcutWidth(items []list.Item) int {
+	w := 0
+	for _, li := range items {
+		if it, ok := li.(Item); ok {
+			w = max(w, ansi.StringWidth(it.shortcut))
+		}
+	}
+	return w
+}
+
+// label returns the icon and title as displayed, without the hint.
+func (i Item) label() string {
+	if icon := i.Icon(); icon != "" {
+		return icon + " " + i.title
+	}
+	return i.title
+}
+
+// decoratedTitle lays out "icon title … shortcut" so that the hint ends at
+// width. The hint is dropped when it would not fit beside the title.
+func (i Item) decoratedTitle(width int) string {
+	left := i.label()
+	if i.shortcut == "" {
+		return left
+	}
+	gap := width - ansi.StringWidth(left) - ansi.StringWidth(i.shortcut)
+	if gap < 1 {
+		return left
+	}
+	return left + strings.Repeat(" ", gap) + i.shortcut
+}
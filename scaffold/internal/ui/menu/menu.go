@@ -14,6 +14,9 @@ type Item struct {
 	title       string
 	description string
 	screenID    string // identifier for navigation
+	icon        string // leading icon or emoji; optional
+	asciiIcon   string // fallback for terminals without Unicode
+	shortcut    string // key that activates the item directly; optional
 }
 
 // NewItem creates a new menu item.
@@ -25,6 +28,32 @@ func NewItem(title, description, screenID string) Item {
 	}
 }
 
+// WithIcon returns the item with a leading icon. ascii is shown instead on
+// terminals that cannot render icon.
+func (i Item) WithIcon(icon, ascii string) Item {
+	i.icon = icon
+	i.asciiIcon = ascii
+	return i
+}
+
+// WithShortcut returns the item with a direct-activation key, shown as a
+// right-aligned hint. k is matched against tea.KeyPressMsg.String().
+func (i Item) WithShortcut(k string) Item {
+	i.shortcut = k
+	return i
+}
+
+// Icon returns the icon to display for the current terminal.
+func (i Item) Icon() string {
+	if asciiIcons {
+		return i.asciiIcon
+	}
+	return i.icon
+}
+
+// Shortcut returns the item's direct-activation key, or "".
+func (i Item) Shortcut() string { return i.shortcut }
+
 // FilterValue implements list.Item.
 func (i Item) FilterValue() string { return i.title }
 
@@ -81,9 +110,10 @@ type SelectionMsg struct {
 type Model struct {
 	theme.ThemeAware
 
-	list     list.Model
-	delegate list.DefaultDelegate
-	keys     keyMap
+	list      list.Model
+	delegate  iconDelegate
+	keys      keyMap
+	shortcuts map[string]int // shortcut key -> index in items
 	ready     bool
 	width     int
 	height    int
@@ -109,11 +139,19 @@ func (m Model) SetSize(width, height int) Model {
 // SetItems sets the menu items.
 func (m Model) SetItems(items []Item) Model {
 	listItems := make([]list.Item, len(items))
+	m.shortcuts = make(map[string]int)
 	for i, item := range items {
 		listItems[i] = item
+		if item.shortcut != "" {
+			if _, dup := m.shortcuts[item.shortcut]; !dup {
+				m.shortcuts[item.shortcut] = i
+			}
+		}
 	}
 
 	if m.ready {
+		m.delegate = m.delegate.withItems(listItems)
+		m.list.SetDelegate(m.delegate)
 		m.list.SetItems(listItems)
 	} else {
 		// Create delegate with theme styles
@@ -121,7 +159,7 @@ func (m Model) SetItems(items []Item) Model {
 		if p.Primary == nil {
 			p = theme.NewPalette("default", false) // fallback
 		}
-		m.delegate = list.NewDefaultDelegate()
+		m.delegate = iconDelegate{DefaultDelegate: list.NewDefaultDelegate()}.withItems(listItems)
 		m.delegate.Styles = theme.ListItemStyles(p)
 
 		m.list = list.New(listItems, m.delegate, m.width, m.height)
@@ -146,7 +184,7 @@ func (m *Model) ApplyTheme(state theme.State) {
 		p := state.Palette
 		m.list.Styles = theme.ListStyles(p)
 
-		m.delegate = list.NewDefaultDelegate()
+		m.delegate = iconDelegate{DefaultDelegate: list.NewDefaultDelegate()}.withItems(m.list.Items())
 		m.delegate.Styles = theme.ListItemStyles(p)
 		m.list.SetDelegate(m.delegate)
 	}
@@ -163,6 +201,18 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// Direct-key activation takes precedence over list navigation, except
+	// while the user is typing a filter.
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && m.list.FilterState() != list.Filtering {
+		if i, ok := m.shortcuts[keyMsg.String()]; ok {
+			if item, ok := m.selectShortcut(i); ok {
+				return m, func() tea.Msg {
+					return SelectionMsg{Item: item}
+				}
+			}
+		}
+	}
+
 	var cmd tea.Cmd
 	m.list, cmd = m.list.Update(msg)
 
@@ -180,6 +230,26 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	return m, cmd
 }
 
+// selectShortcut moves the cursor to the item at index i of the full item
+// list. It fails when a filter hides that item.
+func (m *Model) selectShortcut(i int) (Item, bool) {
+	item, ok := m.list.Items()[i].(Item)
+	if !ok {
+		return Item{}, false
+	}
+	if m.list.FilterState() == list.Unfiltered {
+		m.list.Select(i)
+		return item, true
+	}
+	for vi, li := range m.list.VisibleItems() {
+		if li.(Item).screenID == item.screenID {
+			m.list.Select(vi)
+			return item, true
+		}
+	}
+	return Item{}, false
+}
+
 // View renders the menu.
 func (m Model) View() tea.View {
 	if !m.ready {
@@ -0,0 +1,104 @@
+package menu
+
+import (
+	"fmt"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMenu() Model {
+	return New().SetItems([]Item{
+		NewItem("Dashboard", "View dashboard", "dashboard").WithIcon("📊", "#").WithShortcut("d"),
+		NewItem("Settings", "Configure", "settings").WithIcon("🔧", "*").WithShortcut("s"),
+		NewItem("Plain", "No extras", "plain"),
+	}).SetSize(40, 20)
+}
+
+// --- icons ---
+
+func TestItem_Icon_ASCIIFallback(t *testing.T) {
+	t.Cleanup(func() { SetASCIIIcons(!unicodeTerminal()) })
+	item := NewItem("Dashboard", "", "dashboard").WithIcon("📊", "#")
+
+	SetASCIIIcons(true)
+	assert.Equal(t, "#", item.Icon())
+
+	SetASCIIIcons(false)
+	assert.Equal(t, "📊", item.Icon())
+}
+
+func TestUnicodeTerminal_LinuxConsole(t *testing.T) {
+	t.Setenv("TERM", "linux")
+	t.Setenv("LANG", "en_US.UTF-8")
+	assert.False(t, unicodeTerminal())
+}
+
+func TestUnicodeTerminal_UTF8Locale(t *testing.T) {
+	t.Setenv("TERM", "xterm-256color")
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_CTYPE", "")
+	t.Setenv("LANG", "en_US.UTF-8")
+	assert.True(t, unicodeTerminal())
+}
+
+// --- layout ---
+
+func TestItem_DecoratedTitle_RightAlignsShortcut(t *testing.T) {
+	t.Cleanup(func() { SetASCIIIcons(!unicodeTerminal()) })
+	SetASCIIIcons(true)
+	item := NewItem("Settings", "", "settings").WithIcon("🔧", "*").WithShortcut("s")
+
+	assert.Equal(t, "* Settings     s", item.decoratedTitle(16))
+	assert.Equal(t, "* Settings", item.decoratedTitle(10), "hint is dropped when it does not fit")
+}
+
+// --- shortcuts ---
+
+func TestModel_Shortcut_ActivatesItem(t *testing.T) {
+	m := testMenu()
+
+	m, cmd := m.Update(tea.KeyPressMsg{Code: 's', Text: "s"})
+	require.NotNil(t, cmd)
+
+	sel, ok := cmd().(SelectionMsg)
+	require.True(t, ok)
+	assert.Equal(t, "settings", sel.Item.ScreenID())
+	assert.Equal(t, 1, m.list.Index(), "cursor moves to the activated item")
+}
+
+func TestModel_UnboundKey_FallsThroughToList(t *testing.T) {
+	m := testMenu()
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+
+	assert.Equal(t, 1, m.list.Index())
+}
+
+// --- benchmarks ---
+
+func benchMenu(n int) Model {
+	items := make([]Item, n)
+	for i := range items {
+		items[i] = NewItem(fmt.Sprintf("Project %05d", i), "A project", fmt.Sprintf("p%d", i))
+	}
+	items[n-1] = items[n-1].WithShortcut("z")
+	return New().SetItems(items).SetSize(80, 30)
+}
+
+func BenchmarkView_10k(b *testing.B) {
+	m := benchMenu(10000)
+	for b.Loop() {
+		_ = m.View()
+	}
+}
+
+func BenchmarkShortcut_10k(b *testing.B) {
+	m := benchMenu(10000)
+	key := tea.KeyPressMsg{Code: 'z', Text: "z"}
+	for b.Loop() {
+		_, _ = m.Update(key)
+	}
+}
@@ -0,0 +1,36 @@
+// Package ui — status message history for rootModel.
+package ui
+
+import (
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"scaffold/internal/ui/nav"
+	"scaffold/internal/ui/screens"
+)
+
+// newMessages returns the message history screen listing the status
+// messages shown so far.
+func (m rootModel) newMessages() *screens.Messages {
+	return screens.NewMessages(m.statusbar.History(), m.since)
+}
+
+// since formats t, a time in the past, as the config says.
+func (m rootModel) since(t time.Time) string {
+	return m.timeFormat().Since(t, time.Now())
+}
+
+// openMessages returns to the message history if it is already in the
+// stack, brought up to date, or opens it.
+func (m rootModel) openMessages() (tea.Model, tea.Cmd) {
+	if !m.stack.Contains("messages") {
+		return m.handleNavigate(NavigateMsg{Screen: m.newMessages()})
+	}
+	updated, cmd := m.handlePopTo(nav.PopToMsg{ID: "messages"})
+	m = updated.(rootModel)
+	if s, ok := m.stack.Top().(*screens.Messages); ok {
+		s.SetHistory(m.statusbar.History())
+	}
+	return m, cmd
+}
@@ -2,17 +2,29 @@ package ui
 
 import (
 	"context"
+	"math/rand"
+	"os"
+	"time"
 
 	"charm.land/bubbles/v2/help"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	uv "github.com/charmbracelet/ultraviolet"
 
+	"banner"
 	"scaffold/config"
+	"scaffold/internal/breaks"
+	"scaffold/internal/features"
+	"scaffold/internal/logger"
+	"scaffold/internal/plugin"
+	"scaffold/internal/stats"
 	"scaffold/internal/task"
+	"scaffold/internal/timefmt"
 	"scaffold/internal/ui/header"
 	"scaffold/internal/ui/keys"
 	"scaffold/internal/ui/menu"
 	"scaffold/internal/ui/modal"
+	"scaffold/internal/ui/nav"
 	"scaffold/internal/ui/screens"
 	"scaffold/internal/ui/statusbar"
 	"scaffold/internal/ui/theme"
@@ -23,6 +35,9 @@ type NavigateMsg struct {
 	Screen screens.Screen
 }
 
+// historyCapacity is how many popped screens Forward can re-enter.
+const historyCapacity = 10
+
 // rootState represents the loading state of the root model.
 type rootState int
 
@@ -32,84 +47,97 @@ const (
 	rootStateError                    // unrecoverable startup error
 )
 
-// screenStack holds the navigation history.
-type screenStack struct {
-	screens []screens.Screen
-}
-
-// Push adds a screen to the stack.
-func (s *screenStack) Push(screen screens.Screen) {
-	s.screens = append(s.screens, screen)
-}
-
-// Pop removes and returns the top screen.
-func (s *screenStack) Pop() screens.Screen {
-	if len(s.screens) == 0 {
-		return nil
-	}
-	idx := len(s.screens) - 1
-	screen := s.screens[idx]
-	s.screens = s.screens[:idx]
-	return screen
-}
-
-// Peek returns the top screen without removing it.
-func (s *screenStack) Peek() screens.Screen {
-	if len(s.screens) == 0 {
-		return nil
-	}
-	return s.screens[len(s.screens)-1]
-}
-
-// Len returns the stack depth.
-func (s *screenStack) Len() int {
-	return len(s.screens)
-}
-
 // rootModel is the root tea.Model — owns routing, WindowSize, header/footer.
 type rootModel struct {
-	ctx        context.Context
-	cancel     context.CancelFunc // shutdown only; cancels all running tasks on quit
-	cfg        config.Config
-	configPath string // empty = no persistent save
-	firstRun   bool
-	width      int
-	height     int
-	bodyH      int // cached body height, updated on resize/navigation/theme change
-	themeMgr   *theme.Manager
-	state      rootState
-	styles     theme.Styles
-	keys       keys.GlobalKeyMap
-	help       help.Model
-	modal      modal.Model
-	header     header.Model
-	statusbar  statusbar.Model
-	current    screens.Screen
-	stack      screenStack
+	ctx         context.Context
+	cancel      context.CancelFunc // shutdown only; cancels all running tasks on quit
+	cfg         config.Config
+	configPath  string // empty = no persistent save
+	firstRun    bool
+	notes       string     // scratchpad text when there is no config file to save it beside
+	rng         *rand.Rand // source for random theme picks; seeded by Snapshot
+	width       int
+	height      int
+	bodyH       int // cached body height, updated on resize/navigation/theme change
+	themeMgr    *theme.Manager
+	state       rootState
+	styles      theme.Styles
+	keys        keys.GlobalKeyMap
+	help        help.Model
+	modal       modal.Model
+	header      header.Model
+	statusbar   statusbar.Model
+	stack       nav.Stack             // navigation history; Top() is the active screen
+	anim        nav.Animation         // push/pop transition; inactive unless enabled
+	termColors  theme.TerminalColors  // replies to the startup color queries
+	plugins     *plugin.Host          // running plugins; nil when none were loaded
+	manifests   []plugin.Manifest     // discovered plugins that Init has yet to start
+	pendingNav  *nav.State            // a stack with plugin screens, reopened once they start
+	stats       *stats.Recorder       // local usage counts; nil when not recorded
+	stateColor  StateColor            // global state for the state stripe; nil = no stripe
+	tour        tour                  // the --tour walkthrough; inactive unless requested
+	breaks      breaks.Timer          // break reminder timer; unused unless enabled
+	breakGen    int                   // bumped on each restart of breaks, to drop stale ticks
+	breakHooks  BreakHooks            // called around breaks the user accepts
+	bgDark      bool                  // the terminal reported a dark background
+	saveGen     int                   // bumped on each debounced config save, to drop stale ones
+	savePending bool                  // a debounced config save has not been written yet
+	quitSignal  os.Signal             // the signal that shut the program down, if any
+	locale      string                // governs clock times; empty for 24-hour
+	lastInput   time.Time             // when the user last pressed a key, clicked or pasted
+	idleGen     int                   // bumped on each restart of the idle timer, to drop stale ticks
+	idle        bool                  // no input for the idle timeout; the next key only wakes the UI
+	saver       banner.AnimatedBanner // the idle screensaver; empty unless shown
 }
 
 // newRootModel creates a new root model.
 func newRootModel(ctx context.Context, cancel context.CancelFunc, cfg config.Config, configPath string, firstRun bool) rootModel {
-	return rootModel{
+	m := rootModel{
 		ctx:        ctx,
 		cancel:     cancel,
 		cfg:        cfg,
 		configPath: configPath,
 		firstRun:   firstRun,
-		themeMgr:   theme.GetManager(),
-		current:    screens.NewHome(),
+		rng:        rand.New(rand.NewSource(time.Now().UnixNano())),
+		themeMgr:   theme.FromContext(ctx),
+		stack:      nav.NewStack(screens.NewHome()),
 		keys:       keys.DefaultGlobalKeyMap(),
 		help:       help.New(),
 		header:     header.New(cfg),
 		statusbar:  statusbar.New(cfg),
 	}
+	if m.themeMgr == nil {
+		m.themeMgr = theme.NewManager()
+	}
+	m.stack.EnableHistory(historyCapacity)
+	if cfg.Debug {
+		m.stack.Intercept(nav.LogMessages(logger.Debug))
+	}
+	return m
+}
+
+// timeFormat returns the formatter for durations and times shown anywhere
+// in the UI, in the style and date layout the config sets.
+func (m rootModel) timeFormat() timefmt.Formatter {
+	return timefmt.New(timefmt.Style(m.cfg.UI.TimeStyle), m.cfg.UI.DateFormat, m.locale)
 }
 
 // Init initializes the root model.
 func (m rootModel) Init() tea.Cmd {
+	m.themeMgr.SetColorblindSafe(m.cfg.UI.ColorblindSafe) // Init below sends the update
+	theme.SetTokens(designTokens(m.cfg))
+	m.themeMgr.SetFadeFrames(m.themeFadeFrames())
 	cmds := tea.Batch(
 		tea.RequestBackgroundColor,
-		m.themeMgr.Init(m.cfg.UI.ThemeName, false, m.width),
+		tea.RequestForegroundColor,
+		theme.RequestANSIColors(), // for the "terminal" theme
+		theme.RequestCapabilities(),
+		m.themeMgr.Init(m.cfg.UI.ThemeName, m.isDark(), m.width),
+		m.stack.Top().Init(), // non-nil when a restored screen is on top
+		m.startPlugins(),
+		m.startTour(),
+		m.breakTick(),
+		m.idleTick(time.Now()),
 	)
 	if m.firstRun {
 		return tea.Batch(cmds, func() tea.Msg {
@@ -121,13 +149,31 @@ func (m rootModel) Init() tea.Cmd {
 
 // Update handles messages for the root model.
 func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.recordStats(msg)
+	if isInput(msg) {
+		if m.idle {
+			return m.wake(time.Now())
+		}
+		m.lastInput = time.Now()
+	}
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		return m.handleWindowSize(msg)
 	case tea.BackgroundColorMsg:
 		return m.handleBgColor(msg)
+	case tea.ForegroundColorMsg, uv.UnknownOscEvent:
+		if m.termColors.Record(msg) {
+			return m, m.registerTerminalTheme()
+		}
+		return m.broadcast(msg)
+	case tea.ColorProfileMsg, tea.TerminalVersionMsg, tea.ModeReportMsg:
+		return m.handleCapabilityReply(msg)
 	case theme.ThemeChangedMsg:
 		return m.handleThemeChanged(msg)
+	case theme.FadeFrameMsg:
+		return m, m.themeMgr.Fade(msg)
+	case StateChangedMsg:
+		return m, nil // the next render reads the new state
 	case tea.KeyPressMsg:
 		return m.handleKey(msg)
 	case modal.ShowMsg:
@@ -144,8 +190,56 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m.handleMenuSelection(msg)
 	case screens.SettingsSavedMsg:
 		return m.handleSettingsSaved(msg)
+	case features.ChangedMsg:
+		return m.handleFeatureChanged(msg)
+	case screens.NotesSavedMsg:
+		return m.handleNotesSaved(msg)
+	case screens.ThemePreviewMsg:
+		return m.handleThemePreview(msg)
+	case screens.ThemeSaveAsMsg:
+		return m.handleThemeSaveAs(msg)
+	case theme.ThemeFileChangedMsg:
+		return m.handleThemeFileChanged(msg)
+	case screens.CopyToClipboardMsg:
+		return m.handleCopyToClipboard(msg)
+	case tourTickMsg:
+		return m.handleTourTick(msg)
+	case breakTickMsg:
+		return m.handleBreakTick(msg)
+	case idleTickMsg:
+		return m.handleIdleTick(msg)
+	case shutdownMsg:
+		return m.handleShutdown(msg)
+	case configSaveMsg:
+		return m.handleConfigSave(msg)
+	case pluginsStartedMsg:
+		return m.handlePluginsStarted(msg)
+	case pluginStatusTickMsg:
+		return m, m.pollPluginStatus()
 	case screens.BackMsg:
 		return m.handleBack(msg)
+	case nav.PushMsg:
+		return m.handlePush(msg)
+	case nav.AnimFrameMsg:
+		var cmd tea.Cmd
+		m.anim, cmd = m.anim.Update(msg)
+		return m, cmd
+	case nav.PopMsg:
+		return m.handleBack(screens.BackMsg{})
+	case nav.ReplaceMsg:
+		return m.handleReplace(msg)
+	case nav.ForwardMsg:
+		return m.handleForward(msg)
+	case nav.PopToMsg:
+		return m.handlePopTo(msg)
+	case nav.BroadcastMsg:
+		return m.handleBroadcast(msg)
+	case nav.PresentMsg:
+		return m.handlePresent(msg)
+	case nav.DismissMsg:
+		return m.handleDismiss(msg)
+	case nav.NavEventMsg:
+		return m.handleNavEvent(msg)
 	}
 	return m.broadcast(msg)
 }
@@ -155,18 +249,41 @@ func (m rootModel) View() tea.View {
 	if m.state != rootStateReady {
 		return tea.NewView("")
 	}
+	if m.idle && m.cfg.Idle.Screensaver && !m.modal.Visible() {
+		return tea.NewView(m.screensaverView())
+	}
+
+	body := m.bodyView()
+	if m.anim.Active() {
+		body = m.anim.View()
+	}
 
-	content := lipgloss.JoinVertical(lipgloss.Left,
+	parts := []string{
 		m.header.View().Content,
-		m.styles.Body.MaxHeight(m.bodyH).Render(m.current.Body()),
+		body,
 		m.helpView(),
 		m.statusbar.View().Content,
-	)
+	}
+	if stripe := m.stripeView(); stripe != "" {
+		parts = append([]string{stripe}, parts...)
+	}
+	content := lipgloss.JoinVertical(lipgloss.Left, parts...)
 
 	base := m.styles.App.Render(content)
 
+	if p := m.stack.Presented(); p != nil {
+		base = nav.Overlay(base, p.Body(), m.width, m.height)
+	}
+	if m.stack.DebugVisible() {
+		base = nav.Overlay(base, m.stack.DebugView(), m.width, m.height)
+	}
+
 	if m.modal.Visible() {
-		return tea.NewView(modal.Overlay(base, m.modal.View().Content, m.width, m.height))
+		base = modal.Overlay(base, m.modal.View().Content, m.width, m.height)
+	}
+	v := tea.NewView(base)
+	if e, ok := m.stack.Top().(screens.KeyboardEnhancer); ok {
+		v.KeyboardEnhancements = e.KeyboardEnhancements()
 	}
-	return tea.NewView(base)
+	return v
 }
@@ -2,14 +2,27 @@ package ui
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
 	"testing"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"scaffold/config"
+	"scaffold/internal/breaks"
+	"scaffold/internal/ui/modal"
+	"scaffold/internal/ui/nav"
 	"scaffold/internal/ui/screens"
 	"scaffold/internal/ui/status"
+	"scaffold/internal/ui/theme"
+	"scaffold/internal/ui/theme/themetest"
 )
 
 // testModel returns a minimal rootModel suitable for unit tests.
@@ -40,6 +53,51 @@ func TestRootModel_WindowSizeMsg_SetsReadyState(t *testing.T) {
 	assert.Equal(t, 40, root.height)
 }
 
+// sizeRecorder is a screen that records the window sizes it is sent.
+type sizeRecorder struct{ sizes []tea.WindowSizeMsg }
+
+func (r *sizeRecorder) Init() tea.Cmd { return nil }
+func (r *sizeRecorder) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		r.sizes = append(r.sizes, size)
+	}
+	return r, nil
+}
+func (r *sizeRecorder) View() tea.View { return tea.NewView("") }
+func (r *sizeRecorder) Body() string   { return "" }
+
+func TestRootModel_WindowSizeMsg_CachedOnStack(t *testing.T) {
+	m := testModel(t)
+	size := tea.WindowSizeMsg{Width: 120, Height: 40}
+	updated, _ := m.Update(size)
+	root := updated.(rootModel)
+
+	got, ok := root.stack.Size()
+	require.True(t, ok)
+	assert.Equal(t, size, got)
+
+	// A screen pushed later gets the cached size from the stack.
+	screen := &sizeRecorder{}
+	updated, _ = root.Update(NavigateMsg{Screen: screen})
+	assert.Equal(t, []tea.WindowSizeMsg{size}, screen.sizes)
+
+	// Covered screens get resizes too.
+	bigger := tea.WindowSizeMsg{Width: 160, Height: 50}
+	updated, _ = updated.(rootModel).Update(NavigateMsg{Screen: screens.NewNotes("")})
+	updated.(rootModel).Update(bigger)
+	assert.Equal(t, []tea.WindowSizeMsg{size, bigger}, screen.sizes)
+}
+
+func TestRootModel_NavDebug_ShowsWindowSize(t *testing.T) {
+	m := testModel(t)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	updated, _ = updated.(rootModel).Update(tea.KeyPressMsg{Code: tea.KeyF12})
+	root := updated.(rootModel)
+
+	require.True(t, root.stack.DebugVisible())
+	assert.Contains(t, root.View().Content, "nav stack · window 120×40")
+}
+
 func TestRootModel_View_EmptyUntilReady(t *testing.T) {
 	m := testModel(t)
 	// No WindowSizeMsg sent — should render nothing.
@@ -55,15 +113,13 @@ func TestRootModel_NavigateMsg_PushesCurrentScreen(t *testing.T) {
 	updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
 	m = updated.(rootModel)
 
-	initialScreen := m.current
 	newScreen := screens.NewHome()
 
 	updated, _ = m.Update(NavigateMsg{Screen: newScreen})
 	root := updated.(rootModel)
 
-	assert.Equal(t, 1, root.stack.Len(), "original screen should be on the stack")
-	assert.Equal(t, newScreen, root.current, "current screen should be the new one")
-	_ = initialScreen // referenced to confirm it was pushed
+	assert.Equal(t, 2, root.stack.Len(), "original screen should remain beneath the new one")
+	assert.Equal(t, newScreen, root.stack.Top(), "active screen should be the new one")
 }
 
 func TestRootModel_NavigateMsg_Stacks(t *testing.T) {
@@ -78,23 +134,219 @@ func TestRootModel_NavigateMsg_Stacks(t *testing.T) {
 	updated, _ = updated.(rootModel).Update(NavigateMsg{Screen: screen2})
 	root := updated.(rootModel)
 
-	assert.Equal(t, 2, root.stack.Len(), "two screens should be on the stack")
+	assert.Equal(t, 3, root.stack.Len(), "two screens should be pushed above the root")
 }
 
 // --- BackMsg ---
 
+func TestRootModel_PushMsg_Lazy(t *testing.T) {
+	m := testModel(t)
+	built := 0
+	msg := nav.PushLazy(func() nav.Screen {
+		built++
+		return screens.NewHome()
+	})().(nav.PushMsg)
+	require.Zero(t, built)
+
+	updated, _ := m.Update(msg)
+	root := updated.(rootModel)
+
+	assert.Equal(t, 1, built)
+	assert.Equal(t, 2, root.stack.Len())
+}
+
 func TestRootModel_BackMsg_PopsScreen(t *testing.T) {
 	m := testModel(t)
 	updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
 	m = updated.(rootModel)
 
-	original := m.current
+	original := m.stack.Top()
 	updated, _ = m.Update(NavigateMsg{Screen: screens.NewHome()})
 	updated, _ = updated.(rootModel).Update(screens.BackMsg{})
 	root := updated.(rootModel)
 
-	assert.Equal(t, original, root.current, "BackMsg should restore the previous screen")
-	assert.Equal(t, 0, root.stack.Len(), "stack should be empty after pop")
+	assert.Equal(t, original, root.stack.Top(), "BackMsg should restore the previous screen")
+	assert.Equal(t, 1, root.stack.Len(), "only the root screen should remain after pop")
+}
+
+func TestRootModel_BackMsg_DismissesPresentedFirst(t *testing.T) {
+	m := testModel(t)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	updated, _ = updated.(rootModel).Update(NavigateMsg{Screen: screens.NewHome()})
+	updated, _ = updated.(rootModel).Update(nav.PresentMsg{Screen: screens.NewWelcome()})
+	root := updated.(rootModel)
+	require.NotNil(t, root.stack.Presented())
+
+	updated, _ = root.Update(screens.BackMsg{})
+	root = updated.(rootModel)
+
+	assert.Nil(t, root.stack.Presented(), "BackMsg should dismiss the presented screen")
+	assert.Equal(t, 2, root.stack.Len(), "the stack underneath must be untouched")
+}
+
+func TestRootModel_PopToMsg_ReturnsToScreen(t *testing.T) {
+	m := testModel(t)
+	updated, _ := m.Update(NavigateMsg{Screen: screens.NewSettings(m.cfg)})
+	updated, _ = updated.(rootModel).Update(NavigateMsg{Screen: screens.NewWelcome()})
+	updated, _ = updated.(rootModel).Update(nav.PopToMsg{ID: "home"})
+	root := updated.(rootModel)
+
+	assert.Equal(t, 1, root.stack.Len())
+	assert.False(t, root.stack.Contains("settings"))
+}
+
+// --- transitions ---
+
+func TestRootModel_Transition_DisabledByDefault(t *testing.T) {
+	m := testModel(t)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	updated, _ = updated.(rootModel).Update(NavigateMsg{Screen: screens.NewWelcome()})
+
+	assert.False(t, updated.(rootModel).anim.Active())
+}
+
+func TestRootModel_Transition_SlideOnPushAndPop(t *testing.T) {
+	m := testModel(t)
+	m.cfg.UI.Transition = "slide"
+	m.cfg.UI.AnimationSpeed = "fast"
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	updated, _ = updated.(rootModel).Update(NavigateMsg{Screen: screens.NewWelcome()})
+	root := updated.(rootModel)
+	require.True(t, root.anim.Active(), "push should start a transition")
+	assert.NotEmpty(t, root.View().Content)
+
+	updated, _ = root.Update(screens.BackMsg{})
+	assert.True(t, updated.(rootModel).anim.Active(), "pop should start a transition")
+}
+
+func TestRootModel_Transition_ReducedMotion(t *testing.T) {
+	m := testModel(t)
+	m.cfg.UI.Transition = "fade"
+	m.cfg.UI.AnimationSpeed = "none"
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	updated, _ = updated.(rootModel).Update(NavigateMsg{Screen: screens.NewWelcome()})
+
+	assert.False(t, updated.(rootModel).anim.Active())
+}
+
+// --- navigation state persistence ---
+
+func TestRootModel_NavState_RestoresAcrossRestarts(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	m := New(ctx, cancel, *config.DefaultConfig(), configPath, false)
+	updated, _ := m.Update(NavigateMsg{Screen: screens.NewDetail("Dashboard", "desc", "dashboard", ctx)})
+	updated.(rootModel).saveNavState()
+
+	restored := New(ctx, cancel, *config.DefaultConfig(), configPath, false)
+
+	require.Equal(t, 2, restored.stack.Len())
+	detail, ok := restored.stack.Top().(*screens.Detail)
+	require.True(t, ok, "top screen should be rebuilt as a Detail")
+	assert.Equal(t, "dashboard", detail.Params()["id"])
+}
+
+func TestRootModel_NavState_SkippedOnFirstRun(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	m := New(ctx, cancel, *config.DefaultConfig(), configPath, false)
+	updated, _ := m.Update(NavigateMsg{Screen: screens.NewSettings(m.cfg)})
+	updated.(rootModel).saveNavState()
+
+	restored := New(ctx, cancel, *config.DefaultConfig(), configPath, true)
+	assert.Equal(t, 1, restored.stack.Len())
+}
+
+func TestRootModel_WithScreen_DeepLaunch(t *testing.T) {
+	m, err := testModel(t).WithScreen("detail?id=about")
+	require.NoError(t, err)
+
+	require.Equal(t, 2, m.stack.Len(), "the target should sit on top of Home")
+	detail, ok := m.stack.Top().(*screens.Detail)
+	require.True(t, ok)
+	assert.Equal(t, "about", detail.ScreenID())
+
+	m, err = testModel(t).WithScreen("home")
+	require.NoError(t, err)
+	assert.Equal(t, 1, m.stack.Len())
+}
+
+func TestRootModel_WithScreen_UnknownRoute(t *testing.T) {
+	_, err := testModel(t).WithScreen("nowhere")
+	assert.ErrorContains(t, err, `unknown screen "nowhere"`)
+}
+
+func TestRootModel_ForwardKey_ReentersPoppedScreen(t *testing.T) {
+	m := testModel(t)
+	settings := screens.NewSettings(m.cfg)
+	updated, _ := m.Update(NavigateMsg{Screen: settings})
+	updated, _ = updated.(rootModel).Update(screens.BackMsg{})
+	updated, _ = updated.(rootModel).Update(tea.KeyPressMsg{Code: tea.KeyRight, Mod: tea.ModAlt})
+	root := updated.(rootModel)
+
+	assert.Equal(t, 2, root.stack.Len())
+	assert.Equal(t, settings, root.stack.Top())
+}
+
+func TestRootModel_Forward_ReentersLeftSettings(t *testing.T) {
+	m := testModel(t)
+	settings := screens.NewSettings(m.cfg)
+	updated, _ := m.Update(NavigateMsg{Screen: settings})
+	updated, cmd := updated.(rootModel).Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	require.NotNil(t, cmd)
+	require.IsType(t, screens.BackMsg{}, cmd(), "esc leaves settings")
+	updated, _ = updated.(rootModel).Update(screens.BackMsg{})
+	updated, _ = updated.(rootModel).Update(nav.ForwardMsg{})
+	root := updated.(rootModel)
+	require.Equal(t, settings, root.stack.Top())
+
+	// The form is usable again rather than left aborted.
+	_, cmd = root.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	if cmd != nil {
+		switch msg := cmd().(type) {
+		case screens.BackMsg, screens.SettingsSavedMsg:
+			t.Errorf("re-entered settings sent %T", msg)
+		}
+	}
+}
+
+// --- notes ---
+
+func TestRootModel_Notes_TypingQDoesNotQuit(t *testing.T) {
+	m := testModel(t)
+	updated, _ := m.Update(tea.KeyPressMsg{Code: 'n', Mod: tea.ModCtrl})
+	root := updated.(rootModel)
+	require.IsType(t, &screens.Notes{}, root.stack.Top())
+
+	updated, cmd := root.Update(tea.KeyPressMsg{Code: 'q', Text: "q"})
+	root = updated.(rootModel)
+
+	if cmd != nil {
+		assert.NotEqual(t, tea.Quit(), cmd(), "q must be typed, not quit")
+	}
+	assert.Equal(t, "q", root.stack.Top().(*screens.Notes).Value())
+}
+
+func TestRootModel_Notes_SavedBesideConfig(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	m := New(ctx, cancel, *config.DefaultConfig(), configPath, false)
+
+	updated, _ := m.openNotes()
+	updated, _ = updated.(rootModel).Update(screens.NotesSavedMsg{Text: "remember this", Close: true})
+	root := updated.(rootModel)
+
+	assert.Equal(t, 1, root.stack.Len(), "closing should pop the notes screen")
+	raw, err := os.ReadFile(filepath.Join(filepath.Dir(configPath), "notes.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "remember this", string(raw))
+	assert.Equal(t, "remember this", root.loadNotes())
 }
 
 // --- status.Msg / status.ClearMsg ---
@@ -122,32 +374,382 @@ func TestRootModel_StatusClearMsg_ResetsStatus(t *testing.T) {
 	assert.Equal(t, status.KindNone, root.statusbar.State().Kind)
 }
 
-// --- screenStack ---
+func TestRootModel_MessagesKey_OpensHistory(t *testing.T) {
+	m := testModel(t)
+	updated, _ := m.Update(status.Msg{Text: "first", Kind: status.KindInfo})
+	updated, _ = updated.(rootModel).Update(status.Msg{Text: "second", Kind: status.KindError})
+	root := updated.(rootModel)
+	assert.Equal(t, "first", root.statusbar.State().Text, "the second waits its turn")
+
+	updated, _ = root.Update(tea.KeyPressMsg{Code: 'm', Mod: tea.ModAlt})
+	root = updated.(rootModel)
+	history, ok := root.stack.Top().(*screens.Messages)
+	require.True(t, ok)
+	body := history.Body()
+	assert.Contains(t, body, "first")
+	assert.Contains(t, body, "second")
+}
+
+// --- usage stats ---
+
+func TestRootModel_RecordsStats(t *testing.T) {
+	m := testModel(t)
+	m.configPath = filepath.Join(t.TempDir(), "config.json")
+	m.startStats(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	updated, _ = updated.Update(tea.MouseMotionMsg{X: 3, Y: 4})
+	updated, _ = updated.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	settings := screens.NewSettings(m.cfg)
+	updated, _ = updated.Update(nav.PushedMsg{From: m.stack.Top(), To: settings})
+	updated, _ = updated.Update(status.Msg{Text: "disk full", Kind: status.KindError})
+	m = updated.(rootModel)
+	require.NoError(t, m.stats.Save())
+
+	s := m.stats.Stats()
+	assert.Equal(t, 1, s.Sessions)
+	assert.Equal(t, 1, s.Iterations, "only the key press is input")
+	assert.Equal(t, map[string]int{"home": 1, "settings": 1}, s.Screens)
+	assert.Equal(t, map[string]int{"home": 1}, s.Errors, "counted against the active screen")
+	_, err := os.Stat(m.statsPath())
+	assert.NoError(t, err)
+}
+
+func TestRootModel_StatsDisabled(t *testing.T) {
+	m := testModel(t)
+	m.configPath = filepath.Join(t.TempDir(), "config.json")
+	m.cfg.App.DisableStats = true
+	m.startStats(time.Now())
+
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	assert.Nil(t, m.stats)
+	require.NoError(t, m.stats.Save())
+	_, err := os.Stat(m.statsPath())
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+// --- state stripe ---
+
+func TestRootModel_StateStripe(t *testing.T) {
+	kind, ok := status.KindSuccess, true
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	p := themetest.Palette()
+	ctx = theme.NewContext(ctx, themetest.NewManager(p))
+	m := newRootModel(ctx, cancel, config.Config{LogLevel: "info"}, "", false)
+	m.cfg.UI.StateStripe = true
+	m = m.WithStateColor(func() (status.Kind, bool) { return kind, ok })
+	m.themeMgr.Init("default", true, 80)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 30})
+	updated, _ = updated.Update(theme.ThemeChangedMsg{State: m.themeMgr.State()})
+	m = updated.(rootModel)
+
+	assert.Equal(t, 1, m.stripeLines())
+	first := strings.SplitN(m.View().Content, "\n", 2)[0]
+	assert.Contains(t, first, "▀", "the stripe is the first line")
+	assert.Equal(t, m.stripeView(), lipgloss.NewStyle().
+		Foreground(p.Success).Render(strings.Repeat("▀", m.styles.MaxWidth)))
+
+	kind = status.KindError
+	updated, _ = m.Update(StateChangedMsg{})
+	m = updated.(rootModel)
+	assert.Contains(t, m.stripeView(), strings.Repeat("▀", 3))
+	assert.NotEqual(t, first, strings.SplitN(m.View().Content, "\n", 2)[0], "redrawn in the error color")
+
+	ok = false
+	assert.Equal(t, strings.Repeat(" ", m.styles.MaxWidth), m.stripeView(), "the line is kept blank")
+
+	m.cfg.UI.StateStripe = false
+	assert.Empty(t, m.stripeView())
+	assert.Equal(t, 0, m.stripeLines(), "no line is reserved")
+}
+
+// --- rolled themes ---
+
+func TestRootModel_RollTheme(t *testing.T) {
+	m := testModel(t)
+	m.themeMgr.Init("default", true, 80)
+	altT := tea.KeyPressMsg{Code: 't', Mod: tea.ModAlt}
+
+	updated, cmd := m.Update(altT)
+	m = updated.(rootModel)
+	require.NotNil(t, cmd)
+	assert.Equal(t, theme.RandomThemeName, m.cfg.UI.ThemeName)
+	assert.Equal(t, theme.RandomThemeName, m.themeMgr.State().Name)
+	first := m.themeMgr.State().Palette.Primary
+
+	updated, _ = m.Update(altT)
+	m = updated.(rootModel)
+	assert.NotEqual(t, first, m.themeMgr.State().Palette.Primary, "rolling again replaces the theme")
+}
+
+func TestRootModel_CycleTheme(t *testing.T) {
+	m := testModel(t)
+	m.cfg.UI.ThemeName = "default"
+	m.themeMgr.Init("default", true, 80)
+	themes := theme.AvailableThemes()
+	i := slices.Index(themes, "default")
+	require.GreaterOrEqual(t, i, 0)
+
+	updated, _ := m.Update(tea.KeyPressMsg{Code: '.', Mod: tea.ModAlt})
+	m = updated.(rootModel)
+	next := themes[(i+1)%len(themes)]
+	if next == theme.RandomThemeName {
+		next = themes[(i+2)%len(themes)]
+	}
+	assert.Equal(t, next, m.cfg.UI.ThemeName)
+	assert.Equal(t, next, m.themeMgr.State().Name)
+
+	updated, _ = m.Update(tea.KeyPressMsg{Code: ',', Mod: tea.ModAlt})
+	m = updated.(rootModel)
+	assert.Equal(t, "default", m.cfg.UI.ThemeName, "prev undoes next")
+}
 
-func TestScreenStack_PushPop(t *testing.T) {
-	var s screenStack
-	a := screens.NewHome()
-	b := screens.NewHome()
+func TestRootModel_CycleAppearance(t *testing.T) {
+	m := testModel(t)
+	m.themeMgr.Init("default", false, 80)
+	altD := tea.KeyPressMsg{Code: 'd', Mod: tea.ModAlt}
+
+	updated, _ := m.Update(tea.BackgroundColorMsg{Color: lipgloss.Color("#ffffff")})
+	m = updated.(rootModel)
+	assert.False(t, m.themeMgr.State().IsDark, "auto follows a light terminal")
+
+	updated, _ = m.Update(altD)
+	m = updated.(rootModel)
+	assert.Equal(t, "dark", m.cfg.UI.Appearance)
+	assert.True(t, m.themeMgr.State().IsDark)
+
+	// A forced appearance outlasts later background reports.
+	updated, _ = m.Update(tea.BackgroundColorMsg{Color: lipgloss.Color("#ffffff")})
+	m = updated.(rootModel)
+	assert.True(t, m.themeMgr.State().IsDark)
 
-	s.Push(a)
-	s.Push(b)
-	assert.Equal(t, 2, s.Len())
+	updated, _ = m.Update(altD)
+	m = updated.(rootModel)
+	assert.Equal(t, "light", m.cfg.UI.Appearance)
+	assert.False(t, m.themeMgr.State().IsDark)
 
-	got := s.Pop()
-	assert.Equal(t, b, got, "Pop should return LIFO order")
-	assert.Equal(t, 1, s.Len())
+	updated, _ = m.Update(altD)
+	m = updated.(rootModel)
+	assert.Equal(t, "auto", m.cfg.UI.Appearance)
+	assert.False(t, m.themeMgr.State().IsDark)
 }
 
-func TestScreenStack_PopEmpty_ReturnsNil(t *testing.T) {
-	var s screenStack
-	assert.Nil(t, s.Pop())
+func TestRootModel_QuickToggleSavesDebounced(t *testing.T) {
+	m := testModel(t)
+	m.configPath = filepath.Join(t.TempDir(), "config.json")
+	m.themeMgr.Init("default", true, 80)
+	altD := tea.KeyPressMsg{Code: 'd', Mod: tea.ModAlt}
+
+	updated, _ := m.Update(altD)
+	m = updated.(rootModel)
+	stale := configSaveMsg{gen: m.saveGen}
+	updated, _ = m.Update(altD)
+	m = updated.(rootModel)
+
+	updated, _ = m.Update(stale)
+	m = updated.(rootModel)
+	assert.NoFileExists(t, m.configPath, "a save superseded by a later toggle is dropped")
+
+	updated, _ = m.Update(configSaveMsg{gen: m.saveGen})
+	m = updated.(rootModel)
+	saved, err := config.Load(m.configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "light", saved.UI.Appearance)
 }
 
-func TestScreenStack_PeekDoesNotRemove(t *testing.T) {
-	var s screenStack
-	a := screens.NewHome()
-	s.Push(a)
+// --- tour ---
+
+func TestRootModel_Tour(t *testing.T) {
+	m := testModel(t).WithTour()
+	m.themeMgr.Init("default", true, 100)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	updated, _ = updated.(rootModel).Update(theme.ThemeChangedMsg{State: m.themeMgr.State()})
+	m = updated.(rootModel)
+
+	updated, _ = m.Update(tourTickMsg{step: -1})
+	m = updated.(rootModel)
+	assert.Equal(t, 0, m.tour.step)
+	assert.Contains(t, m.statusbar.View().Content, "Tour 1/")
 
-	assert.Equal(t, a, s.Peek())
-	assert.Equal(t, 1, s.Len(), "Peek should not remove the element")
+	updated, _ = m.Update(tourTickMsg{step: 0})
+	m = updated.(rootModel)
+	assert.IsType(t, &screens.Welcome{}, m.stack.Top())
+
+	// Skipping ahead makes the pending tick stale.
+	updated, _ = m.Update(tea.KeyPressMsg{Code: tea.KeySpace})
+	m = updated.(rootModel)
+	assert.Equal(t, 2, m.tour.step)
+	updated, _ = m.Update(tourTickMsg{step: 1})
+	m = updated.(rootModel)
+	assert.Equal(t, 2, m.tour.step, "a stale tick is ignored")
+	assert.Equal(t, 2, m.stack.Len(), "each step opens on Home")
+
+	updated, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	m = updated.(rootModel)
+	assert.False(t, m.tour.active)
+	assert.Equal(t, 2, m.stack.Len(), "ending the tour stays on the screen")
+	assert.NotContains(t, m.statusbar.View().Content, "Tour ")
+}
+
+func TestRootModel_Tour_Finishes(t *testing.T) {
+	m := testModel(t).WithTour()
+	for i := -1; i < len(tourSteps); i++ {
+		updated, _ := m.Update(tourTickMsg{step: i})
+		m = updated.(rootModel)
+		if i >= 0 && i < len(tourSteps)-1 && tourSteps[i+1].route != "" {
+			assert.Equal(t, 2, m.stack.Len(), "step %d opens its screen", i+1)
+		}
+	}
+	assert.False(t, m.tour.active)
+	assert.Equal(t, 1, m.stack.Len(), "the finished tour returns Home")
+	assert.False(t, m.modal.Visible())
+}
+
+// --- break reminders ---
+
+// breakModel returns a ready testModel with break reminders on and a work
+// period that ended an hour ago.
+func breakModel(t *testing.T) rootModel {
+	t.Helper()
+	m := testModel(t)
+	m.cfg.Breaks = config.BreaksConfig{Enabled: true, WorkMinutes: 60, BreakMinutes: 10}
+	m.startBreaks(time.Now().Add(-2 * time.Hour))
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	return updated.(rootModel)
+}
+
+func TestRootModel_BreakReminder_Accept(t *testing.T) {
+	m := breakModel(t)
+	var paused, resumed bool
+	m = m.WithBreakHooks(BreakHooks{
+		Pause:  func() { paused = true },
+		Resume: func() { resumed = true },
+	})
+
+	updated, cmd := m.Update(breakTickMsg{gen: m.breakGen})
+	m = updated.(rootModel)
+	require.True(t, m.modal.Visible(), "a due reminder asks")
+	assert.NotNil(t, cmd, "the timer keeps ticking")
+
+	updated, _ = m.Update(modal.ConfirmedMsg{ID: breakModalID})
+	m = updated.(rootModel)
+	assert.False(t, m.modal.Visible())
+	assert.True(t, paused)
+	assert.Equal(t, breaks.OnBreak, m.breaks.Phase())
+	assert.False(t, resumed)
+
+	// Move the break into the past so the next tick ends it.
+	m.breaks = m.breaks.TakeBreak(time.Now().Add(-time.Hour))
+	updated, _ = m.Update(breakTickMsg{gen: m.breakGen})
+	m = updated.(rootModel)
+	assert.True(t, resumed)
+	assert.Equal(t, breaks.Working, m.breaks.Phase())
+}
+
+func TestRootModel_BreakReminder_Decline(t *testing.T) {
+	m := breakModel(t)
+	updated, _ := m.Update(breakTickMsg{gen: m.breakGen})
+	m = updated.(rootModel)
+	require.True(t, m.modal.Visible())
+
+	updated, _ = m.Update(modal.CancelledMsg{ID: breakModalID})
+	m = updated.(rootModel)
+	assert.False(t, m.modal.Visible())
+	assert.Equal(t, breaks.Working, m.breaks.Phase())
+	assert.InDelta(t, breaks.Snooze, m.breaks.Remaining(time.Now()), float64(time.Second))
+
+	// A tick from before a restart is dropped.
+	_, cmd := m.Update(breakTickMsg{gen: m.breakGen - 1})
+	assert.Nil(t, cmd)
+}
+
+// --- idle ---
+
+func idleModel(t *testing.T, screensaver bool) rootModel {
+	t.Helper()
+	m := testModel(t)
+	m.cfg.Idle = config.IdleConfig{Enabled: true, Minutes: 10, Screensaver: screensaver}
+	m.startIdle(time.Now().Add(-time.Hour))
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	return updated.(rootModel)
+}
+
+func TestRootModel_Idle_ReturnsHomeAndLocks(t *testing.T) {
+	m := idleModel(t, false)
+	m.stack.Push(screens.NewDetail("Detail", "", "detail", m.ctx))
+	require.Equal(t, 2, m.stack.Len())
+
+	updated, _ := m.Update(idleTickMsg{gen: m.idleGen, at: time.Now()})
+	m = updated.(rootModel)
+	assert.True(t, m.idle)
+	assert.Equal(t, 1, m.stack.Len(), "idle returns to the home screen")
+
+	// The waking key does nothing else: alt+m would otherwise open the
+	// message history.
+	updated, _ = m.Update(tea.KeyPressMsg{Code: 'm', Mod: tea.ModAlt})
+	m = updated.(rootModel)
+	assert.False(t, m.idle)
+	assert.Equal(t, 1, m.stack.Len())
+}
+
+func TestRootModel_Idle_WaitsForTimeout(t *testing.T) {
+	m := idleModel(t, false)
+	updated, _ := m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	m = updated.(rootModel)
+
+	// A tick due before the input moved the deadline checks again later.
+	updated, cmd := m.Update(idleTickMsg{gen: m.idleGen, at: time.Now()})
+	m = updated.(rootModel)
+	assert.False(t, m.idle)
+	assert.NotNil(t, cmd)
+
+	// A tick from before a restart is dropped.
+	updated, cmd = m.Update(idleTickMsg{gen: m.idleGen - 1, at: time.Now().Add(time.Hour)})
+	assert.False(t, updated.(rootModel).idle)
+	assert.Nil(t, cmd)
+}
+
+func TestRootModel_Idle_Screensaver(t *testing.T) {
+	m := idleModel(t, true)
+	m.cfg.App.Name = "scaffold"
+	updated, _ := m.Update(idleTickMsg{gen: m.idleGen, at: time.Now()})
+	m = updated.(rootModel)
+	require.True(t, m.idle)
+	view := m.View().Content
+	assert.Contains(t, view, "Press any key to continue")
+	assert.Equal(t, 30, lipgloss.Height(view), "the screensaver fills the screen")
+
+	updated, _ = m.Update(tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+	assert.NotContains(t, updated.(rootModel).View().Content, "Press any key to continue")
+}
+
+// --- shutdown ---
+
+func TestRootModel_ShutdownSignal(t *testing.T) {
+	m := testModel(t)
+	updated, cmd := m.Update(shutdownMsg{sig: syscall.SIGTERM})
+	m = updated.(rootModel)
+	require.NotNil(t, cmd)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+	assert.Equal(t, syscall.SIGTERM, m.quitSignal)
+
+	assert.Equal(t, 143, SignalError{Signal: syscall.SIGTERM}.ExitCode())
+	assert.Equal(t, 129, SignalError{Signal: syscall.SIGHUP}.ExitCode())
+}
+
+func TestRootModel_FlushConfig(t *testing.T) {
+	m := testModel(t)
+	m.configPath = filepath.Join(t.TempDir(), "config.json")
+	m.themeMgr.Init("default", true, 80)
+
+	m.flushConfig()
+	assert.NoFileExists(t, m.configPath, "nothing is written without a pending save")
+
+	updated, _ := m.Update(tea.KeyPressMsg{Code: 'd', Mod: tea.ModAlt})
+	m = updated.(rootModel)
+	m.flushConfig()
+	saved, err := config.Load(m.configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "dark", saved.UI.Appearance)
 }
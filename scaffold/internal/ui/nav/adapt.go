@@ -0,0 +1,61 @@
+package nav
+
+import tea "charm.land/bubbletea/v2"
+
+// StringModel is a model in the older shape whose View returns a plain
+// string and whose Update returns its own concrete type.
+type StringModel[M any] interface {
+	Init() tea.Cmd
+	Update(tea.Msg) (M, tea.Cmd)
+	View() string
+}
+
+// FromStringModel adapts m so it can be pushed onto a Stack. Body and View
+// both render m.View().
+func FromStringModel[M StringModel[M]](m M) Screen {
+	return &stringScreen[M]{Wrapper: Wrapper{Inner: m}, m: m}
+}
+
+type stringScreen[M StringModel[M]] struct {
+	Wrapper
+
+	m M
+}
+
+func (s *stringScreen[M]) Init() tea.Cmd { return s.m.Init() }
+
+func (s *stringScreen[M]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	s.m, cmd = s.m.Update(msg)
+	s.Inner = s.m
+	return s, cmd
+}
+
+func (s *stringScreen[M]) View() tea.View { return tea.NewView(s.m.View()) }
+func (s *stringScreen[M]) Body() string   { return s.m.View() }
+
+// FromModel adapts a tea.Model that has no Body method so it can be pushed
+// onto a Stack. Body renders the content of m.View().
+func FromModel(m tea.Model) Screen {
+	if s, ok := m.(Screen); ok {
+		return s
+	}
+	return &modelScreen{Wrapper: Wrapper{Inner: m}}
+}
+
+type modelScreen struct {
+	Wrapper
+}
+
+func (s *modelScreen) model() tea.Model { return s.Inner.(tea.Model) }
+
+func (s *modelScreen) Init() tea.Cmd { return s.model().Init() }
+
+func (s *modelScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	s.Inner, cmd = s.model().Update(msg)
+	return s, cmd
+}
+
+func (s *modelScreen) View() tea.View { return s.model().View() }
+func (s *modelScreen) Body() string   { return s.model().View().Content }
@@ -0,0 +1,62 @@
+package nav
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// counter is a string-view model that counts key presses and records hooks.
+type counter struct {
+	n   int
+	log *[]string
+}
+
+func (c counter) Init() tea.Cmd { return nil }
+
+func (c counter) Update(msg tea.Msg) (counter, tea.Cmd) {
+	if _, ok := msg.(tea.KeyPressMsg); ok {
+		c.n++
+	}
+	return c, nil
+}
+
+func (c counter) View() string { return string(rune('0' + c.n)) }
+
+func (c counter) WillAppear() tea.Cmd    { *c.log = append(*c.log, "WillAppear"); return nil }
+func (c counter) Appeared() tea.Cmd      { return nil }
+func (c counter) WillDisappear() tea.Cmd { return nil }
+func (c counter) Disappeared() tea.Cmd   { return nil }
+
+func TestFromStringModel_UpdatesAndRenders(t *testing.T) {
+	var log []string
+	s := NewStack(&fakeScreen{name: "root"})
+	s.Push(FromStringModel(counter{log: &log}))
+
+	s.Update(tea.KeyPressMsg{Code: 'x'})
+	s.Update(tea.KeyPressMsg{Code: 'x'})
+
+	assert.Equal(t, "2", s.Top().Body())
+	assert.Equal(t, "2", s.Top().View().Content)
+	assert.Equal(t, []string{"WillAppear"}, log, "lifecycle hooks reach the wrapped model")
+}
+
+func TestFromModel_WrapsBodylessModel(t *testing.T) {
+	m := bodyless{}
+	screen := FromModel(m)
+
+	assert.Equal(t, "plain", screen.Body())
+	assert.Equal(t, m, screen.(*modelScreen).Inner)
+}
+
+func TestFromModel_PassesScreensThrough(t *testing.T) {
+	a := &fakeScreen{name: "a"}
+	assert.Same(t, a, FromModel(a))
+}
+
+type bodyless struct{}
+
+func (bodyless) Init() tea.Cmd                         { return nil }
+func (b bodyless) Update(tea.Msg) (tea.Model, tea.Cmd) { return b, nil }
+func (bodyless) View() tea.View                        { return tea.NewView("plain") }
@@ -0,0 +1,154 @@
+package nav
+
+import (
+	"strings"
+	"sync/atomic"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// TransitionStyle selects how Push/Pop transitions are animated.
+type TransitionStyle string
+
+// Transition styles. TransitionNone disables animation, which is also the
+// right choice for reduced-motion users.
+const (
+	TransitionNone  TransitionStyle = "none"
+	TransitionSlide TransitionStyle = "slide"
+	TransitionFade  TransitionStyle = "fade"
+)
+
+// Direction is the navigation direction an Animation represents.
+type Direction int
+
+const (
+	// DirectionForward is a Push: the incoming screen slides in from the right.
+	DirectionForward Direction = iota
+	// DirectionBackward is a Pop: the outgoing screen slides out to the right.
+	DirectionBackward
+)
+
+// frameInterval is the delay between animation frames (~30 fps).
+const frameInterval = time.Second / 30
+
+// animSeq issues Animation IDs so stale frame ticks are ignored.
+var animSeq atomic.Int64
+
+// AnimFrameMsg advances the Animation with the matching ID by one frame.
+type AnimFrameMsg struct {
+	id int64
+}
+
+// Animation interpolates between two rendered views over a fixed number of
+// frames. It is a value type: Update returns the advanced copy. The zero
+// value is inactive.
+type Animation struct {
+	id     int64
+	style  TransitionStyle
+	dir    Direction
+	from   []string
+	to     []string
+	width  int
+	frame  int
+	frames int
+}
+
+// NewAnimation prepares a transition from one rendered view to another,
+// width cells wide, lasting frames frames. It returns an inactive Animation
+// when style is TransitionNone or frames or width is not positive.
+func NewAnimation(style TransitionStyle, dir Direction, from, to string, width, frames int) Animation {
+	if style == TransitionNone || style == "" || frames <= 0 || width <= 0 {
+		return Animation{}
+	}
+	fromLines := strings.Split(from, "\n")
+	toLines := strings.Split(to, "\n")
+	h := max(len(fromLines), len(toLines))
+	return Animation{
+		id:     animSeq.Add(1),
+		style:  style,
+		dir:    dir,
+		from:   padLines(fromLines, width, h),
+		to:     padLines(toLines, width, h),
+		width:  width,
+		frames: frames,
+	}
+}
+
+// Active reports whether the animation has frames left to show.
+func (a Animation) Active() bool {
+	return a.frame < a.frames
+}
+
+// Start returns the command that schedules the first frame, or nil when the
+// animation is inactive.
+func (a Animation) Start() tea.Cmd {
+	if !a.Active() {
+		return nil
+	}
+	return a.tick()
+}
+
+func (a Animation) tick() tea.Cmd {
+	id := a.id
+	return tea.Tick(frameInterval, func(time.Time) tea.Msg {
+		return AnimFrameMsg{id: id}
+	})
+}
+
+// Update advances the animation on its own AnimFrameMsg and schedules the
+// next frame until the last one has been reached.
+func (a Animation) Update(msg tea.Msg) (Animation, tea.Cmd) {
+	m, ok := msg.(AnimFrameMsg)
+	if !ok || m.id != a.id || !a.Active() {
+		return a, nil
+	}
+	a.frame++
+	if !a.Active() {
+		return a, nil
+	}
+	return a, a.tick()
+}
+
+// View renders the current frame.
+func (a Animation) View() string {
+	lines := make([]string, len(a.from))
+	switch a.style {
+	case TransitionFade:
+		// Terminals cannot blend colours cell by cell, so fade is a
+		// dissolve: each line switches to the new view at its own frame.
+		for i := range lines {
+			if (i*37)%a.frames < a.frame {
+				lines[i] = a.to[i]
+			} else {
+				lines[i] = a.from[i]
+			}
+		}
+	default:
+		offset := a.width * a.frame / a.frames
+		for i := range lines {
+			if a.dir == DirectionForward {
+				lines[i] = ansi.Cut(a.from[i], offset, a.width) + ansi.Cut(a.to[i], 0, offset)
+			} else {
+				edge := a.width - offset
+				lines[i] = ansi.Cut(a.to[i], edge, a.width) + ansi.Cut(a.from[i], 0, edge)
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// padLines right-pads every line to width cells and appends blank lines up
+// to h, so both views of an Animation share a rectangular shape.
+func padLines(lines []string, width, h int) []string {
+	out := make([]string, h)
+	for i := range out {
+		var l string
+		if i < len(lines) {
+			l = ansi.Truncate(lines[i], width, "")
+		}
+		out[i] = l + strings.Repeat(" ", width-ansi.StringWidth(l))
+	}
+	return out
+}
@@ -0,0 +1,67 @@
+package nav
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// step advances a by one frame as if its tick fired.
+func step(a Animation) Animation {
+	a, _ = a.Update(AnimFrameMsg{id: a.id})
+	return a
+}
+
+func TestAnimation_SlideForward(t *testing.T) {
+	a := NewAnimation(TransitionSlide, DirectionForward, "aaaa", "bbbb", 4, 2)
+	require.True(t, a.Active())
+
+	assert.Equal(t, "aaaa", a.View())
+	a = step(a)
+	assert.Equal(t, "aabb", a.View(), "incoming view enters from the right")
+	a = step(a)
+	assert.False(t, a.Active())
+}
+
+func TestAnimation_SlideBackward(t *testing.T) {
+	a := NewAnimation(TransitionSlide, DirectionBackward, "aaaa", "bbbb", 4, 2)
+
+	a = step(a)
+	assert.Equal(t, "bbaa", a.View(), "outgoing view leaves to the right")
+}
+
+func TestAnimation_Fade_DissolvesToTarget(t *testing.T) {
+	a := NewAnimation(TransitionFade, DirectionForward, "a\na\na", "b\nb\nb", 1, 3)
+
+	steps := 0
+	for a.Active() {
+		prev := a.View()
+		a = step(a)
+		steps++
+		assert.NotEqual(t, prev, a.View(), "each frame should reveal more of the target")
+	}
+	assert.Equal(t, 3, steps)
+	assert.Equal(t, "b\nb\nb", a.View())
+}
+
+func TestAnimation_PadsUnevenViews(t *testing.T) {
+	a := NewAnimation(TransitionSlide, DirectionForward, "ab", "c\nd", 3, 3)
+	assert.Equal(t, "ab \n   ", a.View())
+}
+
+func TestAnimation_IgnoresForeignFrames(t *testing.T) {
+	a := NewAnimation(TransitionSlide, DirectionForward, "a", "b", 1, 2)
+	other := NewAnimation(TransitionSlide, DirectionForward, "a", "b", 1, 2)
+
+	a, cmd := a.Update(AnimFrameMsg{id: other.id})
+
+	assert.Nil(t, cmd)
+	assert.Zero(t, a.frame)
+}
+
+func TestNewAnimation_Disabled(t *testing.T) {
+	assert.False(t, NewAnimation(TransitionNone, DirectionForward, "a", "b", 1, 4).Active())
+	assert.False(t, NewAnimation(TransitionSlide, DirectionForward, "a", "b", 1, 0).Active(), "zero frames is reduced motion")
+	assert.Nil(t, Animation{}.Start())
+}
@@ -0,0 +1,45 @@
+package nav
+
+import tea "charm.land/bubbletea/v2"
+
+// BackgroundAware is an optional interface for screens that want broadcast
+// messages while covered by another screen. WantsBackground is asked for each
+// broadcast; when it returns true the message is delivered through the
+// screen's regular Update.
+type BackgroundAware interface {
+	WantsBackground(msg tea.Msg) bool
+}
+
+// BroadcastMsg asks the root model to deliver Msg to every interested screen
+// in the stack, not just the active one.
+type BroadcastMsg struct {
+	Msg tea.Msg
+}
+
+// Broadcast returns a command that broadcasts msg through the stack.
+func Broadcast(msg tea.Msg) tea.Cmd {
+	return func() tea.Msg { return BroadcastMsg{Msg: msg} }
+}
+
+// Broadcast delivers msg to the active screen as Update would, and to every
+// covered screen that implements BackgroundAware and wants it, so data
+// updates are not stale when those screens are re-exposed by Pop.
+func (s *Stack) Broadcast(msg tea.Msg) tea.Cmd {
+	msg, ok := s.intercept(msg)
+	if !ok {
+		return nil
+	}
+	cmds := []tea.Cmd{s.deliver(msg)}
+	for i := range len(s.screens) - 1 {
+		ba, ok := s.screens[i].(BackgroundAware)
+		if !ok || !ba.WantsBackground(msg) {
+			continue
+		}
+		updated, cmd := s.screens[i].Update(msg)
+		if screen, ok := updated.(Screen); ok {
+			s.screens[i] = screen
+		}
+		cmds = append(cmds, cmd)
+	}
+	return tea.Batch(cmds...)
+}
@@ -0,0 +1,47 @@
+package nav
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// backgroundScreen is a recordingScreen that wants pingMsg while covered.
+type backgroundScreen struct{ recordingScreen }
+
+func (b *backgroundScreen) WantsBackground(msg tea.Msg) bool {
+	_, ok := msg.(pingMsg)
+	return ok
+}
+
+func TestStack_Broadcast_ReachesBackgroundAware(t *testing.T) {
+	aware := &backgroundScreen{recordingScreen{fakeScreen: fakeScreen{name: "aware"}}}
+	plain := &recordingScreen{fakeScreen: fakeScreen{name: "plain"}}
+	top := &recordingScreen{fakeScreen: fakeScreen{name: "top"}}
+	s := NewStack(aware)
+	s.Push(plain)
+	s.Push(top)
+
+	s.Broadcast(pingMsg{1})
+	s.Broadcast(tea.WindowSizeMsg{})
+
+	assert.Equal(t, []tea.Msg{pingMsg{1}}, aware.got, "covered screens only get messages they want")
+	assert.Empty(t, plain.got, "covered screens without BackgroundAware get nothing")
+	assert.Len(t, top.got, 2, "the active screen gets every broadcast")
+}
+
+func TestStack_Update_SkipsCoveredScreens(t *testing.T) {
+	aware := &backgroundScreen{recordingScreen{fakeScreen: fakeScreen{name: "aware"}}}
+	s := NewStack(aware)
+	s.Push(&fakeScreen{name: "top"})
+
+	s.Update(pingMsg{1})
+
+	assert.Empty(t, aware.got, "plain Update must stay scoped to the active screen")
+}
+
+func TestBroadcast_Cmd(t *testing.T) {
+	msg := Broadcast(pingMsg{2})()
+	assert.Equal(t, BroadcastMsg{Msg: pingMsg{2}}, msg)
+}
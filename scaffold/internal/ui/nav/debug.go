@@ -0,0 +1,62 @@
+package nav
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+// ToggleDebug shows or hides the stack debug overlay.
+func (s *Stack) ToggleDebug() {
+	s.debug = !s.debug
+}
+
+// DebugVisible reports whether the debug overlay is shown.
+func (s *Stack) DebugVisible() bool {
+	return s.debug
+}
+
+// DebugView renders the debug overlay: every screen on the stack, root
+// first, with its type, ID, and rendered body size, the active screen
+// marked with ▶, plus the presented screen and forward history, if any.
+func (s *Stack) DebugView() string {
+	var b strings.Builder
+	if size, ok := s.Size(); ok {
+		fmt.Fprintf(&b, "nav stack · window %d×%d\n", size.Width, size.Height)
+	} else {
+		b.WriteString("nav stack · window unknown\n")
+	}
+	active := s.Active()
+	for i, screen := range s.screens {
+		b.WriteString(debugLine(fmt.Sprintf("%d", i), screen, screen == active))
+	}
+	if s.presented != nil {
+		b.WriteString(debugLine("P", s.presented, true))
+	}
+	if len(s.forward) > 0 {
+		fmt.Fprintf(&b, "  forward: %d", len(s.forward))
+	}
+
+	style := lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+	if s.themed {
+		p := s.theme.Palette
+		style = style.BorderForeground(p.Warning).Foreground(p.Foreground)
+	}
+	return style.Render(strings.TrimSuffix(b.String(), "\n"))
+}
+
+// debugLine formats one screen row of the debug overlay.
+func debugLine(label string, screen Screen, active bool) string {
+	marker := " "
+	if active {
+		marker = "▶"
+	}
+	name := fmt.Sprintf("%T", screen)
+	if id := screenID(screen); id != "" {
+		name += " [" + id + "]"
+	}
+	body := screen.Body()
+	return fmt.Sprintf("%s %s %s  %d×%d\n",
+		marker, label, name, lipgloss.Width(body), lipgloss.Height(body))
+}
@@ -0,0 +1,56 @@
+package nav
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+
+	"scaffold/internal/ui/theme/themetest"
+)
+
+func TestStack_ToggleDebug(t *testing.T) {
+	s := NewStack(&fakeScreen{name: "a"})
+	assert.False(t, s.DebugVisible())
+
+	s.ToggleDebug()
+	assert.True(t, s.DebugVisible())
+
+	s.ToggleDebug()
+	assert.False(t, s.DebugVisible())
+}
+
+func TestStack_DebugView_ListsScreens(t *testing.T) {
+	var log []string
+	s := NewStack(newID("home", &log))
+	s.Push(&fakeScreen{name: "detail\nbody"})
+
+	view := ansi.Strip(s.DebugView())
+
+	assert.Contains(t, view, "window unknown")
+	assert.Contains(t, view, "  0 *nav.idScreen [home]  4×1")
+	assert.Contains(t, view, "▶ 1 *nav.fakeScreen  6×2", "the active screen is marked")
+}
+
+func TestStack_DebugView_WindowSize(t *testing.T) {
+	s := NewStack(&fakeScreen{name: "a"})
+	s.Resize(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	assert.Contains(t, ansi.Strip(s.DebugView()), "window 80×24")
+}
+
+func TestStack_DebugView_PresentedAndForward(t *testing.T) {
+	s := NewStack(&fakeScreen{name: "a"})
+	s.EnableHistory(3)
+	s.Push(&fakeScreen{name: "b"})
+	s.Pop()
+	s.Present(&fakeScreen{name: "popup"})
+	s.SetTheme(themetest.State())
+
+	view := ansi.Strip(s.DebugView())
+
+	assert.Contains(t, view, "  0 *nav.fakeScreen", "root is not active while presenting")
+	assert.Contains(t, view, "▶ P *nav.fakeScreen  5×1")
+	assert.Contains(t, view, "forward: 1")
+}
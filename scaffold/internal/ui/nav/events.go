@@ -0,0 +1,35 @@
+package nav
+
+import tea "charm.land/bubbletea/v2"
+
+// NavEventMsg is implemented by the messages the Stack emits after each
+// completed transition, so observers such as the root model, the status bar,
+// or loggers can react to navigation without tracking the stack themselves.
+type NavEventMsg interface {
+	// Screens returns the screen that was active before the transition and
+	// the one active after it. From may be nil for the first push.
+	Screens() (from, to Screen)
+}
+
+// PushedMsg is emitted after Push, PushLazy, or Forward.
+type PushedMsg struct{ From, To Screen }
+
+// PoppedMsg is emitted after Pop or PopTo.
+type PoppedMsg struct{ From, To Screen }
+
+// ReplacedMsg is emitted after Replace.
+type ReplacedMsg struct{ From, To Screen }
+
+// Screens implements NavEventMsg.
+func (m PushedMsg) Screens() (from, to Screen) { return m.From, m.To }
+
+// Screens implements NavEventMsg.
+func (m PoppedMsg) Screens() (from, to Screen) { return m.From, m.To }
+
+// Screens implements NavEventMsg.
+func (m ReplacedMsg) Screens() (from, to Screen) { return m.From, m.To }
+
+// emit wraps an event in a command.
+func emit(msg NavEventMsg) tea.Cmd {
+	return func() tea.Msg { return msg }
+}
@@ -0,0 +1,64 @@
+package nav
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// navEvents runs cmd, flattening batches, and returns the NavEventMsgs it
+// produced.
+func navEvents(cmd tea.Cmd) []NavEventMsg {
+	if cmd == nil {
+		return nil
+	}
+	var out []NavEventMsg
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			out = append(out, navEvents(c)...)
+		}
+	case NavEventMsg:
+		out = append(out, msg)
+	}
+	return out
+}
+
+func TestStack_Events(t *testing.T) {
+	a := &fakeScreen{name: "a"}
+	b := &fakeScreen{name: "b"}
+	c := &fakeScreen{name: "c"}
+	s := NewStack(a)
+	s.EnableHistory(2)
+
+	assert.Equal(t, []NavEventMsg{PushedMsg{From: a, To: b}}, navEvents(s.Push(b)))
+	assert.Equal(t, []NavEventMsg{ReplacedMsg{From: b, To: c}}, navEvents(s.Replace(c)))
+	assert.Equal(t, []NavEventMsg{PoppedMsg{From: c, To: a}}, navEvents(s.Pop()))
+	assert.Equal(t, []NavEventMsg{PushedMsg{From: a, To: c}}, navEvents(s.Forward()))
+}
+
+func TestStack_PopTo_EmitsSinglePoppedMsg(t *testing.T) {
+	var log []string
+	home := newID("home", &log)
+	s := NewStack(home)
+	s.Push(newID("b", &log))
+	top := newID("c", &log)
+	s.Push(top)
+
+	events := navEvents(s.PopTo("home"))
+
+	require.Len(t, events, 1)
+	from, to := events[0].Screens()
+	assert.Equal(t, top, from)
+	assert.Equal(t, home, to)
+}
+
+func TestStack_NoOps_EmitNothing(t *testing.T) {
+	s := NewStack(&fakeScreen{name: "a"})
+
+	assert.Empty(t, navEvents(s.Pop()))
+	assert.Empty(t, navEvents(s.PopTo("missing")))
+	assert.Empty(t, navEvents(s.Forward()))
+}
@@ -0,0 +1,69 @@
+package nav
+
+import tea "charm.land/bubbletea/v2"
+
+// ForwardMsg asks the root model to re-enter the most recently popped screen.
+type ForwardMsg struct{}
+
+// Forward returns a command that navigates forward through history.
+func Forward() tea.Cmd {
+	return func() tea.Msg { return ForwardMsg{} }
+}
+
+// EnableHistory turns on history mode: screens removed by Pop or PopTo are
+// kept, up to capacity, in a forward list instead of being discarded, so
+// Forward can re-enter them like a browser. Pushing or replacing a screen
+// starts a new branch and clears the list. A capacity ≤ 0 disables history.
+func (s *Stack) EnableHistory(capacity int) {
+	s.historyCap = max(capacity, 0)
+	s.trimForward()
+}
+
+// CanForward reports whether Forward has a screen to re-enter.
+func (s *Stack) CanForward() bool {
+	return len(s.forward) > 0
+}
+
+// Forward re-pushes the most recently popped screen with the usual
+// lifecycle hooks, cached theme, and size. It is a no-op returning nil when
+// the forward list is empty.
+func (s *Stack) Forward() tea.Cmd {
+	if len(s.forward) == 0 {
+		return nil
+	}
+	last := len(s.forward) - 1
+	screen := s.forward[last]
+	s.forward[last] = nil
+	s.forward = s.forward[:last]
+	screen, sizeCmd := s.prepare(screen)
+	from := s.Top()
+	return tea.Batch(sizeCmd, transition(from, screen, func() {
+		s.screens = append(s.screens, screen)
+	}), emit(PushedMsg{From: from, To: screen}))
+}
+
+// remember records screens removed from the top of the stack, given
+// bottom-first, so that Forward re-enters the nearest one first.
+func (s *Stack) remember(popped []Screen) {
+	if s.historyCap == 0 {
+		return
+	}
+	for i := len(popped) - 1; i >= 0; i-- {
+		s.forward = append(s.forward, popped[i])
+	}
+	s.trimForward()
+}
+
+// trimForward drops the oldest (deepest) forward entries beyond capacity.
+func (s *Stack) trimForward() {
+	if over := len(s.forward) - s.historyCap; over > 0 {
+		clear(s.forward[:over])
+		s.forward = append(s.forward[:0], s.forward[over:]...)
+	}
+}
+
+// clearForward discards the forward list when navigation starts a new branch.
+func (s *Stack) clearForward() {
+	clear(s.forward)
+	s.forward = s.forward[:0]
+}
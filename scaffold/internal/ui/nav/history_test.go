@@ -0,0 +1,79 @@
+package nav
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStack_History_DisabledByDefault(t *testing.T) {
+	s := NewStack(&fakeScreen{name: "a"})
+	s.Push(&fakeScreen{name: "b"})
+	s.Pop()
+
+	assert.False(t, s.CanForward())
+	assert.Nil(t, s.Forward())
+}
+
+func TestStack_Forward_ReentersPoppedScreen(t *testing.T) {
+	var log []string
+	s := NewStack(newLifecycle("a", &log))
+	s.EnableHistory(5)
+	b := newLifecycle("b", &log)
+	s.Push(b)
+	s.Pop()
+	log = nil
+
+	s.Forward()
+
+	assert.Equal(t, b, s.Top(), "the same instance keeps its state")
+	assert.False(t, s.CanForward())
+	assert.Equal(t, []string{
+		"a.WillDisappear", "b.WillAppear", "a.Disappeared", "b.Appeared",
+	}, log)
+}
+
+func TestStack_Forward_AfterPopToKeepsOrder(t *testing.T) {
+	var log []string
+	s := NewStack(newID("a", &log))
+	s.EnableHistory(5)
+	s.Push(newID("b", &log))
+	s.Push(newID("c", &log))
+	s.Push(newID("d", &log))
+
+	s.PopTo("a")
+	s.Forward()
+	s.Forward()
+	s.Forward()
+
+	assert.Equal(t, 4, s.Len())
+	assert.Equal(t, "d", s.Top().Body())
+}
+
+func TestStack_Push_ClearsForward(t *testing.T) {
+	s := NewStack(&fakeScreen{name: "a"})
+	s.EnableHistory(5)
+	s.Push(&fakeScreen{name: "b"})
+	s.Pop()
+
+	s.Push(&fakeScreen{name: "c"})
+
+	assert.False(t, s.CanForward(), "a new push starts a new branch")
+}
+
+func TestStack_History_CapacityDropsFurthest(t *testing.T) {
+	s := NewStack(&fakeScreen{name: "root"})
+	s.EnableHistory(2)
+	for _, name := range []string{"a", "b", "c"} {
+		s.Push(&fakeScreen{name: name})
+	}
+	s.Pop()
+	s.Pop()
+	s.Pop()
+
+	s.Forward()
+	s.Forward()
+
+	assert.Equal(t, "b", s.Top().Body())
+	assert.False(t, s.CanForward(), "c was beyond capacity")
+}
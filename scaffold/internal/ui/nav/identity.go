@@ -0,0 +1,64 @@
+package nav
+
+import tea "charm.land/bubbletea/v2"
+
+// Identifiable is an optional interface for screens with a stable identity.
+// Screens of the same kind that should not be stacked twice return the same
+// ID.
+type Identifiable interface {
+	ScreenID() string
+}
+
+// PopToMsg asks the root model to pop back to the screen with ID.
+type PopToMsg struct {
+	ID string
+}
+
+// PopToScreen returns a command that pops back to the screen with id.
+func PopToScreen(id string) tea.Cmd {
+	return func() tea.Msg { return PopToMsg{ID: id} }
+}
+
+// screenID returns the ID of screen, or "" when it is not Identifiable.
+func screenID(screen Screen) string {
+	if id, ok := screen.(Identifiable); ok {
+		return id.ScreenID()
+	}
+	return ""
+}
+
+// index returns the position of the topmost screen with id, or -1.
+func (s *Stack) index(id string) int {
+	for i := len(s.screens) - 1; i >= 0; i-- {
+		if screenID(s.screens[i]) == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains reports whether a screen with id is anywhere in the stack.
+func (s *Stack) Contains(id string) bool {
+	return id != "" && s.index(id) >= 0
+}
+
+// PopTo pops every screen above the topmost screen with id, making it active
+// in a single transition: only the current top and the target receive
+// lifecycle hooks. It is a no-op returning nil when no such screen exists or
+// it is already on top.
+func (s *Stack) PopTo(id string) tea.Cmd {
+	if id == "" {
+		return nil
+	}
+	i := s.index(id)
+	if i < 0 || i == len(s.screens)-1 {
+		return nil
+	}
+	from := s.screens[len(s.screens)-1]
+	to := s.screens[i]
+	return tea.Batch(transition(from, to, func() {
+		s.remember(s.screens[i+1:])
+		clear(s.screens[i+1:])
+		s.screens = s.screens[:i+1]
+	}), emit(PoppedMsg{From: from, To: to}))
+}
@@ -0,0 +1,63 @@
+package nav
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// idScreen is an Identifiable lifecycleScreen.
+type idScreen struct{ lifecycleScreen }
+
+func (s *idScreen) ScreenID() string { return s.name }
+
+func newID(name string, log *[]string) *idScreen {
+	return &idScreen{lifecycleScreen{fakeScreen{name: name, log: log}}}
+}
+
+func TestStack_Contains(t *testing.T) {
+	var log []string
+	s := NewStack(newID("home", &log))
+	s.Push(&fakeScreen{name: "anon"})
+	s.Push(newID("settings", &log))
+
+	assert.True(t, s.Contains("home"))
+	assert.True(t, s.Contains("settings"))
+	assert.False(t, s.Contains("anon"), "non-Identifiable screens have no ID")
+	assert.False(t, s.Contains(""))
+}
+
+func TestStack_PopTo_SingleTransition(t *testing.T) {
+	var log []string
+	home := newID("home", &log)
+	s := NewStack(home)
+	s.Push(newID("dashboard", &log))
+	s.Push(newID("detail", &log))
+	s.Push(newID("settings", &log))
+	log = nil
+
+	s.PopTo("dashboard")
+
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, "dashboard", s.Top().Body())
+	assert.Equal(t, []string{
+		"settings.WillDisappear", "dashboard.WillAppear",
+		"settings.Disappeared", "dashboard.Appeared",
+	}, log, "intermediate screens are discarded without hooks")
+}
+
+func TestStack_PopTo_UnknownOrTop_IsNoOp(t *testing.T) {
+	var log []string
+	s := NewStack(newID("home", &log))
+	s.Push(newID("settings", &log))
+	log = nil
+
+	assert.Nil(t, s.PopTo("missing"))
+	assert.Nil(t, s.PopTo("settings"))
+	assert.Equal(t, 2, s.Len())
+	assert.Empty(t, log)
+}
+
+func TestPopToScreen_Cmd(t *testing.T) {
+	assert.Equal(t, PopToMsg{ID: "home"}, PopToScreen("home")())
+}
@@ -0,0 +1,37 @@
+package nav
+
+import tea "charm.land/bubbletea/v2"
+
+// Interceptor inspects a message before the stack delivers it to a screen. It
+// returns the message to deliver, which may be transformed, and false to
+// swallow it.
+type Interceptor func(tea.Msg) (tea.Msg, bool)
+
+// Intercept registers fn to run on every message passed to Update, after any
+// previously registered interceptors. Interceptors apply to whichever screen
+// is active, so cross-cutting concerns such as analytics, global shortcuts,
+// or tracing need not be added to each screen.
+func (s *Stack) Intercept(fn Interceptor) {
+	s.interceptors = append(s.interceptors, fn)
+}
+
+// intercept runs msg through the registered interceptors in order. It reports
+// false as soon as one of them swallows the message.
+func (s *Stack) intercept(msg tea.Msg) (tea.Msg, bool) {
+	for _, fn := range s.interceptors {
+		var ok bool
+		if msg, ok = fn(msg); !ok {
+			return nil, false
+		}
+	}
+	return msg, true
+}
+
+// LogMessages returns an Interceptor that passes every message through
+// unchanged after reporting its type to logf.
+func LogMessages(logf func(format string, v ...any)) Interceptor {
+	return func(msg tea.Msg) (tea.Msg, bool) {
+		logf("nav: %T", msg)
+		return msg, true
+	}
+}
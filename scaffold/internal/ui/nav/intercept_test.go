@@ -0,0 +1,84 @@
+package nav
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingScreen remembers the last message it received.
+type recordingScreen struct {
+	fakeScreen
+	got []tea.Msg
+}
+
+func (r *recordingScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	r.got = append(r.got, msg)
+	return r, nil
+}
+
+type pingMsg struct{ n int }
+
+func TestStack_Intercept_TransformsInOrder(t *testing.T) {
+	screen := &recordingScreen{fakeScreen: fakeScreen{name: "a"}}
+	s := NewStack(screen)
+	s.Intercept(func(msg tea.Msg) (tea.Msg, bool) {
+		if p, ok := msg.(pingMsg); ok {
+			return pingMsg{p.n + 1}, true
+		}
+		return msg, true
+	})
+	s.Intercept(func(msg tea.Msg) (tea.Msg, bool) {
+		if p, ok := msg.(pingMsg); ok {
+			return pingMsg{p.n * 10}, true
+		}
+		return msg, true
+	})
+
+	s.Update(pingMsg{1})
+
+	assert.Equal(t, []tea.Msg{pingMsg{20}}, screen.got)
+}
+
+func TestStack_Intercept_Swallows(t *testing.T) {
+	screen := &recordingScreen{fakeScreen: fakeScreen{name: "a"}}
+	s := NewStack(screen)
+	later := false
+	s.Intercept(func(msg tea.Msg) (tea.Msg, bool) {
+		_, isKey := msg.(tea.KeyPressMsg)
+		return msg, !isKey
+	})
+	s.Intercept(func(msg tea.Msg) (tea.Msg, bool) {
+		later = true
+		return msg, true
+	})
+
+	assert.Nil(t, s.Update(tea.KeyPressMsg{Code: 'q'}))
+	assert.Empty(t, screen.got)
+	assert.False(t, later, "interceptors after a swallow must not run")
+}
+
+func TestStack_Intercept_AppliesToPresented(t *testing.T) {
+	popup := &recordingScreen{fakeScreen: fakeScreen{name: "popup"}}
+	s := NewStack(&fakeScreen{name: "a"})
+	s.Present(popup)
+	s.Intercept(func(msg tea.Msg) (tea.Msg, bool) { return pingMsg{}, true })
+
+	s.Update(tea.KeyPressMsg{Code: 'x'})
+
+	assert.Equal(t, []tea.Msg{pingMsg{}}, popup.got)
+}
+
+func TestLogMessages_PassesThrough(t *testing.T) {
+	var lines []string
+	fn := LogMessages(func(format string, v ...any) {
+		lines = append(lines, format)
+	})
+
+	msg, ok := fn(pingMsg{3})
+
+	assert.True(t, ok)
+	assert.Equal(t, pingMsg{3}, msg)
+	assert.Len(t, lines, 1)
+}
@@ -0,0 +1,35 @@
+// Package nav provides the screen navigation stack used by the root model.
+// Screens are pushed and popped as a LIFO stack; the top screen is active and
+// receives input. A screen may also be presented as an overlay above the stack
+// with [Stack.Present]. Screens may opt into lifecycle hooks by implementing
+// [LifecycleScreen]. Models that predate Screen can be adapted with
+// [FromModel] and [FromStringModel].
+package nav
+
+import tea "charm.land/bubbletea/v2"
+
+// Screen is a navigable screen. Body returns the content rendered between the
+// header and the help bar.
+type Screen interface {
+	tea.Model
+	Body() string
+}
+
+// LifecycleScreen is an optional interface for screens that need to react to
+// navigation. For every transition the Stack invokes the hooks in this order:
+//
+//  1. WillDisappear on the outgoing screen
+//  2. WillAppear on the incoming screen
+//  3. the stack swap
+//  4. Disappeared on the outgoing screen
+//  5. Appeared on the incoming screen
+//
+// WillAppear/WillDisappear run before the incoming screen becomes active, so
+// they are the place to flush state or start loading. Returned commands are
+// batched and may be nil.
+type LifecycleScreen interface {
+	WillAppear() tea.Cmd
+	Appeared() tea.Cmd
+	WillDisappear() tea.Cmd
+	Disappeared() tea.Cmd
+}
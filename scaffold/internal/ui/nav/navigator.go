@@ -0,0 +1,44 @@
+package nav
+
+import tea "charm.land/bubbletea/v2"
+
+// Sender delivers a message to a running program. *tea.Program satisfies it.
+type Sender interface {
+	Send(msg tea.Msg)
+}
+
+// Navigator lets code outside the Update loop, such as background goroutines
+// and file watchers, request navigation. Each method only sends the matching
+// request message through the program, so the stack itself is still changed
+// exclusively by the root model and no locking is needed. A Navigator is safe
+// for concurrent use whenever its Sender is, which *tea.Program is.
+type Navigator struct {
+	sender Sender
+}
+
+// NewNavigator returns a Navigator that sends requests through sender.
+func NewNavigator(sender Sender) Navigator {
+	return Navigator{sender: sender}
+}
+
+// Push requests that screen be pushed.
+func (n Navigator) Push(screen Screen) { n.sender.Send(PushMsg{Screen: screen}) }
+
+// PushLazy requests a push of the screen built by factory. The factory runs
+// on the UI goroutine, not the caller's.
+func (n Navigator) PushLazy(factory func() Screen) { n.sender.Send(PushMsg{New: factory}) }
+
+// Pop requests that the top screen be popped.
+func (n Navigator) Pop() { n.sender.Send(PopMsg{}) }
+
+// Replace requests that the top screen be replaced with screen.
+func (n Navigator) Replace(screen Screen) { n.sender.Send(ReplaceMsg{Screen: screen}) }
+
+// PopTo requests a pop back to the screen identified by id.
+func (n Navigator) PopTo(id string) { n.sender.Send(PopToMsg{ID: id}) }
+
+// Present requests that screen be presented above the stack.
+func (n Navigator) Present(screen Screen) { n.sender.Send(PresentMsg{Screen: screen}) }
+
+// Dismiss requests that the presented screen be dismissed.
+func (n Navigator) Dismiss() { n.sender.Send(DismissMsg{}) }
@@ -0,0 +1,59 @@
+package nav
+
+import (
+	"sync"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// chanSender stands in for *tea.Program, queueing messages the way
+// Program.Send does.
+type chanSender chan tea.Msg
+
+func (c chanSender) Send(msg tea.Msg) { c <- msg }
+
+func TestNavigator_SendsRequests(t *testing.T) {
+	ch := make(chanSender, 8)
+	n := NewNavigator(ch)
+	a := &fakeScreen{name: "a"}
+
+	n.Push(a)
+	n.Pop()
+	n.Replace(a)
+	n.PopTo("home")
+	n.Present(a)
+	n.Dismiss()
+
+	assert.Equal(t, PushMsg{Screen: a}, <-ch)
+	assert.Equal(t, PopMsg{}, <-ch)
+	assert.Equal(t, ReplaceMsg{Screen: a}, <-ch)
+	assert.Equal(t, PopToMsg{ID: "home"}, <-ch)
+	assert.Equal(t, PresentMsg{Screen: a}, <-ch)
+	assert.Equal(t, DismissMsg{}, <-ch)
+}
+
+func TestNavigator_ConcurrentUse(t *testing.T) {
+	const goroutines = 8
+	ch := make(chanSender, goroutines)
+	n := NewNavigator(ch)
+
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n.PushLazy(func() Screen { return &fakeScreen{} })
+		}()
+	}
+	wg.Wait()
+	close(ch)
+
+	count := 0
+	for msg := range ch {
+		assert.IsType(t, PushMsg{}, msg)
+		count++
+	}
+	assert.Equal(t, goroutines, count)
+}
@@ -0,0 +1,92 @@
+package nav
+
+import (
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// PresentMsg asks the root model to present Screen above the current stack.
+type PresentMsg struct {
+	Screen Screen
+}
+
+// DismissMsg asks the root model to dismiss the presented screen.
+type DismissMsg struct{}
+
+// Present returns a command that presents screen as an overlay.
+func Present(screen Screen) tea.Cmd {
+	return func() tea.Msg { return PresentMsg{Screen: screen} }
+}
+
+// Dismiss returns a command that dismisses the presented screen.
+func Dismiss() tea.Cmd {
+	return func() tea.Msg { return DismissMsg{} }
+}
+
+// Present shows screen as an overlay above the stack. The screen beneath stays
+// on the stack, keeps rendering, and keeps receiving non-input messages, so
+// its hooks are not run; only screen receives WillAppear/Appeared. Presenting
+// while another screen is presented replaces it.
+func (s *Stack) Present(screen Screen) tea.Cmd {
+	screen, sizeCmd := s.prepare(screen)
+	from := s.presented
+	return tea.Batch(sizeCmd, transition(from, screen, func() {
+		s.presented = screen
+	}))
+}
+
+// Dismiss removes the presented screen, running its disappear hooks. It is a
+// no-op returning nil when nothing is presented.
+func (s *Stack) Dismiss() tea.Cmd {
+	if s.presented == nil {
+		return nil
+	}
+	from := s.presented
+	return transition(from, nil, func() {
+		s.presented = nil
+	})
+}
+
+// Presented returns the presented screen, or nil when there is none.
+func (s *Stack) Presented() Screen {
+	return s.presented
+}
+
+// SetPresented replaces the presented screen in place without running
+// lifecycle hooks. It is a no-op when nothing is presented.
+func (s *Stack) SetPresented(screen Screen) {
+	if s.presented != nil {
+		s.presented = screen
+	}
+}
+
+// Active returns the screen that receives input: the presented screen when
+// there is one, otherwise Top.
+func (s *Stack) Active() Screen {
+	if s.presented != nil {
+		return s.presented
+	}
+	return s.Top()
+}
+
+// isInput reports whether msg is user input that a presented screen captures
+// exclusively.
+func isInput(msg tea.Msg) bool {
+	switch msg.(type) {
+	case tea.KeyMsg, tea.MouseMsg, tea.PasteMsg, tea.PasteStartMsg, tea.PasteEndMsg:
+		return true
+	}
+	return false
+}
+
+// Overlay composites popup centred over base within a w×h area using lipgloss
+// layers. Unlike a whitespace backdrop, the base content remains visible
+// around the popup.
+func Overlay(base, popup string, w, h int) string {
+	x := max((w-lipgloss.Width(popup))/2, 0)
+	y := max((h-lipgloss.Height(popup))/2, 0)
+	return lipgloss.NewCompositor(
+		lipgloss.NewLayer(lipgloss.Place(w, h, lipgloss.Left, lipgloss.Top, base)),
+		lipgloss.NewLayer(popup).X(x).Y(y).Z(1),
+	).Render()
+}
@@ -0,0 +1,71 @@
+package nav
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingScreen counts the messages it receives.
+type countingScreen struct {
+	fakeScreen
+	keys, other int
+}
+
+func (c *countingScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(tea.KeyPressMsg); ok {
+		c.keys++
+	} else {
+		c.other++
+	}
+	return c, nil
+}
+
+func TestStack_Present_RoutesInputExclusively(t *testing.T) {
+	base := &countingScreen{fakeScreen: fakeScreen{name: "base"}}
+	popup := &countingScreen{fakeScreen: fakeScreen{name: "popup"}}
+	s := NewStack(base)
+
+	s.Present(popup)
+	s.Update(tea.KeyPressMsg{Code: 'x'})
+	s.Update(tea.WindowSizeMsg{Width: 10, Height: 5})
+
+	assert.Equal(t, 1, popup.keys)
+	assert.Equal(t, 0, base.keys, "keys must not reach the screen underneath")
+	assert.Equal(t, 1, base.other, "non-input messages keep the base screen alive")
+	assert.Equal(t, popup, s.Active())
+	assert.Equal(t, base, s.Top(), "presenting must not change the stack")
+	assert.Equal(t, 1, s.Len())
+}
+
+func TestStack_PresentDismiss_Lifecycle(t *testing.T) {
+	var log []string
+	s := NewStack(newLifecycle("base", &log))
+
+	s.Present(newLifecycle("popup", &log))
+	s.Dismiss()
+
+	assert.Equal(t, []string{
+		"popup.WillAppear", "popup.Appeared",
+		"popup.WillDisappear", "popup.Disappeared",
+	}, log, "the screen underneath stays visible and gets no hooks")
+	assert.Nil(t, s.Presented())
+}
+
+func TestStack_Dismiss_NothingPresented_IsNoOp(t *testing.T) {
+	s := NewStack(&fakeScreen{name: "a"})
+	assert.Nil(t, s.Dismiss())
+	assert.Equal(t, "a", s.Active().Body())
+}
+
+func TestOverlay_KeepsBaseVisible(t *testing.T) {
+	base := strings.Repeat("#########\n", 4) + "#########"
+	out := Overlay(base, "ok", 9, 5)
+
+	lines := strings.Split(out, "\n")
+	assert.Len(t, lines, 5)
+	assert.Equal(t, "#########", lines[0], "base shows around the popup")
+	assert.Equal(t, "###ok####", lines[2], "popup is centred over the base")
+}
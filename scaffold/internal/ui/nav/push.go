@@ -0,0 +1,60 @@
+package nav
+
+import tea "charm.land/bubbletea/v2"
+
+// PushMsg asks the root model to push a screen. Exactly one of Screen or New
+// is set; use Push or PushLazy rather than building it directly.
+type PushMsg struct {
+	Screen Screen
+	New    func() Screen
+}
+
+// Build returns the screen to push, calling New if the push was lazy. It
+// returns nil when the factory declines to build a screen.
+func (m PushMsg) Build() Screen {
+	if m.New != nil {
+		return m.New()
+	}
+	return m.Screen
+}
+
+// Push returns a command that pushes screen onto the stack.
+func Push(screen Screen) tea.Cmd {
+	return func() tea.Msg { return PushMsg{Screen: screen} }
+}
+
+// PushLazy returns a command that pushes the screen built by factory. The
+// factory runs only when the stack performs the push, so heavy constructors
+// (loading files, spawning processes) are skipped when the command is never
+// delivered. A factory returning nil cancels the push.
+func PushLazy(factory func() Screen) tea.Cmd {
+	return func() tea.Msg { return PushMsg{New: factory} }
+}
+
+// PushLazy calls factory and pushes its result. When factory returns nil the
+// stack is unchanged and no lifecycle hooks run.
+func (s *Stack) PushLazy(factory func() Screen) tea.Cmd {
+	screen := factory()
+	if screen == nil {
+		return nil
+	}
+	return s.Push(screen)
+}
+
+// PopMsg asks the root model to pop the top screen.
+type PopMsg struct{}
+
+// Pop returns a command that pops the top screen.
+func Pop() tea.Cmd {
+	return func() tea.Msg { return PopMsg{} }
+}
+
+// ReplaceMsg asks the root model to replace the top screen with Screen.
+type ReplaceMsg struct {
+	Screen Screen
+}
+
+// Replace returns a command that replaces the top screen with screen.
+func Replace(screen Screen) tea.Cmd {
+	return func() tea.Msg { return ReplaceMsg{Screen: screen} }
+}
@@ -0,0 +1,41 @@
+package nav
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushLazy_DefersConstruction(t *testing.T) {
+	built := 0
+	cmd := PushLazy(func() Screen {
+		built++
+		return &fakeScreen{name: "heavy"}
+	})
+	assert.Zero(t, built, "creating the command must not build the screen")
+
+	msg, ok := cmd().(PushMsg)
+	require.True(t, ok)
+	assert.Zero(t, built, "delivering the message must not build the screen")
+
+	s := NewStack(&fakeScreen{name: "root"})
+	s.Push(msg.Build())
+	assert.Equal(t, 1, built)
+	assert.Equal(t, "heavy", s.Top().Body())
+}
+
+func TestPush_Eager(t *testing.T) {
+	screen := &fakeScreen{name: "a"}
+	msg := Push(screen)().(PushMsg)
+	assert.Equal(t, screen, msg.Build())
+}
+
+func TestStack_PushLazy_NilFactoryResultIsNoOp(t *testing.T) {
+	var log []string
+	s := NewStack(newLifecycle("root", &log))
+
+	assert.Nil(t, s.PushLazy(func() Screen { return nil }))
+	assert.Equal(t, 1, s.Len())
+	assert.Empty(t, log, "a cancelled push must not run hooks")
+}
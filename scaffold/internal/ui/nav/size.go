@@ -0,0 +1,62 @@
+package nav
+
+import tea "charm.land/bubbletea/v2"
+
+// Size returns the last WindowSizeMsg seen by the stack and whether one has
+// been seen at all.
+func (s *Stack) Size() (tea.WindowSizeMsg, bool) {
+	return s.size, s.sized
+}
+
+// Resize caches msg and delivers it to every screen in the stack, covered
+// ones included, plus the presented screen. Update calls Resize for any
+// WindowSizeMsg, so covered screens never render at stale dimensions when
+// they are re-exposed.
+func (s *Stack) Resize(msg tea.WindowSizeMsg) tea.Cmd {
+	s.size, s.sized = msg, true
+	var cmds []tea.Cmd
+	for i, screen := range s.screens {
+		s.screens[i], cmds = sendSize(screen, msg, cmds)
+	}
+	if s.presented != nil {
+		s.presented, cmds = sendSize(s.presented, msg, cmds)
+	}
+	return tea.Batch(cmds...)
+}
+
+// prepare brings a screen about to enter the stack up to date: it applies
+// the cached theme and delivers the cached size, when the stack has them.
+// It returns the possibly updated screen and the screen's command.
+func (s *Stack) prepare(screen Screen) (Screen, tea.Cmd) {
+	if screen == nil {
+		return nil, nil
+	}
+	if s.themed {
+		applyTheme(screen, s.theme)
+	}
+	if !s.sized {
+		return screen, nil
+	}
+	screen, cmds := sendSize(screen, s.size, nil)
+	return screen, tea.Batch(cmds...)
+}
+
+func sendSize(screen Screen, msg tea.WindowSizeMsg, cmds []tea.Cmd) (Screen, []tea.Cmd) {
+	updated, cmd := screen.Update(msg)
+	if u, ok := updated.(Screen); ok {
+		screen = u
+	}
+	return screen, append(cmds, cmd)
+}
+
+// Each replaces every screen in the stack, root first, followed by the
+// presented screen, with fn's result. It runs no lifecycle hooks; use it to
+// apply setters such as width or theme to covered screens.
+func (s *Stack) Each(fn func(Screen) Screen) {
+	for i, screen := range s.screens {
+		s.screens[i] = fn(screen)
+	}
+	if s.presented != nil {
+		s.presented = fn(s.presented)
+	}
+}
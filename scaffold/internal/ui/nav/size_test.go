@@ -0,0 +1,66 @@
+package nav
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStack_Resize_ReachesCoveredScreens(t *testing.T) {
+	covered := &recordingScreen{fakeScreen: fakeScreen{name: "covered"}}
+	top := &recordingScreen{fakeScreen: fakeScreen{name: "top"}}
+	s := NewStack(covered)
+	s.Push(top)
+
+	size := tea.WindowSizeMsg{Width: 80, Height: 24}
+	s.Update(size)
+
+	assert.Equal(t, []tea.Msg{size}, covered.got)
+	assert.Equal(t, []tea.Msg{size}, top.got)
+	got, ok := s.Size()
+	assert.True(t, ok)
+	assert.Equal(t, size, got)
+}
+
+func TestStack_Push_DeliversCachedSize(t *testing.T) {
+	s := NewStack(&fakeScreen{name: "root"})
+	size := tea.WindowSizeMsg{Width: 100, Height: 30}
+	s.Resize(size)
+
+	pushed := &recordingScreen{fakeScreen: fakeScreen{name: "pushed"}}
+	replaced := &recordingScreen{fakeScreen: fakeScreen{name: "replaced"}}
+	presented := &recordingScreen{fakeScreen: fakeScreen{name: "presented"}}
+	s.Push(pushed)
+	s.Replace(replaced)
+	s.Present(presented)
+
+	assert.Equal(t, []tea.Msg{size}, pushed.got)
+	assert.Equal(t, []tea.Msg{size}, replaced.got)
+	assert.Equal(t, []tea.Msg{size}, presented.got)
+}
+
+func TestStack_Push_NoSizeYet(t *testing.T) {
+	s := NewStack(&fakeScreen{name: "root"})
+	pushed := &recordingScreen{fakeScreen: fakeScreen{name: "pushed"}}
+
+	s.Push(pushed)
+
+	assert.Empty(t, pushed.got, "nothing is delivered before the first resize")
+	_, ok := s.Size()
+	assert.False(t, ok)
+}
+
+func TestStack_Each_VisitsAllScreens(t *testing.T) {
+	s := NewStack(&fakeScreen{name: "a"})
+	s.Push(&fakeScreen{name: "b"})
+	s.Present(&fakeScreen{name: "c"})
+
+	var seen []string
+	s.Each(func(sc Screen) Screen {
+		seen = append(seen, sc.Body())
+		return sc
+	})
+
+	assert.Equal(t, []string{"a", "b", "c"}, seen)
+}
@@ -0,0 +1,167 @@
+package nav
+
+import (
+	tea "charm.land/bubbletea/v2"
+
+	"scaffold/internal/ui/theme"
+)
+
+// Stack holds the navigation history. The zero value is an empty stack; use
+// NewStack to seed it with a root screen.
+type Stack struct {
+	screens      []Screen
+	presented    Screen // overlay above the stack; see Present
+	interceptors []Interceptor
+	size         tea.WindowSizeMsg // last size seen; valid when sized
+	sized        bool
+	theme        theme.State // last theme applied; valid when themed
+	themed       bool
+	forward      []Screen // popped screens for Forward; nearest last
+	historyCap   int      // forward list capacity; 0 disables history
+	debug        bool     // debug overlay visible; see DebugView
+}
+
+// NewStack creates a stack with root as its only (active) screen.
+func NewStack(root Screen) Stack {
+	return Stack{screens: []Screen{root}}
+}
+
+// Len returns the stack depth, including the active screen.
+func (s *Stack) Len() int {
+	return len(s.screens)
+}
+
+// Top returns the active screen, or nil when the stack is empty.
+func (s *Stack) Top() Screen {
+	if len(s.screens) == 0 {
+		return nil
+	}
+	return s.screens[len(s.screens)-1]
+}
+
+// SetTop replaces the active screen in place without running lifecycle hooks.
+// Use it to store the model returned by the active screen's Update.
+func (s *Stack) SetTop(screen Screen) {
+	if len(s.screens) == 0 {
+		s.screens = append(s.screens, screen)
+		return
+	}
+	s.screens[len(s.screens)-1] = screen
+}
+
+// Push makes screen the active screen, keeping the previous one underneath.
+// If the stack has a theme or has seen a WindowSizeMsg, screen receives them
+// first. Push returns the batched size and lifecycle commands followed by a
+// PushedMsg; the caller remains responsible for calling Init on the new
+// screen.
+func (s *Stack) Push(screen Screen) tea.Cmd {
+	screen, sizeCmd := s.prepare(screen)
+	from := s.Top()
+	s.clearForward()
+	return tea.Batch(sizeCmd, transition(from, screen, func() {
+		s.screens = append(s.screens, screen)
+	}), emit(PushedMsg{From: from, To: screen}))
+}
+
+// Pop removes the active screen and re-activates the one beneath it. In
+// history mode the removed screen is kept for Forward. The root screen is
+// never popped; Pop on a stack of depth ≤ 1 is a no-op and
+// returns nil. Otherwise a PoppedMsg follows the lifecycle commands.
+func (s *Stack) Pop() tea.Cmd {
+	if len(s.screens) <= 1 {
+		return nil
+	}
+	from := s.screens[len(s.screens)-1]
+	to := s.screens[len(s.screens)-2]
+	return tea.Batch(transition(from, to, func() {
+		s.remember(s.screens[len(s.screens)-1:])
+		s.screens[len(s.screens)-1] = nil
+		s.screens = s.screens[:len(s.screens)-1]
+	}), emit(PoppedMsg{From: from, To: to}))
+}
+
+// Replace swaps the active screen for screen without growing the stack.
+// The replaced screen is discarded after its disappear hooks run, and a
+// ReplacedMsg is emitted. On an empty stack Replace behaves like Push.
+func (s *Stack) Replace(screen Screen) tea.Cmd {
+	if len(s.screens) == 0 {
+		return s.Push(screen)
+	}
+	screen, sizeCmd := s.prepare(screen)
+	from := s.screens[len(s.screens)-1]
+	s.clearForward()
+	return tea.Batch(sizeCmd, transition(from, screen, func() {
+		s.screens[len(s.screens)-1] = screen
+	}), emit(ReplacedMsg{From: from, To: screen}))
+}
+
+// Update forwards msg to the active screen and stores the returned model.
+// Registered interceptors run first and may transform or swallow msg. A
+// WindowSizeMsg is handed to Resize and so reaches every screen; a
+// theme.ThemeChangedMsg is applied to every screen via SetTheme before being
+// delivered as usual.
+// While a screen is presented it receives every message; input messages stop
+// there, while everything else also reaches Top so it keeps running.
+func (s *Stack) Update(msg tea.Msg) tea.Cmd {
+	msg, ok := s.intercept(msg)
+	if !ok {
+		return nil
+	}
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		return s.Resize(msg)
+	case theme.ThemeChangedMsg:
+		s.SetTheme(msg.State)
+	}
+	return s.deliver(msg)
+}
+
+// deliver routes an already-intercepted msg to the presented and top screens.
+func (s *Stack) deliver(msg tea.Msg) tea.Cmd {
+	if s.presented != nil {
+		updated, cmd := s.presented.Update(msg)
+		if screen, ok := updated.(Screen); ok {
+			s.presented = screen
+		}
+		if isInput(msg) {
+			return cmd
+		}
+		return tea.Batch(cmd, s.updateTop(msg))
+	}
+	return s.updateTop(msg)
+}
+
+func (s *Stack) updateTop(msg tea.Msg) tea.Cmd {
+	top := s.Top()
+	if top == nil {
+		return nil
+	}
+	updated, cmd := top.Update(msg)
+	if screen, ok := updated.(Screen); ok {
+		s.SetTop(screen)
+	}
+	return cmd
+}
+
+// transition runs swap wrapped in the LifecycleScreen hook order documented
+// on the interface. from or to may be nil.
+func transition(from, to Screen, swap func()) tea.Cmd {
+	fromLC, _ := from.(LifecycleScreen)
+	toLC, _ := to.(LifecycleScreen)
+
+	var cmds []tea.Cmd
+	if fromLC != nil {
+		cmds = append(cmds, fromLC.WillDisappear())
+	}
+	if toLC != nil {
+		cmds = append(cmds, toLC.WillAppear())
+	}
+	swap()
+	if fromLC != nil {
+		cmds = append(cmds, fromLC.Disappeared())
+	}
+	if toLC != nil {
+		cmds = append(cmds, toLC.Appeared())
+	}
+	return tea.Batch(cmds...)
+}
@@ -0,0 +1,267 @@
+package nav
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeScreen is a minimal Screen that optionally records lifecycle hooks.
+type fakeScreen struct {
+	name string
+	log  *[]string
+}
+
+func (f *fakeScreen) Init() tea.Cmd                       { return nil }
+func (f *fakeScreen) Update(tea.Msg) (tea.Model, tea.Cmd) { return f, nil }
+func (f *fakeScreen) View() tea.View                      { return tea.NewView(f.name) }
+func (f *fakeScreen) Body() string                        { return f.name }
+
+// lifecycleScreen records every hook invocation into a shared log.
+type lifecycleScreen struct{ fakeScreen }
+
+func (l *lifecycleScreen) record(event string) tea.Cmd {
+	*l.log = append(*l.log, l.name+"."+event)
+	return nil
+}
+
+func (l *lifecycleScreen) WillAppear() tea.Cmd    { return l.record("WillAppear") }
+func (l *lifecycleScreen) Appeared() tea.Cmd      { return l.record("Appeared") }
+func (l *lifecycleScreen) WillDisappear() tea.Cmd { return l.record("WillDisappear") }
+func (l *lifecycleScreen) Disappeared() tea.Cmd   { return l.record("Disappeared") }
+
+func newLifecycle(name string, log *[]string) *lifecycleScreen {
+	return &lifecycleScreen{fakeScreen{name: name, log: log}}
+}
+
+func TestStack_PushPop(t *testing.T) {
+	a := &fakeScreen{name: "a"}
+	b := &fakeScreen{name: "b"}
+	s := NewStack(a)
+
+	s.Push(b)
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, b, s.Top())
+
+	s.Pop()
+	assert.Equal(t, 1, s.Len())
+	assert.Equal(t, a, s.Top(), "Pop should return LIFO order")
+}
+
+func TestStack_PopRoot_IsNoOp(t *testing.T) {
+	a := &fakeScreen{name: "a"}
+	s := NewStack(a)
+
+	assert.Nil(t, s.Pop())
+	assert.Equal(t, 1, s.Len(), "root screen must never be popped")
+	assert.Equal(t, a, s.Top())
+}
+
+func TestStack_TopDoesNotRemove(t *testing.T) {
+	a := &fakeScreen{name: "a"}
+	s := NewStack(a)
+
+	assert.Equal(t, a, s.Top())
+	assert.Equal(t, 1, s.Len(), "Top should not remove the element")
+}
+
+func TestStack_EmptyTopIsNil(t *testing.T) {
+	var s Stack
+	assert.Nil(t, s.Top())
+	assert.Nil(t, s.Pop())
+}
+
+func TestStack_Push_LifecycleOrder(t *testing.T) {
+	var log []string
+	s := NewStack(newLifecycle("a", &log))
+
+	s.Push(newLifecycle("b", &log))
+
+	assert.Equal(t, []string{
+		"a.WillDisappear", "b.WillAppear", "a.Disappeared", "b.Appeared",
+	}, log)
+}
+
+func TestStack_Pop_LifecycleOrder(t *testing.T) {
+	var log []string
+	s := NewStack(newLifecycle("a", &log))
+	s.Push(newLifecycle("b", &log))
+	log = nil
+
+	s.Pop()
+
+	assert.Equal(t, []string{
+		"b.WillDisappear", "a.WillAppear", "b.Disappeared", "a.Appeared",
+	}, log)
+}
+
+func TestStack_WillAppear_RunsBeforeSwap(t *testing.T) {
+	var topAtWillAppear Screen
+	s := NewStack(&fakeScreen{name: "a"})
+	b := &swapProbe{fakeScreen: fakeScreen{name: "b"}, stack: &s, seen: &topAtWillAppear}
+
+	s.Push(b)
+
+	assert.Equal(t, "a", topAtWillAppear.Body(), "WillAppear must fire while the old screen is still active")
+}
+
+// swapProbe captures the active screen at the moment WillAppear is invoked.
+type swapProbe struct {
+	fakeScreen
+	stack *Stack
+	seen  *Screen
+}
+
+func (p *swapProbe) WillAppear() tea.Cmd    { *p.seen = p.stack.Top(); return nil }
+func (p *swapProbe) Appeared() tea.Cmd      { return nil }
+func (p *swapProbe) WillDisappear() tea.Cmd { return nil }
+func (p *swapProbe) Disappeared() tea.Cmd   { return nil }
+
+func TestStack_MixedScreens_OnlyLifecycleScreensNotified(t *testing.T) {
+	var log []string
+	s := NewStack(&fakeScreen{name: "plain"})
+
+	s.Push(newLifecycle("b", &log))
+
+	assert.Equal(t, []string{"b.WillAppear", "b.Appeared"}, log)
+}
+
+func TestStack_Replace_KeepsDepth(t *testing.T) {
+	var log []string
+	s := NewStack(newLifecycle("a", &log))
+	b := newLifecycle("b", &log)
+
+	s.Replace(b)
+
+	assert.Equal(t, 1, s.Len())
+	assert.Equal(t, b, s.Top())
+	assert.Equal(t, []string{
+		"a.WillDisappear", "b.WillAppear", "a.Disappeared", "b.Appeared",
+	}, log)
+}
+
+// fuzzScreen is a lifecycle screen that tracks its own visibility and fails
+// the fuzz run when hooks arrive out of order.
+type fuzzScreen struct {
+	t       *testing.T
+	id      int
+	visible bool
+	pending string // "appear" or "disappear" while a Will* hook is unmatched
+	width   int
+}
+
+func (f *fuzzScreen) Init() tea.Cmd { return nil }
+
+func (f *fuzzScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if ws, ok := msg.(tea.WindowSizeMsg); ok {
+		// Sizes reach covered and incoming screens too; see Stack.Resize.
+		f.width = ws.Width
+		return f, nil
+	}
+	if !f.visible {
+		f.t.Fatalf("screen %d received %T while not visible", f.id, msg)
+	}
+	return f, nil
+}
+
+func (f *fuzzScreen) View() tea.View { return tea.NewView("") }
+func (f *fuzzScreen) Body() string   { return "" }
+
+func (f *fuzzScreen) WillAppear() tea.Cmd {
+	if f.visible || f.pending != "" {
+		f.t.Fatalf("screen %d: WillAppear while visible=%v pending=%q", f.id, f.visible, f.pending)
+	}
+	f.pending = "appear"
+	return nil
+}
+
+func (f *fuzzScreen) Appeared() tea.Cmd {
+	if f.pending != "appear" {
+		f.t.Fatalf("screen %d: Appeared without WillAppear", f.id)
+	}
+	f.pending = ""
+	f.visible = true
+	return nil
+}
+
+func (f *fuzzScreen) WillDisappear() tea.Cmd {
+	if !f.visible || f.pending != "" {
+		f.t.Fatalf("screen %d: WillDisappear while visible=%v pending=%q", f.id, f.visible, f.pending)
+	}
+	f.pending = "disappear"
+	return nil
+}
+
+func (f *fuzzScreen) Disappeared() tea.Cmd {
+	if f.pending != "disappear" {
+		f.t.Fatalf("screen %d: Disappeared without WillDisappear", f.id)
+	}
+	f.pending = ""
+	f.visible = false
+	return nil
+}
+
+// FuzzStack replays randomized Push/Pop/Replace/WindowSize/Key/Forward
+// sequences in history mode and checks that the stack never empties,
+// lifecycle hooks pair up, exactly the top screen is visible, and every live
+// screen has the latest size after every step.
+func FuzzStack(f *testing.F) {
+	f.Add([]byte{0, 0, 1, 2, 3, 4, 1, 1, 1})
+	f.Add([]byte{1, 1, 2, 2, 0, 3, 0, 4, 1})
+	f.Add([]byte{2, 0, 0, 0, 1, 2, 1, 1, 1, 1})
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		var all []*fuzzScreen
+		newScreen := func() *fuzzScreen {
+			s := &fuzzScreen{t: t, id: len(all)}
+			all = append(all, s)
+			return s
+		}
+
+		root := newScreen()
+		root.visible = true // the root is active from construction
+		s := NewStack(root)
+		s.EnableHistory(3)
+		width := 0
+
+		for i, op := range ops {
+			switch op % 6 {
+			case 0:
+				s.Push(newScreen())
+			case 1:
+				s.Pop()
+			case 2:
+				s.Replace(newScreen())
+			case 3:
+				width = int(op)
+				s.Update(tea.WindowSizeMsg{Width: width, Height: i})
+			case 4:
+				s.Update(tea.KeyPressMsg{Code: rune(op)})
+			case 5:
+				s.Forward()
+			}
+
+			if s.Len() < 1 {
+				t.Fatalf("step %d: stack depth %d < 1", i, s.Len())
+			}
+			top, ok := s.Top().(*fuzzScreen)
+			if !ok {
+				t.Fatalf("step %d: unexpected top %T", i, s.Top())
+			}
+			for _, sc := range all {
+				if sc.pending != "" {
+					t.Fatalf("step %d: screen %d left with unmatched %q hook", i, sc.id, sc.pending)
+				}
+				if sc.visible != (sc == top) {
+					t.Fatalf("step %d: screen %d visible=%v, top is %d", i, sc.id, sc.visible, top.id)
+				}
+			}
+			for _, sc := range s.screens {
+				if w := sc.(*fuzzScreen).width; w != width {
+					t.Fatalf("step %d: screen %d has width %d, want %d", i, sc.(*fuzzScreen).id, w, width)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package nav
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// ErrNoState is returned by RestoreState when not even the root screen could
+// be rebuilt from the saved state.
+var ErrNoState = errors.New("nav: no restorable state")
+
+// Serializable is an optional interface for screens that can be recreated
+// after a restart. Route identifies the kind of screen and Params carries
+// whatever its constructor needs.
+type Serializable interface {
+	Route() string
+	Params() map[string]string
+}
+
+// ScreenState is the persisted form of a single Serializable screen.
+type ScreenState struct {
+	Route  string            `json:"route"`
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// ParseRoute parses a route written as "name" or "name?key=value&...", the
+// form used to name a screen on the command line.
+func ParseRoute(s string) (ScreenState, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return ScreenState{}, fmt.Errorf("nav: parsing route %q: %w", s, err)
+	}
+	if u.Path == "" || u.Scheme != "" || u.Host != "" {
+		return ScreenState{}, fmt.Errorf("nav: invalid route %q", s)
+	}
+	st := ScreenState{Route: u.Path}
+	for k, v := range u.Query() {
+		if st.Params == nil {
+			st.Params = map[string]string{}
+		}
+		st.Params[k] = v[len(v)-1]
+	}
+	return st, nil
+}
+
+// State is the persisted form of a Stack, root first.
+type State struct {
+	Screens []ScreenState `json:"screens"`
+}
+
+// Builder recreates a screen from its saved state. It returns false for
+// routes it does not know.
+type Builder func(ScreenState) (Screen, bool)
+
+// SaveState captures the stack from the root up to (but excluding) the first
+// screen that does not implement Serializable or has an empty Route. The
+// presented screen, if any,
+// is transient and never saved.
+func (s *Stack) SaveState() State {
+	var st State
+	for _, screen := range s.screens {
+		ser, ok := screen.(Serializable)
+		if !ok || ser.Route() == "" {
+			break
+		}
+		st.Screens = append(st.Screens, ScreenState{Route: ser.Route(), Params: ser.Params()})
+	}
+	return st
+}
+
+// RestoreState replaces the stack with the screens rebuilt from st. Restoring
+// stops at the first route build does not recognise, so the result is always
+// a valid prefix of the saved history. Restored screens receive the cached
+// theme, but lifecycle hooks are not run: restoring is meant for startup,
+// before the first frame, and the caller remains responsible for sizing and
+// calling Init on the top screen.
+func (s *Stack) RestoreState(st State, build Builder) error {
+	var screens []Screen
+	for _, ss := range st.Screens {
+		screen, ok := build(ss)
+		if !ok {
+			break
+		}
+		if s.themed {
+			applyTheme(screen, s.theme)
+		}
+		screens = append(screens, screen)
+	}
+	if len(screens) == 0 {
+		return ErrNoState
+	}
+	s.screens = screens
+	s.presented = nil
+	s.clearForward()
+	return nil
+}
+
+// SaveStateFile writes st to path as JSON, atomically replacing any previous
+// file.
+func SaveStateFile(path string, st State) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("nav: creating state directory: %w", err)
+	}
+	out, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return fmt.Errorf("nav: encoding state: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, out, 0o644); err != nil {
+		return fmt.Errorf("nav: writing temp file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("nav: atomic rename: %w", err)
+	}
+	return nil
+}
+
+// LoadStateFile reads a State previously written by SaveStateFile.
+func LoadStateFile(path string) (State, error) {
+	var st State
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return st, fmt.Errorf("nav: reading state: %w", err)
+	}
+	if err := json.Unmarshal(raw, &st); err != nil {
+		return st, fmt.Errorf("nav: decoding state: %w", err)
+	}
+	return st, nil
+}
@@ -0,0 +1,105 @@
+package nav
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// routeScreen is a Serializable fakeScreen.
+type routeScreen struct {
+	fakeScreen
+	params map[string]string
+}
+
+func (r *routeScreen) Route() string             { return r.name }
+func (r *routeScreen) Params() map[string]string { return r.params }
+
+func buildRoute(st ScreenState) (Screen, bool) {
+	if st.Route == "unknown" {
+		return nil, false
+	}
+	return &routeScreen{fakeScreen: fakeScreen{name: st.Route}, params: st.Params}, true
+}
+
+func TestStack_SaveState_StopsAtNonSerializable(t *testing.T) {
+	s := NewStack(&routeScreen{fakeScreen: fakeScreen{name: "home"}})
+	s.Push(&routeScreen{fakeScreen: fakeScreen{name: "detail"}, params: map[string]string{"id": "7"}})
+	s.Push(&fakeScreen{name: "wizard"})
+	s.Push(&routeScreen{fakeScreen: fakeScreen{name: "settings"}})
+
+	st := s.SaveState()
+
+	assert.Equal(t, []ScreenState{
+		{Route: "home"},
+		{Route: "detail", Params: map[string]string{"id": "7"}},
+	}, st.Screens)
+}
+
+func TestStack_SaveState_StopsAtEmptyRoute(t *testing.T) {
+	s := NewStack(&routeScreen{fakeScreen: fakeScreen{name: "home"}})
+	s.Push(&routeScreen{fakeScreen: fakeScreen{name: ""}})
+	s.Push(&routeScreen{fakeScreen: fakeScreen{name: "settings"}})
+
+	assert.Equal(t, []ScreenState{{Route: "home"}}, s.SaveState().Screens)
+}
+
+func TestStack_RestoreState_RoundTrip(t *testing.T) {
+	src := NewStack(&routeScreen{fakeScreen: fakeScreen{name: "home"}})
+	src.Push(&routeScreen{fakeScreen: fakeScreen{name: "detail"}, params: map[string]string{"id": "7"}})
+
+	path := filepath.Join(t.TempDir(), "nav", "state.json")
+	require.NoError(t, SaveStateFile(path, src.SaveState()))
+	st, err := LoadStateFile(path)
+	require.NoError(t, err)
+
+	dst := NewStack(&fakeScreen{name: "default"})
+	require.NoError(t, dst.RestoreState(st, buildRoute))
+
+	assert.Equal(t, 2, dst.Len())
+	assert.Equal(t, "detail", dst.Top().Body())
+	assert.Equal(t, "7", dst.Top().(Serializable).Params()["id"])
+}
+
+func TestStack_RestoreState_UnknownRouteTruncates(t *testing.T) {
+	s := NewStack(&fakeScreen{name: "default"})
+	st := State{Screens: []ScreenState{{Route: "home"}, {Route: "unknown"}, {Route: "detail"}}}
+
+	require.NoError(t, s.RestoreState(st, buildRoute))
+
+	assert.Equal(t, 1, s.Len())
+	assert.Equal(t, "home", s.Top().Body())
+}
+
+func TestStack_RestoreState_NothingBuildable_KeepsStack(t *testing.T) {
+	s := NewStack(&fakeScreen{name: "default"})
+
+	err := s.RestoreState(State{Screens: []ScreenState{{Route: "unknown"}}}, buildRoute)
+
+	assert.ErrorIs(t, err, ErrNoState)
+	assert.Equal(t, "default", s.Top().Body())
+}
+
+func TestLoadStateFile_Missing(t *testing.T) {
+	_, err := LoadStateFile(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestParseRoute(t *testing.T) {
+	st, err := ParseRoute("settings")
+	require.NoError(t, err)
+	assert.Equal(t, ScreenState{Route: "settings"}, st)
+
+	st, err = ParseRoute("detail?id=about&title=About%20us")
+	require.NoError(t, err)
+	assert.Equal(t, ScreenState{Route: "detail", Params: map[string]string{"id": "about", "title": "About us"}}, st)
+}
+
+func TestParseRoute_Invalid(t *testing.T) {
+	for _, s := range []string{"", "?id=1", "http://example.com/detail", "%zz"} {
+		_, err := ParseRoute(s)
+		assert.Error(t, err, s)
+	}
+}
@@ -0,0 +1,170 @@
+package nav
+
+import (
+	"strconv"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+)
+
+// Tab is a top-level destination with its own navigation stack.
+type Tab struct {
+	Title string
+	Stack Stack
+}
+
+// NewTab creates a tab whose stack is seeded with root.
+func NewTab(title string, root Screen) Tab {
+	return Tab{Title: title, Stack: NewStack(root)}
+}
+
+// TabKeyMap defines the tab-switching key bindings.
+type TabKeyMap struct {
+	Next key.Binding
+	Prev key.Binding
+	// Jump selects a tab directly; Jump[i] activates tab i.
+	Jump []key.Binding
+}
+
+// DefaultTabKeyMap returns ctrl+←/→ for cycling and alt+1…alt+9 for jumping.
+func DefaultTabKeyMap() TabKeyMap {
+	km := TabKeyMap{
+		Next: key.NewBinding(
+			key.WithKeys("ctrl+right"),
+			key.WithHelp("ctrl+→", "next tab"),
+		),
+		Prev: key.NewBinding(
+			key.WithKeys("ctrl+left"),
+			key.WithHelp("ctrl+←", "prev tab"),
+		),
+	}
+	for i := 1; i <= 9; i++ {
+		n := strconv.Itoa(i)
+		km.Jump = append(km.Jump, key.NewBinding(
+			key.WithKeys("alt+"+n),
+			key.WithHelp("alt+"+n, "tab "+n),
+		))
+	}
+	return km
+}
+
+// TabController owns one Stack per tab and routes input to the active tab.
+// Inactive tabs keep their screens alive, so per-tab state such as form
+// contents, list selection, and viewport scroll offsets survives switching.
+//
+// Switching tabs runs the LifecycleScreen hooks on the outgoing tab's top
+// screen and the incoming tab's top screen, exactly like a Push.
+type TabController struct {
+	tabs   []Tab
+	active int
+	keys   TabKeyMap
+}
+
+// NewTabController creates a controller with the given tabs; the first tab
+// is active.
+func NewTabController(tabs ...Tab) TabController {
+	return TabController{tabs: tabs, keys: DefaultTabKeyMap()}
+}
+
+// WithKeyMap returns the controller with km as its tab-switching bindings.
+func (c TabController) WithKeyMap(km TabKeyMap) TabController {
+	c.keys = km
+	return c
+}
+
+// Len returns the number of tabs.
+func (c *TabController) Len() int {
+	return len(c.tabs)
+}
+
+// ActiveIndex returns the index of the active tab.
+func (c *TabController) ActiveIndex() int {
+	return c.active
+}
+
+// Titles returns the tab titles in order, for rendering a tab bar.
+func (c *TabController) Titles() []string {
+	titles := make([]string, len(c.tabs))
+	for i, t := range c.tabs {
+		titles[i] = t.Title
+	}
+	return titles
+}
+
+// Active returns the active tab's stack, or nil when there are no tabs.
+// Push/Pop/Replace on the returned stack affect only that tab.
+func (c *TabController) Active() *Stack {
+	if len(c.tabs) == 0 {
+		return nil
+	}
+	return &c.tabs[c.active].Stack
+}
+
+// Top returns the active tab's active screen, or nil when there are no tabs.
+func (c *TabController) Top() Screen {
+	if s := c.Active(); s != nil {
+		return s.Top()
+	}
+	return nil
+}
+
+// Select activates tab i. Out-of-range indices and re-selecting the active
+// tab are no-ops returning nil.
+func (c *TabController) Select(i int) tea.Cmd {
+	if i < 0 || i >= len(c.tabs) || i == c.active {
+		return nil
+	}
+	from := c.Top()
+	to := c.tabs[i].Stack.Top()
+	return transition(from, to, func() {
+		c.active = i
+	})
+}
+
+// Next activates the following tab, wrapping around.
+func (c *TabController) Next() tea.Cmd {
+	if len(c.tabs) == 0 {
+		return nil
+	}
+	return c.Select((c.active + 1) % len(c.tabs))
+}
+
+// Prev activates the preceding tab, wrapping around.
+func (c *TabController) Prev() tea.Cmd {
+	if len(c.tabs) == 0 {
+		return nil
+	}
+	return c.Select((c.active - 1 + len(c.tabs)) % len(c.tabs))
+}
+
+// Update handles the tab-switching keys and forwards everything else to the
+// active tab's stack.
+func (c *TabController) Update(msg tea.Msg) tea.Cmd {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		switch {
+		case key.Matches(keyMsg, c.keys.Next):
+			return c.Next()
+		case key.Matches(keyMsg, c.keys.Prev):
+			return c.Prev()
+		}
+		for i, b := range c.keys.Jump {
+			if i < len(c.tabs) && key.Matches(keyMsg, b) {
+				return c.Select(i)
+			}
+		}
+	}
+	if s := c.Active(); s != nil {
+		return s.Update(msg)
+	}
+	return nil
+}
+
+// ShortHelp implements help.KeyMap.
+func (c TabController) ShortHelp() []key.Binding {
+	return []key.Binding{c.keys.Prev, c.keys.Next}
+}
+
+// FullHelp implements help.KeyMap.
+func (c TabController) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{c.keys.Prev, c.keys.Next}}
+}
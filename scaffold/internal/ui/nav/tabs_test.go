@@ -0,0 +1,68 @@
+package nav
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTabs(log *[]string) TabController {
+	return NewTabController(
+		NewTab("Dashboard", newLifecycle("dashboard", log)),
+		NewTab("History", newLifecycle("history", log)),
+		NewTab("Settings", newLifecycle("settings", log)),
+	)
+}
+
+func TestTabController_StacksAreIndependent(t *testing.T) {
+	var log []string
+	c := newTestTabs(&log)
+	detail := &fakeScreen{name: "detail"}
+
+	c.Active().Push(detail)
+	c.Select(1)
+
+	assert.Equal(t, "history", c.Top().Body())
+	assert.Equal(t, 1, c.Active().Len(), "other tabs must not see pushes")
+
+	c.Select(0)
+	assert.Equal(t, detail, c.Top(), "tab should resume where it was left")
+	assert.Equal(t, 2, c.Active().Len())
+}
+
+func TestTabController_KeysSwitchTabs(t *testing.T) {
+	var log []string
+	c := newTestTabs(&log)
+
+	c.Update(tea.KeyPressMsg{Code: tea.KeyRight, Mod: tea.ModCtrl})
+	assert.Equal(t, 1, c.ActiveIndex())
+
+	c.Update(tea.KeyPressMsg{Code: tea.KeyLeft, Mod: tea.ModCtrl})
+	c.Update(tea.KeyPressMsg{Code: tea.KeyLeft, Mod: tea.ModCtrl})
+	assert.Equal(t, 2, c.ActiveIndex(), "Prev should wrap around")
+
+	c.Update(tea.KeyPressMsg{Code: '1', Mod: tea.ModAlt})
+	assert.Equal(t, 0, c.ActiveIndex())
+}
+
+func TestTabController_Select_RunsLifecycle(t *testing.T) {
+	var log []string
+	c := newTestTabs(&log)
+
+	c.Select(2)
+
+	assert.Equal(t, []string{
+		"dashboard.WillDisappear", "settings.WillAppear",
+		"dashboard.Disappeared", "settings.Appeared",
+	}, log)
+}
+
+func TestTabController_SelectInvalid_IsNoOp(t *testing.T) {
+	var log []string
+	c := newTestTabs(&log)
+
+	assert.Nil(t, c.Select(0), "selecting the active tab is a no-op")
+	assert.Nil(t, c.Select(7))
+	assert.Empty(t, log)
+}
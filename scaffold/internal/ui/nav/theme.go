@@ -0,0 +1,27 @@
+package nav
+
+import "scaffold/internal/ui/theme"
+
+// Theme returns the theme state last given to SetTheme and whether one has
+// been set at all.
+func (s *Stack) Theme() (theme.State, bool) {
+	return s.theme, s.themed
+}
+
+// SetTheme caches state and applies it to every screen in the stack, covered
+// ones and the presented screen included, that implements theme.Themeable.
+// Screens entering the stack later receive the cached state automatically.
+// Update calls SetTheme for any theme.ThemeChangedMsg.
+func (s *Stack) SetTheme(state theme.State) {
+	s.theme, s.themed = state, true
+	s.Each(func(screen Screen) Screen {
+		applyTheme(screen, state)
+		return screen
+	})
+}
+
+func applyTheme(screen Screen, state theme.State) {
+	if t, ok := screen.(theme.Themeable); ok {
+		t.ApplyTheme(state)
+	}
+}
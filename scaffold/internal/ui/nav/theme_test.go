@@ -0,0 +1,72 @@
+package nav
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"scaffold/internal/ui/theme"
+)
+
+// themedScreen records the theme names it was given.
+type themedScreen struct {
+	fakeScreen
+	applied []string
+}
+
+func (s *themedScreen) ApplyTheme(state theme.State) {
+	s.applied = append(s.applied, state.Name)
+}
+
+func newThemed(name string) *themedScreen {
+	return &themedScreen{fakeScreen: fakeScreen{name: name}}
+}
+
+func TestStack_SetTheme_AppliesToAllScreens(t *testing.T) {
+	covered, top, popup := newThemed("covered"), newThemed("top"), newThemed("popup")
+	s := NewStack(covered)
+	s.Push(top)
+	s.Present(popup)
+
+	s.SetTheme(theme.State{Name: "dracula"})
+
+	assert.Equal(t, []string{"dracula"}, covered.applied)
+	assert.Equal(t, []string{"dracula"}, top.applied)
+	assert.Equal(t, []string{"dracula"}, popup.applied)
+}
+
+func TestStack_NewScreensReceiveCachedTheme(t *testing.T) {
+	s := NewStack(newThemed("root"))
+	s.SetTheme(theme.State{Name: "nord"})
+
+	pushed, replaced, presented := newThemed("pushed"), newThemed("replaced"), newThemed("presented")
+	s.Push(pushed)
+	s.Replace(replaced)
+	s.Present(presented)
+
+	assert.Equal(t, []string{"nord"}, pushed.applied)
+	assert.Equal(t, []string{"nord"}, replaced.applied)
+	assert.Equal(t, []string{"nord"}, presented.applied)
+}
+
+func TestStack_Update_ThemeChangedMsg(t *testing.T) {
+	covered := newThemed("covered")
+	s := NewStack(covered)
+	s.Push(newThemed("top"))
+
+	s.Update(theme.ThemeChangedMsg{State: theme.State{Name: "solarized"}})
+
+	assert.Equal(t, []string{"solarized"}, covered.applied)
+	got, ok := s.Theme()
+	assert.True(t, ok)
+	assert.Equal(t, "solarized", got.Name)
+}
+
+func TestStack_NoThemeYet_NothingApplied(t *testing.T) {
+	s := NewStack(&fakeScreen{name: "root"})
+	pushed := newThemed("pushed")
+
+	s.Push(pushed)
+
+	assert.Empty(t, pushed.applied)
+}
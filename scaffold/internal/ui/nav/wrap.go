@@ -0,0 +1,69 @@
+package nav
+
+import (
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+)
+
+// Wrapper is embedded by screens that wrap another model, such as the
+// adapters in this package and the decorators in package screens. It
+// forwards the optional interfaces the stack and the root model look for to
+// Inner, so wrapping a model never hides them: the lifecycle hooks,
+// ScreenID, WantsBackground, input capture and key help. Each answers as if
+// the interface were not there when Inner does not implement it. The
+// embedding type keeps Inner current as the model updates.
+//
+// A Wrapper is not Serializable; a wrapping screen that should be restored
+// after a restart forwards Route and Params itself.
+type Wrapper struct {
+	Inner any
+}
+
+func (w Wrapper) WillAppear() tea.Cmd    { return w.hook(LifecycleScreen.WillAppear) }
+func (w Wrapper) Appeared() tea.Cmd      { return w.hook(LifecycleScreen.Appeared) }
+func (w Wrapper) WillDisappear() tea.Cmd { return w.hook(LifecycleScreen.WillDisappear) }
+func (w Wrapper) Disappeared() tea.Cmd   { return w.hook(LifecycleScreen.Disappeared) }
+
+// hook runs fn on Inner when it implements LifecycleScreen.
+func (w Wrapper) hook(fn func(LifecycleScreen) tea.Cmd) tea.Cmd {
+	if l, ok := w.Inner.(LifecycleScreen); ok {
+		return fn(l)
+	}
+	return nil
+}
+
+// ScreenID implements Identifiable with Inner's ID, or "" when it has none.
+func (w Wrapper) ScreenID() string {
+	if id, ok := w.Inner.(Identifiable); ok {
+		return id.ScreenID()
+	}
+	return ""
+}
+
+// WantsBackground implements BackgroundAware for Inner.
+func (w Wrapper) WantsBackground(msg tea.Msg) bool {
+	ba, ok := w.Inner.(BackgroundAware)
+	return ok && ba.WantsBackground(msg)
+}
+
+// CapturingInput reports whether Inner consumes plain keystrokes as text.
+func (w Wrapper) CapturingInput() bool {
+	c, ok := w.Inner.(interface{ CapturingInput() bool })
+	return ok && c.CapturingInput()
+}
+
+// ShortHelp returns Inner's key bindings for the help bar.
+func (w Wrapper) ShortHelp() []key.Binding {
+	if kb, ok := w.Inner.(interface{ ShortHelp() []key.Binding }); ok {
+		return kb.ShortHelp()
+	}
+	return nil
+}
+
+// FullHelp returns Inner's grouped key bindings for the expanded help bar.
+func (w Wrapper) FullHelp() [][]key.Binding {
+	if kb, ok := w.Inner.(interface{ FullHelp() [][]key.Binding }); ok {
+		return kb.FullHelp()
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// Package ui — navigation state persistence for rootModel.
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"scaffold/internal/logger"
+	"scaffold/internal/ui/nav"
+	"scaffold/internal/ui/screens"
+)
+
+// navStateFile is the name of the saved navigation stack, stored next to the
+// config file.
+const navStateFile = "navstate.json"
+
+// navStatePath returns where the navigation stack is persisted, or "" when
+// there is no config file to sit beside.
+func (m rootModel) navStatePath() string {
+	if m.configPath == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(m.configPath), navStateFile)
+}
+
+// buildScreen is the nav.Builder for every Serializable screen in the app.
+func (m rootModel) buildScreen(st nav.ScreenState) (nav.Screen, bool) {
+	switch st.Route {
+	case "home":
+		return m.withPluginItems(screens.NewHome()), true
+	case "detail":
+		p := st.Params
+		title := p["title"]
+		if title == "" {
+			title = p["id"] // deep links usually carry only the id
+		}
+		return screens.NewDetail(title, p["description"], p["id"], m.ctx), true
+	case "settings":
+		return screens.NewSettings(m.cfg), true
+	case "notes":
+		return screens.NewNotes(m.loadNotes()), true
+	case "messages":
+		return m.newMessages(), true
+	case "theme-editor":
+		return screens.NewThemeEditor(), true
+	case "fonts":
+		return screens.NewFontCatalog(m.cfg.App.Name), true
+	case "key-debug":
+		return screens.WithHeader(screens.NewKeyDebug(), "Key debugger"), true
+	case "capabilities":
+		return screens.NewCapabilities(m.themeMgr.Capabilities().Profile, os.Getenv), true
+	case "features":
+		return screens.NewFeatureFlags(), true
+	case "plugin":
+		if s, ok := m.pluginScreen(st.Params["name"], st.Params["screen"]); ok {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// WithScreen returns m opened on the screen named by route, e.g. "settings"
+// or "detail?id=about". Any other route is placed on top of Home so that Back
+// still leads somewhere; "home" leaves the default stack alone. A plugin
+// screen opens once the plugins have started, so an unknown one is only
+// logged then.
+func (m rootModel) WithScreen(route string) (rootModel, error) {
+	target, err := nav.ParseRoute(route)
+	if err != nil {
+		return m, err
+	}
+	st := nav.State{Screens: []nav.ScreenState{{Route: "home"}}}
+	if target.Route != "home" {
+		st.Screens = append(st.Screens, target)
+	}
+	m.pendingNav = nil
+	if m.waitsForPlugins(st) {
+		m.pendingNav = &st // checked when the plugins have started
+		return m, nil
+	}
+	if _, ok := m.buildScreen(target); !ok {
+		return m, fmt.Errorf("unknown screen %q", target.Route)
+	}
+	if err := m.stack.RestoreState(st, m.buildScreen); err != nil {
+		return m, err
+	}
+	return m, nil
+}
+
+// restoreNavState reopens the stack saved by saveNavState. A missing or
+// unreadable file leaves the default stack in place.
+func (m *rootModel) restoreNavState() {
+	path := m.navStatePath()
+	if path == "" {
+		return
+	}
+	st, err := nav.LoadStateFile(path)
+	if err != nil {
+		logger.Debug("nav state not restored: %v", err)
+		return
+	}
+	if m.waitsForPlugins(st) {
+		m.pendingNav = &st
+		return
+	}
+	if err := m.stack.RestoreState(st, m.buildScreen); err != nil {
+		logger.Debug("nav state not restored: %v", err)
+	}
+}
+
+// saveNavState persists the current stack so the next launch reopens it.
+func (m rootModel) saveNavState() {
+	path := m.navStatePath()
+	if path == "" {
+		return
+	}
+	st := m.stack.SaveState()
+	if m.pendingNav != nil && m.stack.Len() == 1 {
+		st = *m.pendingNav // quit before the plugins started; keep it for next time
+	}
+	if err := nav.SaveStateFile(path, st); err != nil {
+		logger.Debug("nav state not saved: %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+// Package ui — scratchpad persistence for rootModel.
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	tea "charm.land/bubbletea/v2"
+
+	"scaffold/internal/logger"
+	"scaffold/internal/ui/nav"
+	"scaffold/internal/ui/screens"
+	"scaffold/internal/ui/status"
+)
+
+// notesFile is the name of the scratchpad, stored next to the config file.
+const notesFile = "notes.md"
+
+// notesPath returns where the scratchpad is persisted, or "" when there is no
+// config file to sit beside.
+func (m rootModel) notesPath() string {
+	if m.configPath == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(m.configPath), notesFile)
+}
+
+// loadNotes returns the saved scratchpad text. Without a config file the text
+// only lives for the session. A missing file is an empty scratchpad; other
+// read errors are logged and treated the same way.
+func (m rootModel) loadNotes() string {
+	path := m.notesPath()
+	if path == "" {
+		return m.notes
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logger.Debug("notes not loaded: %v", err)
+		}
+		return ""
+	}
+	return string(raw)
+}
+
+// saveNotes writes text to the scratchpad file.
+func (m rootModel) saveNotes(text string) error {
+	path := m.notesPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("notes: creating directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(text), 0o644); err != nil {
+		return fmt.Errorf("notes: writing %s: %w", path, err)
+	}
+	return nil
+}
+
+// openNotes returns to the notes screen if it is already in the stack, or
+// pushes a fresh one loaded from disk.
+func (m rootModel) openNotes() (tea.Model, tea.Cmd) {
+	if m.stack.Contains("notes") {
+		return m.handlePopTo(nav.PopToMsg{ID: "notes"})
+	}
+	return m.handleNavigate(NavigateMsg{Screen: screens.NewNotes(m.loadNotes())})
+}
+
+func (m rootModel) handleNotesSaved(msg screens.NotesSavedMsg) (tea.Model, tea.Cmd) {
+	var saveCmd tea.Cmd
+	if m.notesPath() == "" {
+		m.notes = msg.Text
+		saveCmd = status.SetInfo("Notes kept for this session (no config file)", 0)
+	} else if err := m.saveNotes(msg.Text); err != nil {
+		return m, status.SetError("Save failed: "+err.Error(), 0)
+	} else {
+		saveCmd = status.SetSuccess("Notes saved", 0)
+	}
+	if n, ok := m.stack.Top().(*screens.Notes); ok {
+		n.MarkSaved()
+	}
+	if !msg.Close {
+		return m, saveCmd
+	}
+	updated, backCmd := m.handleBack(screens.BackMsg{})
+	return updated, tea.Batch(saveCmd, backCmd)
+}
@@ -0,0 +1,200 @@
+// Package ui — plugin screens, menu items and status segments for rootModel.
+package ui
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"scaffold/internal/logger"
+	"scaffold/internal/plugin"
+	"scaffold/internal/ui/menu"
+	"scaffold/internal/ui/nav"
+	"scaffold/internal/ui/screens"
+	"scaffold/internal/ui/statusbar"
+	"scaffold/internal/version"
+)
+
+// pluginsDir is the directory of plugins, next to the config file.
+const pluginsDir = "plugins"
+
+// pluginStatusInterval is how often plugins are asked for their status bar
+// segment.
+const pluginStatusInterval = 5 * time.Second
+
+// pluginStatusTickMsg asks for the plugins' status segments to be refreshed.
+type pluginStatusTickMsg struct{}
+
+// pluginsPath returns where plugins are installed, or "" when there is no
+// config file to sit beside.
+func (m rootModel) pluginsPath() string {
+	if m.configPath == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(m.configPath), pluginsDir)
+}
+
+// pluginsStartedMsg reports the plugins started by startPlugins. err lists
+// the plugins that failed to start; host holds the rest.
+type pluginsStartedMsg struct {
+	host *plugin.Host
+	err  error
+}
+
+// discoverPlugins finds the plugins in the plugins directory, for Init to
+// start. Plugins that fail to load are logged and skipped.
+func (m *rootModel) discoverPlugins() {
+	dir := m.pluginsPath()
+	if dir == "" {
+		return
+	}
+	manifests, err := plugin.Discover(dir)
+	if err != nil {
+		logger.Debug("plugins: %v", err)
+	}
+	m.manifests = manifests
+}
+
+// startPlugins returns the command that starts the discovered plugins and
+// reports them in a pluginsStartedMsg, or nil when there are none. Starting
+// waits for each plugin to answer, so it runs off the UI goroutine and a
+// slow plugin does not hold up the first frame.
+func (m rootModel) startPlugins() tea.Cmd {
+	manifests := m.manifests
+	if len(manifests) == 0 {
+		return nil
+	}
+	ctx := m.ctx
+	return func() tea.Msg {
+		host, err := plugin.StartHost(ctx, manifests, version.Version)
+		if ctx.Err() != nil { // the app quit while they started
+			host.Shutdown()
+			return nil
+		}
+		return pluginsStartedMsg{host: host, err: err}
+	}
+}
+
+// handlePluginsStarted adds the started plugins' screens to the Home menu,
+// starts polling their status segments, and reopens a stack that was
+// waiting on them, unless the user has navigated away from Home since.
+func (m rootModel) handlePluginsStarted(msg pluginsStartedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		logger.Debug("plugins: %v", msg.err)
+	}
+	m.plugins = msg.host
+	m.manifests = nil
+	for _, c := range m.plugins.Plugins() {
+		logger.Debug("plugin %s %s started", c.Manifest.Name, c.Manifest.Version)
+	}
+	m.stack.Each(m.withPluginItems)
+	cmds := []tea.Cmd{m.pollPluginStatus()}
+
+	pending := m.pendingNav
+	m.pendingNav = nil
+	if pending != nil && m.stack.Len() == 1 && m.stack.Presented() == nil {
+		if err := m.stack.RestoreState(*pending, m.buildScreen); err != nil {
+			logger.Debug("nav state not restored: %v", err)
+		} else {
+			m.bodyH = m.bodyHeight()
+			m.stack.Each(m.sized)
+			cmds = append(cmds, m.stack.Top().Init())
+		}
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// waitsForPlugins reports whether st opens a plugin screen that cannot be
+// built until the discovered plugins have started.
+func (m rootModel) waitsForPlugins(st nav.State) bool {
+	if len(m.manifests) == 0 {
+		return false
+	}
+	return slices.ContainsFunc(st.Screens, func(ss nav.ScreenState) bool { return ss.Route == "plugin" })
+}
+
+// withPluginItems adds a menu item for every plugin screen to s when it is
+// the Home screen.
+func (m rootModel) withPluginItems(s screens.Screen) screens.Screen {
+	home, ok := s.(*screens.Home)
+	if !ok {
+		return s
+	}
+	var items []menu.Item
+	for _, c := range m.plugins.Plugins() {
+		for _, spec := range c.Manifest.Screens {
+			desc := spec.Description
+			if desc == "" {
+				desc = c.Manifest.Description
+			}
+			item := menu.NewItem(spec.Title, desc, screens.PluginScreenID(c.Manifest.Name, spec.ID))
+			if spec.Icon != "" {
+				item = item.WithIcon(spec.Icon, ">")
+			}
+			if spec.Shortcut != "" {
+				item = item.WithShortcut(spec.Shortcut)
+			}
+			items = append(items, item)
+		}
+	}
+	if len(items) > 0 {
+		home.AddItems(items...)
+	}
+	return home
+}
+
+// pluginScreen builds the screen named by a plugin name and screen ID.
+func (m rootModel) pluginScreen(name, id string) (*screens.PluginScreen, bool) {
+	c, ok := m.plugins.Lookup(name)
+	if !ok {
+		return nil, false
+	}
+	spec, ok := c.Manifest.Screen(id)
+	if !ok {
+		return nil, false
+	}
+	return screens.NewPluginScreen(m.ctx, c, spec), true
+}
+
+// openPluginScreen opens the plugin screen behind a menu item ID of the form
+// "plugin:<name>/<screen>".
+func (m rootModel) openPluginScreen(screenID string) (tea.Model, tea.Cmd, bool) {
+	rest, ok := strings.CutPrefix(screenID, "plugin:")
+	if !ok {
+		return m, nil, false
+	}
+	name, id, _ := strings.Cut(rest, "/")
+	s, ok := m.pluginScreen(name, id)
+	if !ok {
+		return m, nil, false
+	}
+	next, cmd := m.handleNavigate(NavigateMsg{Screen: s})
+	return next, cmd, true
+}
+
+// pollPluginStatus asks every plugin with a status segment for its text, and
+// schedules the next poll. It returns nil when no plugin has a segment.
+func (m rootModel) pollPluginStatus() tea.Cmd {
+	var cmds []tea.Cmd
+	for _, c := range m.plugins.Plugins() {
+		if !c.Manifest.Status {
+			continue
+		}
+		ctx := m.ctx
+		cmds = append(cmds, func() tea.Msg {
+			text, err := c.Status(ctx)
+			if err != nil && ctx.Err() == nil {
+				logger.Debug("%v", err)
+			}
+			return statusbar.SegmentMsg{Source: c.Manifest.Name, Text: text}
+		})
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	tick := tea.Tick(pluginStatusInterval, func(time.Time) tea.Msg { return pluginStatusTickMsg{} })
+	return tea.Batch(append(cmds, tick)...)
+}
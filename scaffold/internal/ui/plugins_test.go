@@ -0,0 +1,164 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"scaffold/internal/plugin"
+	"scaffold/internal/ui/menu"
+	"scaffold/internal/ui/nav"
+	"scaffold/internal/ui/screens"
+	"scaffold/internal/ui/statusbar"
+	"scaffold/internal/ui/theme"
+	"scaffold/internal/ui/theme/themetest"
+)
+
+// pluginHelperEnv makes the test binary act as the plugin under test.
+const pluginHelperEnv = "SCAFFOLD_UI_PLUGIN_HELPER"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(pluginHelperEnv) == "1" {
+		plugin.Main(plugin.Handler{
+			Render: func(p plugin.RenderParams) (plugin.Reply, error) {
+				return plugin.Reply{Body: fmt.Sprintf("hello from %s", p.Screen)}, nil
+			},
+			Key: func(p plugin.KeyParams) (plugin.Reply, error) {
+				return plugin.Reply{Body: "pressed " + p.Key, Close: p.Key == "x"}, nil
+			},
+			Status: func() (string, error) { return "echo ok", nil },
+		})
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// pluginModel returns a sized model with the test binary installed as the
+// "echo" plugin next to its config file, and started.
+func pluginModel(t *testing.T) rootModel {
+	t.Helper()
+	m := echoPluginModel(t)
+	require.Nil(t, m.plugins, "plugins start in Init, not in New")
+	updated, _ := m.Update(m.startPlugins()())
+	m = updated.(rootModel)
+	t.Cleanup(m.plugins.Shutdown)
+	require.Len(t, m.plugins.Plugins(), 1)
+	updated, _ = m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	return updated.(rootModel)
+}
+
+// echoPluginModel returns a model with the test binary installed as the
+// "echo" plugin next to its config file, found but not yet started.
+func echoPluginModel(t *testing.T) rootModel {
+	t.Helper()
+	t.Setenv(pluginHelperEnv, "1")
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	dir := filepath.Join(t.TempDir(), pluginsDir, "echo")
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	manifest := fmt.Sprintf(`{"name": "echo", "exec": [%q], "status": true,
+		"screens": [{"id": "main", "title": "Echo", "shortcut": "e"}]}`, exe)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, plugin.ManifestFile), []byte(manifest), 0o644))
+
+	m := testModel(t)
+	m.configPath = filepath.Join(dir, "..", "..", "config.json")
+	m.discoverPlugins()
+	return m
+}
+
+func TestPlugins_DeepLinkWaitsForStart(t *testing.T) {
+	m, err := echoPluginModel(t).WithScreen("plugin?name=echo&screen=main")
+	require.NoError(t, err)
+	assert.Equal(t, 1, m.stack.Len(), "the plugin screen cannot be built yet")
+
+	updated, cmd := m.Update(m.startPlugins()())
+	m = updated.(rootModel)
+	t.Cleanup(m.plugins.Shutdown)
+	require.IsType(t, &screens.PluginScreen{}, m.stack.Top(), "it opens once the plugins have started")
+	assert.Equal(t, 2, m.stack.Len())
+	assert.NotNil(t, cmd)
+}
+
+func TestPlugins_ScreenFromMenu(t *testing.T) {
+	m := pluginModel(t)
+	item := menu.NewItem("Echo", "", screens.PluginScreenID("echo", "main"))
+
+	updated, _ := m.Update(menu.SelectionMsg{Item: item})
+	m = updated.(rootModel)
+	s, ok := m.stack.Top().(*screens.PluginScreen)
+	require.True(t, ok, "menu item opens the plugin screen")
+	assert.Contains(t, ansi.Strip(s.Body()), "Loading…")
+
+	updated, _ = m.Update(s.Init()())
+	m = updated.(rootModel)
+	assert.Contains(t, ansi.Strip(m.stack.Top().(*screens.PluginScreen).Body()), "hello from main")
+
+	_, cmd := m.Update(tea.KeyPressMsg{Code: 'x', Text: "x"})
+	require.NotNil(t, cmd)
+	updated, cmd = m.Update(cmd())
+	m = updated.(rootModel)
+	require.NotNil(t, cmd)
+	assert.Equal(t, screens.BackMsg{}, cmd(), "close in the reply goes back")
+}
+
+func TestPlugins_MenuItemsAndRoute(t *testing.T) {
+	m := pluginModel(t)
+	st, ok := m.buildScreen(nav.ScreenState{Route: "plugin", Params: map[string]string{"name": "echo", "screen": "main"}})
+	require.True(t, ok)
+	assert.Equal(t, screens.PluginScreenID("echo", "main"), st.(*screens.PluginScreen).ScreenID())
+
+	_, ok = m.buildScreen(nav.ScreenState{Route: "plugin", Params: map[string]string{"name": "echo", "screen": "missing"}})
+	assert.False(t, ok)
+
+	body := ansi.Strip(m.stack.Top().(*screens.Home).Body())
+	assert.Contains(t, body, "Echo")
+}
+
+func TestPlugins_StatusSegment(t *testing.T) {
+	m := pluginModel(t)
+	batch, ok := m.pollPluginStatus()().(tea.BatchMsg)
+	require.True(t, ok)
+	var msgs []tea.Msg
+	for _, cmd := range batch[:len(batch)-1] { // the last is the next poll
+		msgs = append(msgs, cmd())
+	}
+	require.True(t, slices.Contains(msgs, tea.Msg(statusbar.SegmentMsg{Source: "echo", Text: "echo ok"})))
+
+	state := themetest.State()
+	state.Width = 80
+	updated, _ := m.Update(theme.ThemeChangedMsg{State: state})
+	updated, _ = updated.Update(msgs[0])
+	m = updated.(rootModel)
+	assert.Equal(t, []statusbar.Segment{{Source: "echo", Text: "echo ok"}}, m.statusbar.Segments())
+	assert.Contains(t, ansi.Strip(m.statusbar.View().Content), "echo ok")
+}
+
+func TestPlugins_KeysSentInOrder(t *testing.T) {
+	m := pluginModel(t)
+	updated, _ := m.Update(menu.SelectionMsg{Item: menu.NewItem("Echo", "", screens.PluginScreenID("echo", "main"))})
+	m = updated.(rootModel)
+	s := m.stack.Top().(*screens.PluginScreen)
+	cmd := s.Init()
+
+	// Keys typed while a call is in flight wait for it.
+	for _, k := range []rune{'a', 'b'} {
+		_, keyCmd := s.Update(tea.KeyPressMsg{Code: k, Text: string(k)})
+		assert.Nil(t, keyCmd)
+	}
+	var bodies []string
+	for cmd != nil {
+		_, cmd = s.Update(cmd())
+		bodies = append(bodies, ansi.Strip(s.Body()))
+	}
+	require.Len(t, bodies, 3, "one call per key, one after the other")
+	assert.Contains(t, bodies[0], "hello from main")
+	assert.Contains(t, bodies[1], "pressed a")
+	assert.Contains(t, bodies[2], "pressed b")
+}
@@ -0,0 +1,109 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+
+	"scaffold/config"
+	"scaffold/internal/task"
+	"scaffold/internal/ui/menu"
+)
+
+// reflowSizes is the resize matrix, from the smallest supported terminal up
+// to a large ultra-wide one.
+var reflowSizes = []tea.WindowSizeMsg{
+	{Width: 20, Height: 10},
+	{Width: 40, Height: 12},
+	{Width: 60, Height: 20},
+	{Width: 80, Height: 24},
+	{Width: 120, Height: 40},
+	{Width: 200, Height: 60},
+	{Width: 300, Height: 80},
+}
+
+// reflowRoutes are the screens the reflow tests open, each via WithScreen.
+var reflowRoutes = []string{
+	"home",
+	"detail?id=dashboard&title=Dashboard&description=View application dashboard",
+	"settings",
+	"notes",
+	"theme-editor",
+	"key-debug",
+	"capabilities",
+	"features",
+}
+
+// newReflowRunner opens route on a dark default-config model, with commands
+// left unexecuted as in Snapshot.
+func newReflowRunner(t *testing.T, route string) *snapshotRunner {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	menu.SetASCIIIcons(false)
+	cfg := *config.DefaultConfig()
+	m := newRootModel(ctx, cancel, cfg, "", false)
+	m, err := m.WithScreen(route)
+	require.NoError(t, err)
+	m.themeMgr.Init(cfg.UI.ThemeName, true, 0)
+
+	r := &snapshotRunner{m: m}
+	r.send(tea.BackgroundColorMsg{Color: color.Black})
+	r.send(task.DoneMsg[string]{Label: "detail-load", Value: "loaded"})
+	return r
+}
+
+// resize delivers size and the theme update it triggers, and returns the
+// rendered frame.
+func (r *snapshotRunner) resize(size tea.WindowSizeMsg) string {
+	r.send(size)
+	r.syncTheme()
+	return r.m.View().Content
+}
+
+// checkFrame reports the first line of frame that is wider than size.
+func checkFrame(frame string, size tea.WindowSizeMsg) error {
+	for i, line := range strings.Split(frame, "\n") {
+		if w := ansi.StringWidth(line); w > size.Width {
+			return fmt.Errorf("line %d is %d cells wide: %q", i+1, w, ansi.Strip(line))
+		}
+	}
+	return nil
+}
+
+func TestReflow_FramesFitEverySize(t *testing.T) {
+	for _, route := range reflowRoutes {
+		t.Run(route, func(t *testing.T) {
+			r := newReflowRunner(t, route)
+			for _, size := range reflowSizes {
+				var frame string
+				require.NotPanics(t, func() { frame = r.resize(size) }, "%dx%d", size.Width, size.Height)
+				require.NoError(t, checkFrame(frame, size), "%dx%d", size.Width, size.Height)
+			}
+		})
+	}
+}
+
+func TestReflow_ResizeRoundTripIsStable(t *testing.T) {
+	for _, route := range reflowRoutes {
+		t.Run(route, func(t *testing.T) {
+			r := newReflowRunner(t, route)
+			for _, size := range reflowSizes {
+				want := r.resize(size)
+				// Visit the extremes and come back: the frame must not depend
+				// on the sizes seen in between.
+				r.resize(reflowSizes[0])
+				r.resize(reflowSizes[len(reflowSizes)-1])
+				got := r.resize(size)
+				require.Equal(t, plainFrame(want), plainFrame(got), "%dx%d", size.Width, size.Height)
+			}
+		})
+	}
+}
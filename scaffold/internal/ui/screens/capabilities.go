@@ -0,0 +1,316 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/colorprofile"
+	uv "github.com/charmbracelet/ultraviolet"
+	"github.com/charmbracelet/x/ansi"
+
+	"scaffold/internal/ui/theme"
+)
+
+// capabilityProbeTimeout is how long the capabilities screen waits for
+// terminal replies before reporting the missing ones as unanswered.
+const capabilityProbeTimeout = time.Second
+
+// capabilityTimeoutMsg ends the probe wait.
+type capabilityTimeoutMsg struct{}
+
+// mouseModes are the mouse tracking modes the capabilities screen queries.
+var mouseModes = []struct {
+	mode ansi.DECMode
+	name string
+}{
+	{ansi.ModeMouseNormal, "normal (1000)"},
+	{ansi.ModeMouseButtonEvent, "button (1002)"},
+	{ansi.ModeMouseAnyEvent, "any motion (1003)"},
+	{ansi.ModeMouseExtSgr, "SGR (1006)"},
+}
+
+// Capability is one line of the terminal capability report.
+type Capability struct {
+	Name  string
+	Value string
+}
+
+// Capabilities probes the terminal with escape-sequence queries and shows
+// what it supports: color depth, Unicode and grapheme sizing, background
+// color, kitty keyboard flags, mouse modes, clipboard and hyperlink support,
+// and cell size. The report can
+// be copied for bug reports; it complements the doctor subcommand, which sees
+// only the environment.
+//
+// Terminals that do not understand a query stay silent, so unanswered probes
+// are reported as such after capabilityProbeTimeout.
+type Capabilities struct {
+	theme.ThemeAware
+
+	profile  colorprofile.Profile
+	getenv   func(string) string
+	bg       *tea.BackgroundColorMsg
+	version  string
+	kitty    *tea.KeyboardEnhancementsMsg
+	modes    map[ansi.DECMode]ansi.ModeSetting
+	cellSize *uv.CellSizeEvent
+	timedOut bool
+	keys     capabilitiesKeyMap
+}
+
+type capabilitiesKeyMap struct {
+	Copy  key.Binding
+	Probe key.Binding
+	Back  key.Binding
+}
+
+// NewCapabilities creates the capabilities screen. profile is the color
+// profile Bubble Tea detected, and getenv looks up environment variables,
+// normally os.Getenv.
+func NewCapabilities(profile colorprofile.Profile, getenv func(string) string) *Capabilities {
+	return &Capabilities{
+		profile: profile,
+		getenv:  getenv,
+		modes:   make(map[ansi.DECMode]ansi.ModeSetting),
+		keys: capabilitiesKeyMap{
+			Copy:  key.NewBinding(key.WithKeys("c", "y"), key.WithHelp("c", "copy report")),
+			Probe: key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "probe again")),
+			Back:  key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+		},
+	}
+}
+
+// ScreenID implements nav.Identifiable.
+func (c *Capabilities) ScreenID() string { return "capabilities" }
+
+// Route implements nav.Serializable.
+func (c *Capabilities) Route() string { return "capabilities" }
+
+// Params implements nav.Serializable.
+func (c *Capabilities) Params() map[string]string { return nil }
+
+// ApplyTheme implements theme.Themeable.
+func (c *Capabilities) ApplyTheme(state theme.State) {
+	c.ApplyThemeState(state)
+}
+
+// Init sends the terminal queries.
+func (c *Capabilities) Init() tea.Cmd {
+	cmds := []tea.Cmd{
+		tea.RequestBackgroundColor,
+		tea.RequestTerminalVersion,
+		tea.Raw(ansi.RequestKittyKeyboard),
+		tea.Raw(ansi.WindowOp(ansi.RequestCellSizeWinOp)),
+		tea.Raw(ansi.RequestModeUnicodeCore),
+		tea.Tick(capabilityProbeTimeout, func(time.Time) tea.Msg { return capabilityTimeoutMsg{} }),
+	}
+	for _, m := range mouseModes {
+		cmds = append(cmds, tea.Raw(ansi.RequestMode(m.mode)))
+	}
+	return tea.Batch(cmds...)
+}
+
+// Update records terminal replies and handles keys.
+func (c *Capabilities) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.ColorProfileMsg:
+		c.profile = msg.Profile
+	case tea.BackgroundColorMsg:
+		c.bg = &msg
+	case tea.TerminalVersionMsg:
+		c.version = msg.Name
+	case tea.KeyboardEnhancementsMsg:
+		c.kitty = &msg
+	case tea.ModeReportMsg:
+		if m, ok := msg.Mode.(ansi.DECMode); ok {
+			c.modes[m] = msg.Value
+		}
+	case uv.CellSizeEvent:
+		c.cellSize = &msg
+	case capabilityTimeoutMsg:
+		c.timedOut = true
+	case tea.KeyPressMsg:
+		switch {
+		case key.Matches(msg, c.keys.Copy):
+			return c, func() tea.Msg {
+				return CopyToClipboardMsg{Text: c.ReportText(), Label: "Capability report"}
+			}
+		case key.Matches(msg, c.keys.Probe):
+			c.bg, c.version, c.kitty, c.cellSize, c.timedOut = nil, "", nil, nil, false
+			clear(c.modes)
+			return c, c.Init()
+		case key.Matches(msg, c.keys.Back):
+			return c, func() tea.Msg { return BackMsg{} }
+		}
+	}
+	return c, nil
+}
+
+// Report returns the capability lines in display order.
+func (c *Capabilities) Report() []Capability {
+	pending := "waiting…"
+	if c.timedOut {
+		pending = "no reply"
+	}
+	or := func(value, fallback string) string {
+		if value == "" {
+			return fallback
+		}
+		return value
+	}
+
+	terminal := or(c.version, or(c.getenv("TERM_PROGRAM"), pending))
+	report := []Capability{
+		{"Terminal", terminal},
+		{"TERM", or(c.getenv("TERM"), "(unset)")},
+		{"Color depth", c.colorDepth()},
+		{"Unicode", c.unicode()},
+		{"Grapheme width (2027)", c.mode(ansi.ModeUnicodeCore, pending)},
+		{"Background", c.background(pending)},
+		{"Kitty keyboard", c.kittyKeyboard(pending)},
+	}
+	for _, m := range mouseModes {
+		report = append(report, Capability{"Mouse " + m.name, c.mode(m.mode, pending)})
+	}
+	report = append(report,
+		Capability{"OSC 52 clipboard", c.clipboard()},
+		Capability{"OSC 8 hyperlinks", c.hyperlinks()},
+		Capability{"Cell size", c.cell(pending)},
+	)
+	return report
+}
+
+// ReportText returns the report as plain text for pasting into bug reports.
+func (c *Capabilities) ReportText() string {
+	var b strings.Builder
+	b.WriteString("Terminal capabilities\n")
+	for _, r := range c.Report() {
+		fmt.Fprintf(&b, "  %-24s %s\n", r.Name+":", r.Value)
+	}
+	return b.String()
+}
+
+func (c *Capabilities) colorDepth() string {
+	switch c.profile {
+	case colorprofile.TrueColor:
+		return "24-bit (TrueColor)"
+	case colorprofile.ANSI256:
+		return "8-bit (256 colors)"
+	case colorprofile.ANSI:
+		return "4-bit (16 colors)"
+	case colorprofile.ASCII:
+		return "none (ASCII)"
+	case colorprofile.NoTTY:
+		return "none (not a terminal)"
+	}
+	return "not reported"
+}
+
+// unicode reports whether symbols and emoji are used, which the app decides
+// from the locale (see theme.Capabilities).
+func (c *Capabilities) unicode() string {
+	if c.ThemeState().Caps.Unicode {
+		return "yes (UTF-8 locale)"
+	}
+	return "no (ASCII fallbacks)"
+}
+
+func (c *Capabilities) background(pending string) string {
+	if c.bg == nil {
+		return pending
+	}
+	shade := "light"
+	if c.bg.IsDark() {
+		shade = "dark"
+	}
+	return fmt.Sprintf("%s (%s)", c.bg.String(), shade)
+}
+
+func (c *Capabilities) kittyKeyboard(pending string) string {
+	switch {
+	case c.kitty != nil && c.kitty.Flags != 0:
+		return fmt.Sprintf("supported (flags %#x)", c.kitty.Flags)
+	case c.kitty != nil:
+		return "supported (no flags enabled)"
+	case c.timedOut:
+		return "not supported"
+	}
+	return pending
+}
+
+func (c *Capabilities) mode(m ansi.DECMode, pending string) string {
+	v, ok := c.modes[m]
+	switch {
+	case !ok:
+		return pending
+	case v.IsNotRecognized():
+		return "not supported"
+	case v.IsSet(), v.IsPermanentlySet():
+		return "supported (on)"
+	}
+	return "supported (off)"
+}
+
+func (c *Capabilities) cell(pending string) string {
+	if c.cellSize == nil {
+		return pending
+	}
+	return fmt.Sprintf("%dx%d px", c.cellSize.Width, c.cellSize.Height)
+}
+
+// clipboard describes OSC 52 support. Terminals cannot be asked about it, and
+// probing by reading the clipboard makes some of them prompt the user, so
+// copying the report doubles as the test.
+func (c *Capabilities) clipboard() string {
+	note := "press c to test"
+	if c.getenv("TMUX") != "" {
+		note += "; tmux needs set-clipboard on"
+	}
+	return "unknown (" + note + ")"
+}
+
+// hyperlinks guesses OSC 8 support from the terminal's name, since there is
+// no query for it.
+func (c *Capabilities) hyperlinks() string {
+	if t := theme.HyperlinkTerminal(c.version, c.getenv("TERM_PROGRAM"), c.getenv("TERM")); t != "" {
+		return "likely (" + t + ")"
+	}
+	switch {
+	case c.getenv("WT_SESSION") != "":
+		return "likely (Windows Terminal)"
+	case c.getenv("VTE_VERSION") != "":
+		return "likely (VTE)"
+	}
+	return "unknown"
+}
+
+// View satisfies tea.Model.
+func (c *Capabilities) View() tea.View { return tea.NewView(c.Body()) }
+
+// Body returns the renderable content for layout composition.
+func (c *Capabilities) Body() string {
+	p := c.Palette()
+	title := lipgloss.NewStyle().Bold(true).Foreground(p.Primary).Render("Terminal capabilities")
+	label := lipgloss.NewStyle().Foreground(p.ForegroundMuted).Width(26)
+	value := lipgloss.NewStyle().Foreground(p.Foreground)
+
+	lines := []string{title, ""}
+	for _, r := range c.Report() {
+		lines = append(lines, label.Render(r.Name)+value.Render(r.Value))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ShortHelp returns key bindings for the help bar.
+func (c *Capabilities) ShortHelp() []key.Binding {
+	return []key.Binding{c.keys.Copy, c.keys.Probe, c.keys.Back}
+}
+
+// FullHelp returns grouped key bindings for the expanded help bar.
+func (c *Capabilities) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{c.keys.Copy, c.keys.Probe}, {c.keys.Back}}
+}
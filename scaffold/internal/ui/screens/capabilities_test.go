@@ -0,0 +1,86 @@
+package screens
+
+import (
+	"image/color"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/colorprofile"
+	uv "github.com/charmbracelet/ultraviolet"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"scaffold/internal/ui/theme/themetest"
+)
+
+func newTestCapabilities(env map[string]string) *Capabilities {
+	c := NewCapabilities(colorprofile.ANSI256, func(k string) string { return env[k] })
+	c.ApplyTheme(themetest.State())
+	return c
+}
+
+func reportValue(t *testing.T, c *Capabilities, name string) string {
+	t.Helper()
+	for _, r := range c.Report() {
+		if r.Name == name {
+			return r.Value
+		}
+	}
+	require.Failf(t, "missing capability", "%q", name)
+	return ""
+}
+
+func TestCapabilities_RecordsReplies(t *testing.T) {
+	c := newTestCapabilities(nil)
+
+	c.Update(tea.BackgroundColorMsg{Color: color.RGBA{R: 0x10, G: 0x10, B: 0x10, A: 0xff}})
+	c.Update(tea.TerminalVersionMsg{Name: "kitty(0.32.2)"})
+	c.Update(tea.KeyboardEnhancementsMsg{Flags: ansi.KittyDisambiguateEscapeCodes})
+	c.Update(tea.ModeReportMsg{Mode: ansi.ModeMouseExtSgr, Value: ansi.ModeReset})
+	c.Update(tea.ModeReportMsg{Mode: ansi.ModeMouseAnyEvent, Value: ansi.ModeNotRecognized})
+	c.Update(uv.CellSizeEvent{Width: 9, Height: 18})
+
+	assert.Equal(t, "kitty(0.32.2)", reportValue(t, c, "Terminal"))
+	assert.Equal(t, "8-bit (256 colors)", reportValue(t, c, "Color depth"))
+	assert.Equal(t, "#101010 (dark)", reportValue(t, c, "Background"))
+	assert.Equal(t, "supported (flags 0x1)", reportValue(t, c, "Kitty keyboard"))
+	assert.Equal(t, "supported (off)", reportValue(t, c, "Mouse SGR (1006)"))
+	assert.Equal(t, "not supported", reportValue(t, c, "Mouse any motion (1003)"))
+	assert.Equal(t, "likely (kitty)", reportValue(t, c, "OSC 8 hyperlinks"))
+	assert.Equal(t, "9x18 px", reportValue(t, c, "Cell size"))
+}
+
+func TestCapabilities_UnansweredProbesAfterTimeout(t *testing.T) {
+	c := newTestCapabilities(map[string]string{"TMUX": "/tmp/tmux-0/default"})
+	assert.Equal(t, "waiting…", reportValue(t, c, "Cell size"))
+
+	c.Update(capabilityTimeoutMsg{})
+
+	assert.Equal(t, "no reply", reportValue(t, c, "Cell size"))
+	assert.Equal(t, "not supported", reportValue(t, c, "Kitty keyboard"))
+	assert.Equal(t, "unknown", reportValue(t, c, "OSC 8 hyperlinks"))
+	assert.Contains(t, reportValue(t, c, "OSC 52 clipboard"), "set-clipboard")
+}
+
+func TestCapabilities_CopyReport(t *testing.T) {
+	c := newTestCapabilities(map[string]string{"TERM": "xterm-256color"})
+
+	_, cmd := c.Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(CopyToClipboardMsg)
+	require.True(t, ok)
+	assert.Contains(t, msg.Text, "Terminal capabilities")
+	assert.Contains(t, msg.Text, "xterm-256color")
+}
+
+func TestCapabilities_ProbeAgainKeepsTheme(t *testing.T) {
+	c := newTestCapabilities(nil)
+	c.Update(uv.CellSizeEvent{Width: 9, Height: 18})
+
+	_, cmd := c.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "waiting…", reportValue(t, c, "Cell size"))
+	assert.Equal(t, "default", c.ThemeName())
+}
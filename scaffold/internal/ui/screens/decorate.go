@@ -0,0 +1,194 @@
+package screens
+
+import (
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"scaffold/internal/ui/nav"
+	"scaffold/internal/ui/theme"
+)
+
+// decorator is what the screen decorators share. nav.Wrapper forwards the
+// lifecycle hooks, ID, help and input capture to the wrapped screen, as it
+// does for the nav adapters; decorator adds the theme, the size, keyboard
+// enhancements and the saved route, so wrapping a screen hides none of them.
+type decorator struct {
+	nav.Wrapper
+	theme.ThemeAware
+
+	lines int // lines of chrome taken from the body height
+}
+
+// screen returns the wrapped screen.
+func (d *decorator) screen() Screen { return d.Inner.(Screen) }
+
+// Init implements tea.Model.
+func (d *decorator) Init() tea.Cmd { return d.screen().Init() }
+
+// update passes msg to the wrapped screen, keeping what it returns.
+func (d *decorator) update(msg tea.Msg) tea.Cmd {
+	m, cmd := d.screen().Update(msg)
+	if s, ok := m.(Screen); ok {
+		d.Inner = s
+	}
+	return cmd
+}
+
+// ApplyTheme implements theme.Themeable for the decorator and the wrapped
+// screen.
+func (d *decorator) ApplyTheme(state theme.State) {
+	d.ApplyThemeState(state)
+	if t, ok := d.Inner.(theme.Themeable); ok {
+		t.ApplyTheme(state)
+	}
+}
+
+// setWidth gives the wrapped screen the full width.
+func (d *decorator) setWidth(w int) {
+	if setter, ok := d.Inner.(interface{ SetWidth(int) Screen }); ok {
+		d.Inner = setter.SetWidth(w)
+	}
+}
+
+// setHeight gives the wrapped screen the body height less the chrome.
+func (d *decorator) setHeight(h int) {
+	if setter, ok := d.Inner.(interface{ SetHeight(int) Screen }); ok {
+		d.Inner = setter.SetHeight(max(h-d.lines, 1))
+	}
+}
+
+// KeyboardEnhancements implements KeyboardEnhancer for the wrapped screen.
+func (d *decorator) KeyboardEnhancements() tea.KeyboardEnhancements {
+	if e, ok := d.Inner.(KeyboardEnhancer); ok {
+		return e.KeyboardEnhancements()
+	}
+	return tea.KeyboardEnhancements{}
+}
+
+// Route implements nav.Serializable with the wrapped screen's route. It is
+// empty when the wrapped screen is not serializable, which ends a saved
+// session at the decorator.
+func (d *decorator) Route() string {
+	if ser, ok := d.Inner.(nav.Serializable); ok {
+		return ser.Route()
+	}
+	return ""
+}
+
+// Params implements nav.Serializable with the wrapped screen's params.
+func (d *decorator) Params() map[string]string {
+	if ser, ok := d.Inner.(nav.Serializable); ok {
+		return ser.Params()
+	}
+	return nil
+}
+
+// WithHeader wraps s with a title above its body, styled like the titles of
+// the built-in screens, so a screen can render its content alone.
+func WithHeader(s Screen, title string) Screen {
+	return &headerScreen{decorator: decorator{Wrapper: nav.Wrapper{Inner: s}, lines: 2}, title: title}
+}
+
+type headerScreen struct {
+	decorator
+
+	title string
+}
+
+// Update implements tea.Model.
+func (h *headerScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) { return h, h.update(msg) }
+
+// View implements tea.Model.
+func (h *headerScreen) View() tea.View { return tea.NewView(h.Body()) }
+
+// Body implements nav.Screen: the title, a blank line, and the body.
+func (h *headerScreen) Body() string {
+	title := lipgloss.NewStyle().Bold(true).Foreground(h.Palette().Primary).Render(h.title)
+	return lipgloss.JoinVertical(lipgloss.Left, title, "", h.screen().Body())
+}
+
+// SetWidth sets the available render width.
+func (h *headerScreen) SetWidth(w int) Screen { h.setWidth(w); return h }
+
+// SetHeight sets the available body height, reserving two lines for the
+// title.
+func (h *headerScreen) SetHeight(v int) Screen { h.setHeight(v); return h }
+
+// WithHelp wraps s so bindings show in the help bar after s's own. The
+// bindings are only listed; s still handles the keys in its Update.
+func WithHelp(s Screen, bindings ...key.Binding) Screen {
+	return &helpScreen{decorator: decorator{Wrapper: nav.Wrapper{Inner: s}}, bindings: bindings}
+}
+
+type helpScreen struct {
+	decorator
+
+	bindings []key.Binding
+}
+
+// Update implements tea.Model.
+func (h *helpScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) { return h, h.update(msg) }
+
+// View implements tea.Model.
+func (h *helpScreen) View() tea.View { return tea.NewView(h.Body()) }
+
+// Body implements nav.Screen with s's body as it is.
+func (h *helpScreen) Body() string { return h.screen().Body() }
+
+// SetWidth sets the available render width.
+func (h *helpScreen) SetWidth(w int) Screen { h.setWidth(w); return h }
+
+// SetHeight sets the available body height.
+func (h *helpScreen) SetHeight(v int) Screen { h.setHeight(v); return h }
+
+// ShortHelp returns s's bindings followed by the added ones.
+func (h *helpScreen) ShortHelp() []key.Binding {
+	return append(h.decorator.ShortHelp(), h.bindings...)
+}
+
+// FullHelp returns s's groups followed by the added bindings as a group of
+// their own.
+func (h *helpScreen) FullHelp() [][]key.Binding {
+	groups := h.decorator.FullHelp()
+	if len(h.bindings) > 0 {
+		groups = append(groups, h.bindings)
+	}
+	return groups
+}
+
+// WithStatus wraps s with a muted status line below its body. status is
+// called on every render, so the line follows whatever state it reads; an
+// empty status leaves the line out.
+func WithStatus(s Screen, status func() string) Screen {
+	return &statusScreen{decorator: decorator{Wrapper: nav.Wrapper{Inner: s}, lines: 2}, status: status}
+}
+
+type statusScreen struct {
+	decorator
+
+	status func() string
+}
+
+// Update implements tea.Model.
+func (st *statusScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) { return st, st.update(msg) }
+
+// View implements tea.Model.
+func (st *statusScreen) View() tea.View { return tea.NewView(st.Body()) }
+
+// Body implements nav.Screen: the body, a blank line, and the status.
+func (st *statusScreen) Body() string {
+	line := st.status()
+	if line == "" {
+		return st.screen().Body()
+	}
+	line = lipgloss.NewStyle().Foreground(st.Palette().ForegroundMuted).Render(line)
+	return lipgloss.JoinVertical(lipgloss.Left, st.screen().Body(), "", line)
+}
+
+// SetWidth sets the available render width.
+func (st *statusScreen) SetWidth(w int) Screen { st.setWidth(w); return st }
+
+// SetHeight sets the available body height, reserving two lines for the
+// status.
+func (st *statusScreen) SetHeight(v int) Screen { st.setHeight(v); return st }
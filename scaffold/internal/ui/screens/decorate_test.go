@@ -0,0 +1,90 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"scaffold/internal/ui/nav"
+	"scaffold/internal/ui/theme"
+	"scaffold/internal/ui/theme/themetest"
+)
+
+// plainBody is a screen that only renders its body, the kind the
+// decorators are for.
+type plainBody struct{ text string }
+
+func (p *plainBody) Init() tea.Cmd                       { return nil }
+func (p *plainBody) Update(tea.Msg) (tea.Model, tea.Cmd) { return p, nil }
+func (p *plainBody) View() tea.View                      { return tea.NewView(p.text) }
+func (p *plainBody) Body() string                        { return p.text }
+
+func TestWithHeaderAndStatus_Body(t *testing.T) {
+	count := 0
+	s := WithStatus(WithHeader(&plainBody{text: "body"}, "Title"), func() string {
+		if count == 0 {
+			return ""
+		}
+		return "3 items"
+	})
+	s.(theme.Themeable).ApplyTheme(themetest.State())
+
+	lines := strings.Split(ansi.Strip(s.Body()), "\n")
+	assert.Equal(t, []string{"Title", "", "body"}, trimRight(lines), "an empty status leaves its line out")
+
+	count = 3
+	lines = strings.Split(ansi.Strip(s.Body()), "\n")
+	assert.Equal(t, []string{"Title", "", "body", "", "3 items"}, trimRight(lines))
+}
+
+func TestDecorators_Forward(t *testing.T) {
+	notes := NewNotes("")
+	extra := key.NewBinding(key.WithKeys("f1"), key.WithHelp("f1", "about"))
+	s := WithHelp(WithHeader(notes, "Scratch"), extra)
+	s.(theme.Themeable).ApplyTheme(themetest.State())
+
+	assert.Equal(t, "notes", s.(nav.Identifiable).ScreenID())
+	assert.True(t, s.(InputCapturer).CapturingInput())
+	assert.Same(t, notes, s.(*helpScreen).Inner.(*headerScreen).Inner)
+	assert.Equal(t, "notes", s.(nav.Serializable).Route())
+
+	short := s.(KeyBinder).ShortHelp()
+	require.Len(t, short, len(notes.ShortHelp())+1)
+	assert.Equal(t, "about", short[len(short)-1].Help().Desc)
+	full := s.(KeyBinder).FullHelp()
+	assert.Equal(t, []key.Binding{extra}, full[len(full)-1])
+
+	// The header's two lines come out of the wrapped screen's height.
+	s = s.(interface{ SetHeight(int) Screen }).SetHeight(20)
+	assert.Equal(t, 18, notes.height)
+
+	// Updates reach the wrapped screen, and the decorator stays on top.
+	m, _ := s.Update(tea.KeyPressMsg{Code: 'x', Text: "x"})
+	assert.Same(t, s, m)
+	assert.Equal(t, "x", notes.Value())
+}
+
+func TestWithHeader_KeyDebug(t *testing.T) {
+	s := WithHeader(NewKeyDebug(), "Key debugger")
+	s.(theme.Themeable).ApplyTheme(themetest.State())
+
+	lines := strings.Split(ansi.Strip(s.Body()), "\n")
+	assert.Equal(t, "Key debugger", strings.TrimRight(lines[0], " "))
+	assert.Equal(t, "key-debug", s.(nav.Serializable).Route())
+	assert.True(t, s.(KeyboardEnhancer).KeyboardEnhancements().ReportEventTypes)
+
+	// A plain body has no route, so a saved session ends at it.
+	assert.Empty(t, WithHeader(&plainBody{}, "Title").(nav.Serializable).Route())
+}
+
+func trimRight(lines []string) []string {
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " ")
+	}
+	return lines
+}
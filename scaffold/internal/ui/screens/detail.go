@@ -42,6 +42,22 @@ func NewDetail(title, description, screenID string, ctx context.Context) *Detail
 	}
 }
 
+// ScreenID implements nav.Identifiable. Details are identified by the menu
+// item they show, so two items never share an ID.
+func (d *Detail) ScreenID() string { return d.screenID }
+
+// Route implements nav.Serializable.
+func (d *Detail) Route() string { return "detail" }
+
+// Params implements nav.Serializable.
+func (d *Detail) Params() map[string]string {
+	return map[string]string{
+		"title":       d.title,
+		"description": d.description,
+		"id":          d.screenID,
+	}
+}
+
 // SetWidth sets the screen width.
 func (d *Detail) SetWidth(w int) Screen {
 	d.width = w
@@ -134,12 +150,22 @@ func (d *Detail) Body() string {
 	}
 
 	content := lipgloss.JoinVertical(lipgloss.Left,
-		d.styles.Title.Render(d.title),
-		d.styles.Desc.Render(d.description),
-		d.styles.Content.Render(fmt.Sprintf("Screen ID: %s", d.screenID)),
+		d.wrap(d.styles.Title).Render(d.title),
+		d.wrap(d.styles.Desc).Render(d.description),
+		d.wrap(d.styles.Content).Render(fmt.Sprintf("Screen ID: %s", d.screenID)),
 		"Test",
-		d.styles.Info.Render("Press Esc to go back to the menu"),
+		d.wrap(d.styles.Info).Render("Press Esc to go back to the menu"),
 	)
 
 	return content
 }
+
+// wrap limits s to the screen width so long text wraps instead of being
+// clipped. Before the first size message the text is left unwrapped.
+func (d *Detail) wrap(s lipgloss.Style) lipgloss.Style {
+	if d.width <= 0 {
+		return s
+	}
+	w := d.width - 6 - s.GetHorizontalMargins()
+	return s.Width(max(w, s.GetHorizontalPadding()+s.GetHorizontalBorderSize()+1))
+}
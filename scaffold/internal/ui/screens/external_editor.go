@@ -0,0 +1,109 @@
+package screens
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"reflect"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"scaffold/config"
+	"scaffold/internal/formgen"
+)
+
+// ExternalEditedMsg carries the text of a setting edited in an external
+// editor back to the settings screen. Err is set when the editor could not
+// be run or the text could not be read back.
+type ExternalEditedMsg struct {
+	Key   string // koanf key of the edited setting
+	Value string // the setting's text as its input holds it
+	Err   error
+}
+
+// editorCommand returns the editor to run: $VISUAL, else $EDITOR, else
+// fallback, the configured editor command, else vi. It may carry arguments,
+// such as "code --wait".
+func editorCommand(fallback string) []string {
+	for _, cmd := range []string{os.Getenv("VISUAL"), os.Getenv("EDITOR"), fallback} {
+		if args := strings.Fields(cmd); len(args) > 0 {
+			return args
+		}
+	}
+	return []string{"vi"}
+}
+
+// editExternally writes fm's value to a temporary file, suspends the TUI
+// while editor edits it, and reads it back as an ExternalEditedMsg. The
+// file is removed afterwards.
+func editExternally(editor []string, fm config.FieldMeta) tea.Cmd {
+	key, text := fm.Key, editorText(fm)
+	list := fm.Value.Kind() == reflect.Slice
+	return func() tea.Msg {
+		f, err := os.CreateTemp("", strings.ReplaceAll(key, ".", "-")+"-*.txt")
+		if err != nil {
+			return ExternalEditedMsg{Key: key, Err: err}
+		}
+		path := f.Name()
+		_, err = f.WriteString(text)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			os.Remove(path)
+			return ExternalEditedMsg{Key: key, Err: err}
+		}
+		c := exec.Command(editor[0], append(editor[1:], path)...)
+		return tea.ExecProcess(c, func(err error) tea.Msg {
+			defer os.Remove(path)
+			if err != nil {
+				return ExternalEditedMsg{Key: key, Err: err}
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return ExternalEditedMsg{Key: key, Err: err}
+			}
+			value, err := inputText(string(data), list)
+			return ExternalEditedMsg{Key: key, Value: value, Err: err}
+		})()
+	}
+}
+
+// editorText returns the text an editor is given for fm: a list one item
+// per line, and any other value as its input shows it.
+func editorText(fm config.FieldMeta) string {
+	text := formgen.TextAccessor(fm.Value).Get()
+	if fm.Value.Kind() == reflect.Slice {
+		text = strings.ReplaceAll(text, ", ", "\n")
+	}
+	if text == "" {
+		return ""
+	}
+	return text + "\n"
+}
+
+// errMultiline is returned when a single-line setting comes back from the
+// editor with more than one line.
+var errMultiline = errors.New("must be a single line")
+
+// inputText turns text back from the editor into what the setting's input
+// would hold: the non-blank lines of a list joined with commas, or the
+// single line of any other value. Trailing newlines, which most editors
+// add, are dropped.
+func inputText(text string, list bool) (string, error) {
+	text = strings.TrimRight(text, "\r\n")
+	if !list {
+		if strings.ContainsAny(text, "\r\n") {
+			return "", errMultiline
+		}
+		return text, nil
+	}
+	var items []string
+	for line := range strings.Lines(text) {
+		if line = strings.TrimSpace(line); line != "" {
+			items = append(items, line)
+		}
+	}
+	return strings.Join(items, ", "), nil
+}
@@ -0,0 +1,71 @@
+package screens
+
+import (
+	"reflect"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"scaffold/config"
+	"scaffold/internal/ui/theme/themetest"
+)
+
+func TestEditorCommand(t *testing.T) {
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "")
+	assert.Equal(t, []string{"vi"}, editorCommand(""))
+	assert.Equal(t, []string{"nano"}, editorCommand("nano"))
+
+	t.Setenv("EDITOR", "code --wait")
+	assert.Equal(t, []string{"code", "--wait"}, editorCommand("nano"))
+
+	t.Setenv("VISUAL", "hx")
+	assert.Equal(t, []string{"hx"}, editorCommand("nano"))
+}
+
+func TestEditorText_RoundTrip(t *testing.T) {
+	v := struct {
+		Commands []string
+		Name     string
+	}{Commands: []string{"go vet ./...", "go test ./..."}, Name: "scaffold"}
+	rv := reflect.ValueOf(&v).Elem()
+
+	list := config.FieldMeta{Key: "commands", Value: rv.Field(0)}
+	assert.Equal(t, "go vet ./...\ngo test ./...\n", editorText(list))
+	got, err := inputText("go vet ./...\n\n  go test ./...  \ngolangci-lint run\n", true)
+	require.NoError(t, err)
+	assert.Equal(t, "go vet ./..., go test ./..., golangci-lint run", got)
+
+	name := config.FieldMeta{Key: "name", Value: rv.Field(1)}
+	assert.Equal(t, "scaffold\n", editorText(name))
+	got, err = inputText("renamed\r\n", false)
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", got)
+	_, err = inputText("two\nlines\n", false)
+	assert.ErrorIs(t, err, errMultiline)
+}
+
+func TestSettings_ExternalEdit(t *testing.T) {
+	s := NewSettings(*config.DefaultConfig())
+	s.ApplyTheme(themetest.State())
+	s.SetWidth(100)
+	for s.focusedKey() != "editor.editorCommand" {
+		_, cmd := s.Update(tea.KeyPressMsg{Code: '}', Text: "}"})
+		require.NotNil(t, cmd, "the editor group is reached")
+		s.Update(cmd())
+	}
+
+	// Text that fails validation leaves the setting as it was.
+	_, cmd := s.Update(ExternalEditedMsg{Key: "editor.editorCommand", Value: ""})
+	assert.NotNil(t, cmd, "the error is reported")
+	assert.Equal(t, "vim", s.cfg.Editor.EditorCommand)
+
+	s.Update(ExternalEditedMsg{Key: "editor.editorCommand", Value: "nano"})
+	assert.Equal(t, "nano", s.cfg.Editor.EditorCommand)
+
+	// An edit for a field that is no longer focused is dropped.
+	s.Update(ExternalEditedMsg{Key: "ui.dateFormat", Value: "02/01/2006"})
+	assert.Equal(t, "2006-01-02", s.cfg.UI.DateFormat)
+}
@@ -0,0 +1,177 @@
+package screens
+
+import (
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"scaffold/internal/features"
+	"scaffold/internal/ui/theme"
+)
+
+type featureFlagsKeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Toggle key.Binding
+	Reset  key.Binding
+}
+
+func defaultFeatureFlagsKeyMap() featureFlagsKeyMap {
+	return featureFlagsKeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		Toggle: key.NewBinding(
+			key.WithKeys("space", "enter"),
+			key.WithHelp("space", "toggle"),
+		),
+		Reset: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "reset all"),
+		),
+	}
+}
+
+// FeatureFlags lists the feature flags with their values and where each
+// value came from, and toggles them at runtime. It is not in the menu: open
+// it with f9 or --screen features. Changes last until exit; the config
+// file's "features" map or $SCAFFOLD_FEATURES make them stick.
+type FeatureFlags struct {
+	theme.ThemeAware
+
+	flags  []*features.Flag
+	cursor int
+	keys   featureFlagsKeyMap
+	width  int
+}
+
+// NewFeatureFlags creates the feature flags screen.
+func NewFeatureFlags() *FeatureFlags {
+	return &FeatureFlags{
+		flags: features.All(),
+		keys:  defaultFeatureFlagsKeyMap(),
+	}
+}
+
+// ScreenID implements nav.Identifiable.
+func (f *FeatureFlags) ScreenID() string { return "features" }
+
+// Route implements nav.Serializable.
+func (f *FeatureFlags) Route() string { return "features" }
+
+// Params implements nav.Serializable.
+func (f *FeatureFlags) Params() map[string]string { return nil }
+
+// SetWidth sets the width descriptions wrap at.
+func (f *FeatureFlags) SetWidth(w int) Screen {
+	f.width = w
+	return f
+}
+
+// ApplyTheme implements theme.Themeable.
+func (f *FeatureFlags) ApplyTheme(state theme.State) {
+	f.ApplyThemeState(state)
+}
+
+// Init is a no-op.
+func (f *FeatureFlags) Init() tea.Cmd { return nil }
+
+// Update moves the cursor and toggles or resets flags, reporting each
+// change with a features.ChangedMsg.
+func (f *FeatureFlags) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyPressMsg)
+	if !ok || len(f.flags) == 0 {
+		return f, nil
+	}
+	switch {
+	case key.Matches(keyMsg, f.keys.Up):
+		f.cursor = (f.cursor - 1 + len(f.flags)) % len(f.flags)
+	case key.Matches(keyMsg, f.keys.Down):
+		f.cursor = (f.cursor + 1) % len(f.flags)
+	case key.Matches(keyMsg, f.keys.Toggle):
+		flag := f.flags[f.cursor]
+		on := !flag.Enabled()
+		if err := features.Set(flag.Name(), on, features.SourceRuntime); err != nil {
+			return f, nil
+		}
+		return f, changedCmd(flag, on)
+	case key.Matches(keyMsg, f.keys.Reset):
+		var cmds []tea.Cmd
+		was := make([]bool, len(f.flags))
+		for i, flag := range f.flags {
+			was[i] = flag.Enabled()
+		}
+		features.Reset()
+		for i, flag := range f.flags {
+			if flag.Enabled() != was[i] {
+				cmds = append(cmds, changedCmd(flag, flag.Enabled()))
+			}
+		}
+		return f, tea.Batch(cmds...)
+	}
+	return f, nil
+}
+
+func changedCmd(flag *features.Flag, on bool) tea.Cmd {
+	return func() tea.Msg { return features.ChangedMsg{Flag: flag, Enabled: on} }
+}
+
+// View satisfies tea.Model.
+func (f *FeatureFlags) View() tea.View { return tea.NewView(f.Body()) }
+
+// Body returns the renderable content for layout composition.
+func (f *FeatureFlags) Body() string {
+	p := f.Palette()
+	title := lipgloss.NewStyle().Bold(true).Foreground(p.Primary).Render("Feature flags")
+	muted := lipgloss.NewStyle().Foreground(p.ForegroundMuted)
+	if f.width > 0 {
+		muted = muted.Width(max(f.width-6, 1))
+	}
+	lines := []string{title, muted.Render("Experimental subsystems. Changes last until exit."), ""}
+	if len(f.flags) == 0 {
+		return strings.Join(append(lines, muted.Render("No flags defined.")), "\n")
+	}
+
+	nameW := 0
+	for _, flag := range f.flags {
+		nameW = max(nameW, lipgloss.Width(flag.Name()))
+	}
+	on := lipgloss.NewStyle().Foreground(p.Success)
+	off := lipgloss.NewStyle().Foreground(p.ForegroundMuted)
+	for i, flag := range f.flags {
+		cursor, name := "  ", lipgloss.NewStyle().Width(nameW).Render(flag.Name())
+		if i == f.cursor {
+			cursor = lipgloss.NewStyle().Foreground(p.Primary).Render("› ")
+			name = lipgloss.NewStyle().Bold(true).Foreground(p.Foreground).Width(nameW).Render(flag.Name())
+		}
+		state := off.Render("[ ] off")
+		if flag.Enabled() {
+			state = on.Render("[x] on ")
+		}
+		row := cursor + state + "  " + name + "  " + muted.UnsetWidth().Render(flag.Source().String())
+		if f.width > 0 {
+			row = ansi.Truncate(row, max(f.width-6, 1), "…")
+		}
+		lines = append(lines, row)
+	}
+	lines = append(lines, "", muted.Render(f.flags[f.cursor].Desc()))
+	return strings.Join(lines, "\n")
+}
+
+// ShortHelp returns key bindings for the help bar.
+func (f *FeatureFlags) ShortHelp() []key.Binding {
+	return []key.Binding{f.keys.Toggle, f.keys.Reset}
+}
+
+// FullHelp returns grouped key bindings for the expanded help bar.
+func (f *FeatureFlags) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{f.keys.Up, f.keys.Down}, {f.keys.Toggle, f.keys.Reset}}
+}
@@ -0,0 +1,63 @@
+package screens
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"scaffold/internal/features"
+	"scaffold/internal/ui/theme/themetest"
+)
+
+func newTestFeatureFlags(t *testing.T) *FeatureFlags {
+	t.Helper()
+	t.Cleanup(features.Reset)
+	f := NewFeatureFlags()
+	f.ApplyTheme(themetest.State())
+	return f
+}
+
+// cursorTo moves the cursor to the flag named name.
+func cursorTo(t *testing.T, f *FeatureFlags, name string) {
+	t.Helper()
+	for range f.flags {
+		if f.flags[f.cursor].Name() == name {
+			return
+		}
+		f.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	}
+	t.Fatalf("flag %s not listed", name)
+}
+
+func TestFeatureFlags_ToggleReportsChange(t *testing.T) {
+	f := newTestFeatureFlags(t)
+	cursorTo(t, f, "animated-banner")
+
+	_, cmd := f.Update(tea.KeyPressMsg{Code: tea.KeySpace})
+	require.NotNil(t, cmd)
+	assert.Equal(t, features.ChangedMsg{Flag: features.AnimatedBanner, Enabled: true}, cmd())
+	assert.True(t, features.AnimatedBanner.Enabled())
+
+	body := ansi.Strip(f.Body())
+	assert.Contains(t, body, "› [x] on   animated-banner   runtime")
+	assert.Contains(t, body, features.AnimatedBanner.Desc())
+}
+
+func TestFeatureFlags_ResetReportsOnlyChanges(t *testing.T) {
+	f := newTestFeatureFlags(t)
+	require.NoError(t, features.Set("transitions", false, features.SourceRuntime))
+
+	_, cmd := f.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	require.NotNil(t, cmd)
+	assert.Equal(t, features.ChangedMsg{Flag: features.Transitions, Enabled: true}, cmd())
+	assert.True(t, features.Transitions.Enabled())
+}
+
+func TestFeatureFlags_CursorWraps(t *testing.T) {
+	f := newTestFeatureFlags(t)
+	f.Update(tea.KeyPressMsg{Code: tea.KeyUp})
+	assert.Equal(t, len(f.flags)-1, f.cursor)
+}
@@ -0,0 +1,233 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textarea"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"scaffold/internal/ui/nav"
+	"scaffold/internal/ui/theme"
+)
+
+// FieldEditedMsg carries the text confirmed in a FieldEditor back to the
+// screen that opened it.
+type FieldEditedMsg struct {
+	Key   string // koanf key of the edited setting
+	Value string
+}
+
+type fieldEditorKeyMap struct {
+	Confirm    key.Binding
+	Cancel     key.Binding
+	NextFocus  key.Binding
+	ReplaceAll key.Binding
+}
+
+// editorFocus identifies which input of a FieldEditor receives keys.
+type editorFocus int
+
+const (
+	focusText editorFocus = iota
+	focusFind
+	focusReplace
+	focusCount
+)
+
+// FieldEditor is a presented screen for editing a long string setting in a
+// textarea, with find/replace and a character count. Newlines are not
+// allowed, since the underlying settings are single-line.
+type FieldEditor struct {
+	theme.ThemeAware
+
+	key     string
+	label   string
+	text    textarea.Model
+	find    textinput.Model
+	replace textinput.Model
+	focus   editorFocus
+	keys    fieldEditorKeyMap
+	width   int
+}
+
+// NewFieldEditor creates an editor for the setting at key, pre-filled with
+// value.
+func NewFieldEditor(key, label, value string) *FieldEditor {
+	text := textarea.New()
+	text.ShowLineNumbers = false
+	text.CharLimit = 0
+	text.SetHeight(5)
+	text.KeyMap.InsertNewline.SetEnabled(false)
+	text.SetValue(value)
+	text.Focus()
+
+	find := textinput.New()
+	find.Prompt = "Find:    "
+	replace := textinput.New()
+	replace.Prompt = "Replace: "
+
+	return &FieldEditor{
+		key:     key,
+		label:   label,
+		text:    text,
+		find:    find,
+		replace: replace,
+		keys:    defaultFieldEditorKeyMap(),
+	}
+}
+
+func defaultFieldEditorKeyMap() fieldEditorKeyMap {
+	return fieldEditorKeyMap{
+		Confirm: fieldEditorBinding("ctrl+s", "confirm"),
+		Cancel:  fieldEditorBinding("esc", "cancel"),
+		NextFocus: key.NewBinding(
+			key.WithKeys("tab", "shift+tab"),
+			key.WithHelp("tab", "text/find/replace"),
+		),
+		ReplaceAll: fieldEditorBinding("ctrl+r", "replace all"),
+	}
+}
+
+func fieldEditorBinding(k, help string) key.Binding {
+	return key.NewBinding(key.WithKeys(k), key.WithHelp(k, help))
+}
+
+// ScreenID implements nav.Identifiable.
+func (e *FieldEditor) ScreenID() string { return "field-editor" }
+
+// CapturingInput implements InputCapturer.
+func (e *FieldEditor) CapturingInput() bool { return true }
+
+// Value returns the text being edited.
+func (e *FieldEditor) Value() string { return e.text.Value() }
+
+// SetWidth sizes the popup to two thirds of the terminal.
+func (e *FieldEditor) SetWidth(w int) Screen {
+	e.width = max(w*2/3, 30)
+	inner := e.width - 4 // border and padding
+	e.text.SetWidth(inner)
+	e.find.SetWidth(inner - lipgloss.Width(e.find.Prompt))
+	e.replace.SetWidth(inner - lipgloss.Width(e.replace.Prompt))
+	return e
+}
+
+// ApplyTheme implements theme.Themeable.
+func (e *FieldEditor) ApplyTheme(state theme.State) {
+	e.ApplyThemeState(state)
+	p := state.Palette
+	s := textarea.DefaultStyles(state.IsDark)
+	s.Focused.Text = s.Focused.Text.Foreground(p.Foreground)
+	s.Focused.CursorLine = s.Focused.CursorLine.UnsetBackground().Foreground(p.Foreground)
+	s.Focused.Prompt = s.Focused.Prompt.Foreground(p.Primary)
+	s.Cursor.Color = p.Primary
+	e.text.SetStyles(s)
+}
+
+// Init starts the cursor blinking.
+func (e *FieldEditor) Init() tea.Cmd { return textarea.Blink }
+
+// Update handles editing, focus cycling, replace-all, and confirm/cancel.
+func (e *FieldEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		switch {
+		case key.Matches(keyMsg, e.keys.Confirm):
+			edited := FieldEditedMsg{Key: e.key, Value: e.text.Value()}
+			return e, tea.Batch(func() tea.Msg { return edited }, nav.Dismiss())
+		case key.Matches(keyMsg, e.keys.Cancel):
+			return e, nav.Dismiss()
+		case key.Matches(keyMsg, e.keys.NextFocus):
+			step := editorFocus(1)
+			if keyMsg.String() == "shift+tab" {
+				step = focusCount - 1
+			}
+			return e, e.setFocus((e.focus + step) % focusCount)
+		case key.Matches(keyMsg, e.keys.ReplaceAll):
+			e.replaceAll()
+			return e, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	switch e.focus {
+	case focusText:
+		e.text, cmd = e.text.Update(msg)
+	case focusFind:
+		e.find, cmd = e.find.Update(msg)
+	case focusReplace:
+		e.replace, cmd = e.replace.Update(msg)
+	}
+	return e, cmd
+}
+
+func (e *FieldEditor) setFocus(f editorFocus) tea.Cmd {
+	e.focus = f
+	e.text.Blur()
+	e.find.Blur()
+	e.replace.Blur()
+	switch f {
+	case focusFind:
+		return e.find.Focus()
+	case focusReplace:
+		return e.replace.Focus()
+	default:
+		return e.text.Focus()
+	}
+}
+
+// replaceAll substitutes every occurrence of the find text. An empty find
+// text is a no-op rather than inserting between every character.
+func (e *FieldEditor) replaceAll() {
+	if e.find.Value() == "" {
+		return
+	}
+	e.text.SetValue(strings.ReplaceAll(e.text.Value(), e.find.Value(), e.replace.Value()))
+}
+
+// View satisfies tea.Model.
+func (e *FieldEditor) View() tea.View { return tea.NewView(e.Body()) }
+
+// Body renders the editor as a bordered popup.
+func (e *FieldEditor) Body() string {
+	p := e.Palette()
+	title := lipgloss.NewStyle().Bold(true).Foreground(p.Primary).Render("Edit " + e.label)
+	muted := lipgloss.NewStyle().Foreground(p.ForegroundSubtle)
+
+	value := e.text.Value()
+	info := fmt.Sprintf("%d characters", utf8.RuneCountInString(value))
+	if f := e.find.Value(); f != "" {
+		info += fmt.Sprintf(" · %d matches", strings.Count(value, f))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		title,
+		"",
+		e.text.View(),
+		muted.Render(info),
+		"",
+		e.find.View(),
+		e.replace.View(),
+		"",
+		muted.Render("ctrl+s confirm · esc cancel · tab switch · ctrl+r replace all"),
+	)
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(p.Primary).
+		Padding(0, 1).
+		Width(e.width).
+		Render(content)
+}
+
+// ShortHelp returns key bindings for the help bar.
+func (e *FieldEditor) ShortHelp() []key.Binding {
+	return []key.Binding{e.keys.Confirm, e.keys.Cancel, e.keys.NextFocus, e.keys.ReplaceAll}
+}
+
+// FullHelp returns grouped key bindings for the expanded help bar.
+func (e *FieldEditor) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{e.keys.Confirm, e.keys.Cancel}, {e.keys.NextFocus, e.keys.ReplaceAll}}
+}
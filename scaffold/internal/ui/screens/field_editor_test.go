@@ -0,0 +1,58 @@
+package screens
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func typeInto(e *FieldEditor, s string) {
+	for _, r := range s {
+		e.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+}
+
+func TestFieldEditor_ReplaceAll(t *testing.T) {
+	e := NewFieldEditor("editor.command", "Command", "vim $FILE && vim $FILE")
+
+	e.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	typeInto(e, "vim")
+	e.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	typeInto(e, "nvim")
+	e.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+
+	assert.Equal(t, "nvim $FILE && nvim $FILE", e.Value())
+}
+
+func TestFieldEditor_ReplaceAll_EmptyFindIsNoOp(t *testing.T) {
+	e := NewFieldEditor("k", "K", "abc")
+
+	e.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+
+	assert.Equal(t, "abc", e.Value())
+}
+
+func TestFieldEditor_Confirm_SendsEdit(t *testing.T) {
+	e := NewFieldEditor("k", "K", "ab")
+	typeInto(e, "c")
+
+	_, cmd := e.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	require.NotNil(t, cmd)
+	batch, ok := cmd().(tea.BatchMsg)
+	require.True(t, ok, "confirm should send the edit and dismiss")
+
+	assert.Equal(t, FieldEditedMsg{Key: "k", Value: "abc"}, batch[0]())
+}
+
+func TestFieldEditor_Body_ShowsCounts(t *testing.T) {
+	e := NewFieldEditor("k", "K", "aXbXc")
+	e.SetWidth(90)
+	e.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	typeInto(e, "X")
+
+	body := e.Body()
+	assert.Contains(t, body, "5 characters")
+	assert.Contains(t, body, "2 matches")
+}
@@ -0,0 +1,287 @@
+package screens
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"banner"
+	"scaffold/internal/ui/theme"
+)
+
+// fontPreviewMinWidth is the narrowest preview shown beside the font list;
+// narrower screens show the preview below the selected font's name.
+const fontPreviewMinWidth = 40
+
+type fontCatalogKeyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	Top      key.Binding
+	Bottom   key.Binding
+	Gradient key.Binding
+	Safe     key.Binding
+	Copy     key.Binding
+	Back     key.Binding
+}
+
+func defaultFontCatalogKeyMap() fontCatalogKeyMap {
+	return fontCatalogKeyMap{
+		Up:       key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+		Down:     key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+		Top:      key.NewBinding(key.WithKeys("home"), key.WithHelp("home", "first")),
+		Bottom:   key.NewBinding(key.WithKeys("end"), key.WithHelp("end", "last")),
+		Gradient: key.NewBinding(key.WithKeys("g"), key.WithHelp("g", "next gradient")),
+		Safe:     key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "safe fonts only")),
+		Copy:     key.NewBinding(key.WithKeys("c", "y"), key.WithHelp("c", "copy font name")),
+		Back:     key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	}
+}
+
+// FontCatalog lists the figlet fonts embedded in the banner package with a
+// live preview of the selected one, so a banner font can be picked by eye.
+// The preview starts in the theme's gradient and g cycles through the
+// predefined ones; s narrows the list to banner.SafeFonts, the pool random
+// banners are picked from. The font name can be copied for use in
+// banner.Config.
+type FontCatalog struct {
+	theme.ThemeAware
+
+	text      string // previewed text
+	fonts     []string
+	safe      bool // fonts is banner.SafeFonts rather than every font
+	gradients []banner.Gradient
+	gradient  int // -1 for the theme's gradient, else an index into gradients
+	cursor    int
+	offset    int // first font shown in the list
+	width     int
+	height    int
+	keys      fontCatalogKeyMap
+}
+
+// NewFontCatalog creates the font catalog previewing text, such as the app
+// name.
+func NewFontCatalog(text string) *FontCatalog {
+	return &FontCatalog{
+		text:      text,
+		fonts:     banner.Fonts(),
+		gradients: banner.Gradients(),
+		gradient:  -1,
+		keys:      defaultFontCatalogKeyMap(),
+	}
+}
+
+// ScreenID implements nav.Identifiable.
+func (f *FontCatalog) ScreenID() string { return "fonts" }
+
+// Route implements nav.Serializable.
+func (f *FontCatalog) Route() string { return "fonts" }
+
+// Params implements nav.Serializable.
+func (f *FontCatalog) Params() map[string]string { return nil }
+
+// SetWidth sets the width the list and preview share.
+func (f *FontCatalog) SetWidth(w int) Screen {
+	f.width = w
+	return f
+}
+
+// SetHeight sets the body height, which bounds the list.
+func (f *FontCatalog) SetHeight(h int) Screen {
+	f.height = h
+	f.scroll()
+	return f
+}
+
+// ApplyTheme implements theme.Themeable.
+func (f *FontCatalog) ApplyTheme(state theme.State) {
+	f.ApplyThemeState(state)
+}
+
+// Init is a no-op.
+func (f *FontCatalog) Init() tea.Cmd { return nil }
+
+// Selected returns the name of the selected font.
+func (f *FontCatalog) Selected() string {
+	if len(f.fonts) == 0 {
+		return ""
+	}
+	return f.fonts[f.cursor]
+}
+
+// Update moves the selection, cycles the gradient and copies the font name.
+func (f *FontCatalog) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyPressMsg)
+	if !ok || len(f.fonts) == 0 {
+		return f, nil
+	}
+	switch {
+	case key.Matches(keyMsg, f.keys.Up):
+		f.cursor = (f.cursor - 1 + len(f.fonts)) % len(f.fonts)
+	case key.Matches(keyMsg, f.keys.Down):
+		f.cursor = (f.cursor + 1) % len(f.fonts)
+	case key.Matches(keyMsg, f.keys.Top):
+		f.cursor = 0
+	case key.Matches(keyMsg, f.keys.Bottom):
+		f.cursor = len(f.fonts) - 1
+	case key.Matches(keyMsg, f.keys.Gradient):
+		f.gradient++
+		if f.gradient >= len(f.gradients) {
+			f.gradient = -1
+		}
+	case key.Matches(keyMsg, f.keys.Safe):
+		f.toggleSafe()
+	case key.Matches(keyMsg, f.keys.Copy):
+		name := f.Selected()
+		return f, func() tea.Msg { return CopyToClipboardMsg{Text: name, Label: "Font name"} }
+	case key.Matches(keyMsg, f.keys.Back):
+		return f, func() tea.Msg { return BackMsg{} }
+	}
+	f.scroll()
+	return f, nil
+}
+
+// toggleSafe switches the list between every font and the safe ones,
+// keeping the selected font when it is in both.
+func (f *FontCatalog) toggleSafe() {
+	selected := f.Selected()
+	f.safe = !f.safe
+	if f.safe {
+		f.fonts = banner.SafeFonts
+		f.keys.Safe.SetHelp("s", "all fonts")
+	} else {
+		f.fonts = banner.Fonts()
+		f.keys.Safe.SetHelp("s", "safe fonts only")
+	}
+	f.cursor = max(slices.Index(f.fonts, selected), 0)
+}
+
+// rows returns how many fonts the list shows: the body less the title lines.
+func (f *FontCatalog) rows() int {
+	if f.height <= 0 {
+		return 10
+	}
+	return max(f.height-3, 3)
+}
+
+// scroll moves the list window so the selected font is in it.
+func (f *FontCatalog) scroll() {
+	rows := f.rows()
+	switch {
+	case f.cursor < f.offset:
+		f.offset = f.cursor
+	case f.cursor >= f.offset+rows:
+		f.offset = f.cursor - rows + 1
+	}
+	f.offset = max(min(f.offset, len(f.fonts)-rows), 0)
+}
+
+// currentGradient returns the gradient the preview is drawn in.
+func (f *FontCatalog) currentGradient() *banner.Gradient {
+	if f.gradient >= 0 {
+		g := f.gradients[f.gradient]
+		return &g
+	}
+	p := f.Palette()
+	if p.Primary == nil { // not themed yet
+		p = theme.NewPalette(f.ThemeName(), f.IsDark())
+	}
+	return banner.GradientFromColors("theme", theme.Ramp(p, 7))
+}
+
+// View satisfies tea.Model.
+func (f *FontCatalog) View() tea.View { return tea.NewView(f.Body()) }
+
+// Body returns the renderable content for layout composition.
+func (f *FontCatalog) Body() string {
+	p := f.Palette()
+	title := lipgloss.NewStyle().Bold(true).Foreground(p.Primary).Render("Fonts")
+	muted := lipgloss.NewStyle().Foreground(p.ForegroundMuted)
+	if len(f.fonts) == 0 {
+		return title + "\n\n" + muted.Render("No fonts are embedded.")
+	}
+	grad := f.currentGradient()
+	noun := "fonts"
+	if f.safe {
+		noun = "safe fonts"
+	}
+	summary := muted.Render(fmt.Sprintf("%d %s · gradient: %s", len(f.fonts), noun, grad.Name))
+
+	width := f.width
+	if width <= 0 {
+		width = 80
+	}
+	listW := 0
+	for _, name := range f.fonts {
+		listW = max(listW, lipgloss.Width(name))
+	}
+	listW += 4 // cursor and gap
+
+	if width-listW < fontPreviewMinWidth {
+		name := lipgloss.NewStyle().Bold(true).Foreground(p.Foreground).
+			Render(fmt.Sprintf("‹ %s ›", f.Selected()))
+		counter := muted.Render(fmt.Sprintf("  %d/%d", f.cursor+1, len(f.fonts)))
+		return strings.Join([]string{title, summary, "", name + counter, "", f.preview(grad, width)}, "\n")
+	}
+
+	list := lipgloss.NewStyle().Width(listW).Render(f.list())
+	preview := f.preview(grad, width-listW)
+	return strings.Join([]string{title, summary, "", lipgloss.JoinHorizontal(lipgloss.Top, list, preview)}, "\n")
+}
+
+// list renders the window of font names around the selection.
+func (f *FontCatalog) list() string {
+	p := f.Palette()
+	normal := lipgloss.NewStyle().Foreground(p.Foreground)
+	selected := lipgloss.NewStyle().Bold(true).Foreground(p.Primary)
+	end := min(f.offset+f.rows(), len(f.fonts))
+	lines := make([]string, 0, end-f.offset)
+	for i := f.offset; i < end; i++ {
+		if i == f.cursor {
+			lines = append(lines, selected.Render("› "+f.fonts[i]))
+			continue
+		}
+		lines = append(lines, normal.Render("  "+f.fonts[i]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// preview renders the previewed text in the selected font, cut to width.
+func (f *FontCatalog) preview(grad *banner.Gradient, width int) string {
+	parser := "terminal-color"
+	if theme.Monochrome() {
+		parser = "terminal"
+	}
+	art, err := banner.Render(banner.Config{
+		Text:     f.text,
+		Font:     f.Selected(),
+		Width:    max(width, 20),
+		Gradient: grad,
+		Parser:   parser,
+	})
+	if err != nil {
+		return lipgloss.NewStyle().Foreground(f.Palette().Error).Render("Cannot render: " + err.Error())
+	}
+	lines := strings.Split(strings.TrimRight(art, "\n"), "\n")
+	for i, l := range lines {
+		lines[i] = ansi.Truncate(l, width, "")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ShortHelp returns key bindings for the help bar.
+func (f *FontCatalog) ShortHelp() []key.Binding {
+	return []key.Binding{f.keys.Up, f.keys.Down, f.keys.Gradient, f.keys.Copy, f.keys.Back}
+}
+
+// FullHelp returns grouped key bindings for the expanded help bar.
+func (f *FontCatalog) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{f.keys.Up, f.keys.Down, f.keys.Top, f.keys.Bottom},
+		{f.keys.Gradient, f.keys.Safe, f.keys.Copy, f.keys.Back},
+	}
+}
@@ -0,0 +1,89 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"banner"
+	"scaffold/internal/ui/theme/themetest"
+)
+
+func newTestFontCatalog() *FontCatalog {
+	f := NewFontCatalog("Hi")
+	f.ApplyTheme(themetest.State())
+	f.SetWidth(100)
+	f.SetHeight(12)
+	return f
+}
+
+func TestFontCatalog_MovesAndScrolls(t *testing.T) {
+	f := newTestFontCatalog()
+	require.NotEmpty(t, f.fonts)
+	first := f.Selected()
+
+	f.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	assert.Equal(t, f.fonts[1], f.Selected())
+
+	f.Update(tea.KeyPressMsg{Code: tea.KeyUp})
+	f.Update(tea.KeyPressMsg{Code: tea.KeyUp})
+	assert.Equal(t, f.fonts[len(f.fonts)-1], f.Selected(), "up from the first font wraps")
+	assert.Contains(t, ansi.Strip(f.Body()), "› "+f.Selected(), "the list scrolls to the selection")
+	assert.NotContains(t, ansi.Strip(f.Body()), "› "+first)
+}
+
+func TestFontCatalog_CyclesGradients(t *testing.T) {
+	f := newTestFontCatalog()
+	assert.Contains(t, ansi.Strip(f.Body()), "gradient: theme")
+
+	f.Update(tea.KeyPressMsg{Code: 'g', Text: "g"})
+	assert.Contains(t, ansi.Strip(f.Body()), "gradient: "+f.gradients[0].Name)
+
+	for range f.gradients {
+		f.Update(tea.KeyPressMsg{Code: 'g', Text: "g"})
+	}
+	assert.Contains(t, ansi.Strip(f.Body()), "gradient: theme", "cycling comes back to the theme")
+}
+
+func TestFontCatalog_SafeFontsOnly(t *testing.T) {
+	f := newTestFontCatalog()
+	all := len(f.fonts)
+	for f.Selected() != "slant" {
+		f.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	}
+
+	f.Update(tea.KeyPressMsg{Code: 's', Text: "s"})
+	assert.Equal(t, banner.SafeFonts, f.fonts)
+	assert.Equal(t, "slant", f.Selected(), "a safe selection is kept")
+	assert.Contains(t, ansi.Strip(f.Body()), fmt.Sprintf("%d safe fonts", len(banner.SafeFonts)))
+
+	f.Update(tea.KeyPressMsg{Code: 's', Text: "s"})
+	assert.Len(t, f.fonts, all)
+	assert.Equal(t, "slant", f.Selected())
+}
+
+func TestFontCatalog_CopiesFontName(t *testing.T) {
+	f := newTestFontCatalog()
+	f.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+
+	_, cmd := f.Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(CopyToClipboardMsg)
+	require.True(t, ok)
+	assert.Equal(t, f.fonts[1], msg.Text)
+}
+
+func TestFontCatalog_NarrowShowsPreviewBelow(t *testing.T) {
+	f := newTestFontCatalog()
+	f.SetWidth(40)
+	body := ansi.Strip(f.Body())
+	assert.Contains(t, body, "‹ "+f.Selected()+" ›")
+	for line := range strings.SplitSeq(body, "\n") {
+		assert.LessOrEqual(t, ansi.StringWidth(line), 40)
+	}
+}
@@ -2,18 +2,19 @@
 package screens
 
 import (
+	"slices"
+
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
 
 	"scaffold/internal/ui/menu"
+	"scaffold/internal/ui/nav"
 	"scaffold/internal/ui/theme"
 )
 
 // Screen is the interface for screen components that can be composed.
-type Screen interface {
-	tea.Model
-	Body() string // Returns body content for layout composition
-}
+// It aliases nav.Screen so screens can be pushed onto the navigation stack.
+type Screen = nav.Screen
 
 // KeyBinder is an optional interface for screens that provide key bindings.
 type KeyBinder interface {
@@ -21,6 +22,13 @@ type KeyBinder interface {
 	FullHelp() [][]key.Binding
 }
 
+// InputCapturer is an optional interface for screens that consume plain
+// keystrokes as text. While the active screen reports true, single-key global
+// bindings such as q are passed through instead of being acted on.
+type InputCapturer interface {
+	CapturingInput() bool
+}
+
 // Home is the home screen with a menu.
 type Home struct {
 	theme.ThemeAware
@@ -34,16 +42,39 @@ type Home struct {
 func NewHome() *Home {
 	m := menu.New()
 	m = m.SetItems([]menu.Item{
-		menu.NewItem("Dashboard", "View application dashboard", "dashboard"),
-		menu.NewItem("Settings", "Configure application settings", "settings"),
-		menu.NewItem("Profile", "Manage your profile", "profile"),
-		menu.NewItem("About", "About this application", "about"),
+		menu.NewItem("Dashboard", "View application dashboard", "dashboard").
+			WithIcon("📊", "#").WithShortcut("d"),
+		menu.NewItem("Settings", "Configure application settings", "settings").
+			WithIcon("🔧", "*").WithShortcut("s"),
+		menu.NewItem("Theme Editor", "Customize the current theme's colors", "theme-editor").
+			WithIcon("🎨", "%").WithShortcut("t"),
+		menu.NewItem("Fonts", "Preview the banner fonts", "fonts").
+			WithIcon("🔤", "&").WithShortcut("f"),
+		menu.NewItem("Profile", "Manage your profile", "profile").
+			WithIcon("👤", "@").WithShortcut("p"),
+		menu.NewItem("About", "About this application", "about").
+			WithIcon("📖", "?").WithShortcut("a"),
 	})
 	return &Home{
 		menu: m,
 	}
 }
 
+// AddItems appends items, such as those contributed by plugins, to the menu.
+// Items whose screen ID is already listed are skipped.
+func (h *Home) AddItems(items ...menu.Item) {
+	current := h.menu.Items()
+	for _, item := range items {
+		if !slices.ContainsFunc(current, func(i menu.Item) bool { return i.ScreenID() == item.ScreenID() }) {
+			current = append(current, item)
+		}
+	}
+	h.menu = h.menu.SetItems(current)
+	if h.width > 0 {
+		h.SetWidth(h.width) // the menu's height follows its item count
+	}
+}
+
 // SetWidth sets the screen width.
 func (h *Home) SetWidth(w int) Screen {
 	h.width = w
@@ -62,6 +93,15 @@ func (h *Home) ApplyTheme(state theme.State) {
 	h.menu.ApplyTheme(state)
 }
 
+// ScreenID implements nav.Identifiable.
+func (h *Home) ScreenID() string { return "home" }
+
+// Route implements nav.Serializable.
+func (h *Home) Route() string { return "home" }
+
+// Params implements nav.Serializable.
+func (h *Home) Params() map[string]string { return nil }
+
 // Init initializes the home screen.
 func (h *Home) Init() tea.Cmd {
 	return nil
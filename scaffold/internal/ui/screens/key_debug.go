@@ -0,0 +1,217 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"scaffold/internal/ui/theme"
+)
+
+// keyDebugHistory is how many key events the key debug screen keeps.
+const keyDebugHistory = 12
+
+// KeyboardEnhancer is an optional interface for screens that need keyboard
+// enhancements from the terminal, such as key release events, while they are
+// active.
+type KeyboardEnhancer interface {
+	KeyboardEnhancements() tea.KeyboardEnhancements
+}
+
+// keyEvent is one recorded key press or release.
+type keyEvent struct {
+	release bool
+	key     tea.Key
+	str     string
+}
+
+// KeyDebug shows the raw fields of every key event the terminal sends, so
+// users can see why a binding does not fire: which code and modifiers
+// arrived, whether text was attached, and which keyboard enhancements the
+// terminal supports. All keys are captured; pressing esc twice in a row goes
+// back, and ctrl+c still quits.
+type KeyDebug struct {
+	theme.ThemeAware
+
+	events     []keyEvent // newest first
+	enhanced   *tea.KeyboardEnhancementsMsg
+	pendingEsc bool
+	back       key.Binding
+}
+
+// NewKeyDebug creates the key debug screen.
+func NewKeyDebug() *KeyDebug {
+	return &KeyDebug{
+		back: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc esc", "back")),
+	}
+}
+
+// ScreenID implements nav.Identifiable.
+func (k *KeyDebug) ScreenID() string { return "key-debug" }
+
+// Route implements nav.Serializable.
+func (k *KeyDebug) Route() string { return "key-debug" }
+
+// Params implements nav.Serializable.
+func (k *KeyDebug) Params() map[string]string { return nil }
+
+// CapturingInput implements InputCapturer: every key is data here.
+func (k *KeyDebug) CapturingInput() bool { return true }
+
+// KeyboardEnhancements implements KeyboardEnhancer, asking for release
+// events so they can be shown too.
+func (k *KeyDebug) KeyboardEnhancements() tea.KeyboardEnhancements {
+	return tea.KeyboardEnhancements{ReportEventTypes: true}
+}
+
+// ApplyTheme implements theme.Themeable.
+func (k *KeyDebug) ApplyTheme(state theme.State) {
+	k.ApplyThemeState(state)
+}
+
+// Init is a no-op.
+func (k *KeyDebug) Init() tea.Cmd { return nil }
+
+// Update records key events and keyboard enhancement reports.
+func (k *KeyDebug) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyboardEnhancementsMsg:
+		k.enhanced = &msg
+	case tea.KeyPressMsg:
+		k.record(keyEvent{key: msg.Key(), str: msg.String()})
+		if key.Matches(msg, k.back) {
+			if k.pendingEsc {
+				return k, func() tea.Msg { return BackMsg{} }
+			}
+			k.pendingEsc = true
+			return k, nil
+		}
+		k.pendingEsc = false
+	case tea.KeyReleaseMsg:
+		k.record(keyEvent{release: true, key: msg.Key(), str: msg.String()})
+	}
+	return k, nil
+}
+
+func (k *KeyDebug) record(ev keyEvent) {
+	k.events = append([]keyEvent{ev}, k.events...)
+	if len(k.events) > keyDebugHistory {
+		k.events = k.events[:keyDebugHistory]
+	}
+}
+
+// View satisfies tea.Model.
+func (k *KeyDebug) View() tea.View { return tea.NewView(k.Body()) }
+
+// Body returns the renderable content for layout composition.
+func (k *KeyDebug) Body() string {
+	p := k.Palette()
+	muted := lipgloss.NewStyle().Foreground(p.ForegroundMuted)
+	label := lipgloss.NewStyle().Foreground(p.ForegroundMuted).Width(13)
+
+	lines := []string{muted.Render(k.enhancementsLine()), ""}
+	if len(k.events) == 0 {
+		lines = append(lines, muted.Render("Press any key…"))
+		return strings.Join(lines, "\n")
+	}
+
+	latest := k.events[0]
+	kind := "press"
+	if latest.release {
+		kind = "release"
+	}
+	if latest.key.IsRepeat {
+		kind += " (repeat)"
+	}
+	field := func(name, value string) string { return label.Render(name) + value }
+	lines = append(lines,
+		field("String", lipgloss.NewStyle().Bold(true).Foreground(p.Foreground).Render(latest.str)),
+		field("Event", kind),
+		field("Code", formatCode(latest.key.Code)),
+		field("Mod", formatMods(latest.key.Mod)),
+		field("Text", fmt.Sprintf("%q", latest.key.Text)),
+		field("ShiftedCode", formatCode(latest.key.ShiftedCode)),
+		field("BaseCode", formatCode(latest.key.BaseCode)),
+		"",
+		muted.Render("History"),
+	)
+	for _, ev := range k.events[1:] {
+		prefix := "↓ "
+		if ev.release {
+			prefix = "↑ "
+		}
+		lines = append(lines, muted.Render(prefix+ev.str))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// enhancementsLine summarises the terminal's keyboard enhancement report.
+func (k *KeyDebug) enhancementsLine() string {
+	if k.enhanced == nil {
+		return "Keyboard enhancements: not reported (legacy key encoding)"
+	}
+	if !k.enhanced.SupportsKeyDisambiguation() {
+		return "Keyboard enhancements: none"
+	}
+	features := []string{"disambiguation"}
+	for _, f := range []struct {
+		flag int
+		name string
+	}{
+		{ansi.KittyReportEventTypes, "event types"},
+		{ansi.KittyReportAlternateKeys, "alternate keys"},
+		{ansi.KittyReportAllKeysAsEscapeCodes, "all keys as escapes"},
+		{ansi.KittyReportAssociatedKeys, "associated text"},
+	} {
+		if k.enhanced.Flags&f.flag != 0 {
+			features = append(features, f.name)
+		}
+	}
+	return fmt.Sprintf("Keyboard enhancements (kitty flags %#x): %s", k.enhanced.Flags, strings.Join(features, ", "))
+}
+
+// formatCode shows a key code as its character and code point.
+func formatCode(r rune) string {
+	if r == 0 {
+		return "—"
+	}
+	name := tea.Key{Code: r}.String()
+	return fmt.Sprintf("%s (%U)", name, r)
+}
+
+// formatMods lists the modifier names in m.
+func formatMods(m tea.KeyMod) string {
+	var names []string
+	for _, mod := range []struct {
+		mod  tea.KeyMod
+		name string
+	}{
+		{tea.ModCtrl, "ctrl"},
+		{tea.ModAlt, "alt"},
+		{tea.ModShift, "shift"},
+		{tea.ModMeta, "meta"},
+		{tea.ModHyper, "hyper"},
+		{tea.ModSuper, "super"},
+		{tea.ModCapsLock, "capslock"},
+		{tea.ModNumLock, "numlock"},
+		{tea.ModScrollLock, "scrolllock"},
+	} {
+		if m.Contains(mod.mod) {
+			names = append(names, mod.name)
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, "+")
+}
+
+// ShortHelp returns key bindings for the help bar.
+func (k *KeyDebug) ShortHelp() []key.Binding { return []key.Binding{k.back} }
+
+// FullHelp returns grouped key bindings for the expanded help bar.
+func (k *KeyDebug) FullHelp() [][]key.Binding { return [][]key.Binding{{k.back}} }
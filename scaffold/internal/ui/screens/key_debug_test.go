@@ -0,0 +1,75 @@
+package screens
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"scaffold/internal/ui/theme/themetest"
+)
+
+func newTestKeyDebug() *KeyDebug {
+	k := NewKeyDebug()
+	k.ApplyTheme(themetest.State())
+	return k
+}
+
+func TestKeyDebug_ShowsKeyFields(t *testing.T) {
+	k := newTestKeyDebug()
+
+	k.Update(tea.KeyPressMsg{Code: 'a', Mod: tea.ModCtrl | tea.ModShift})
+	body := ansi.Strip(k.Body())
+
+	assert.Contains(t, body, "ctrl+shift+a")
+	assert.Contains(t, body, "U+0061")
+	assert.Contains(t, body, "ctrl+shift")
+	assert.Contains(t, body, `""`, "no text for a modified key")
+}
+
+func TestKeyDebug_RecordsReleasesAndHistory(t *testing.T) {
+	k := newTestKeyDebug()
+
+	k.Update(tea.KeyPressMsg{Code: 'x', Text: "x"})
+	k.Update(tea.KeyReleaseMsg{Code: 'x'})
+	body := ansi.Strip(k.Body())
+
+	assert.Contains(t, body, "release")
+	assert.Contains(t, body, "↓ x")
+}
+
+func TestKeyDebug_HistoryIsBounded(t *testing.T) {
+	k := newTestKeyDebug()
+	for range keyDebugHistory + 5 {
+		k.Update(tea.KeyPressMsg{Code: 'a', Text: "a"})
+	}
+	assert.Len(t, k.events, keyDebugHistory)
+}
+
+func TestKeyDebug_EnhancementsReport(t *testing.T) {
+	k := newTestKeyDebug()
+	assert.Contains(t, k.Body(), "not reported")
+
+	k.Update(tea.KeyboardEnhancementsMsg{Flags: ansi.KittyDisambiguateEscapeCodes | ansi.KittyReportEventTypes})
+	body := ansi.Strip(k.Body())
+	assert.Contains(t, body, "disambiguation")
+	assert.Contains(t, body, "event types")
+}
+
+func TestKeyDebug_DoubleEscGoesBack(t *testing.T) {
+	k := newTestKeyDebug()
+	esc := tea.KeyPressMsg{Code: tea.KeyEscape}
+
+	_, cmd := k.Update(esc)
+	assert.Nil(t, cmd, "a single esc is recorded, not acted on")
+
+	k.Update(tea.KeyPressMsg{Code: 'a', Text: "a"})
+	_, cmd = k.Update(esc)
+	assert.Nil(t, cmd, "another key in between resets the count")
+
+	_, cmd = k.Update(esc)
+	require.NotNil(t, cmd)
+	assert.IsType(t, BackMsg{}, cmd())
+}
@@ -0,0 +1,204 @@
+package screens
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"scaffold/internal/ui/status"
+	"scaffold/internal/ui/theme"
+)
+
+type messagesKeyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	PageUp   key.Binding
+	PageDown key.Binding
+	Top      key.Binding
+	Bottom   key.Binding
+	Back     key.Binding
+}
+
+func defaultMessagesKeyMap() messagesKeyMap {
+	return messagesKeyMap{
+		Up:       key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+		Down:     key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+		PageUp:   key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "page up")),
+		PageDown: key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdn", "page down")),
+		Top:      key.NewBinding(key.WithKeys("home"), key.WithHelp("home", "newest")),
+		Bottom:   key.NewBinding(key.WithKeys("end"), key.WithHelp("end", "oldest")),
+		Back:     key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	}
+}
+
+// Messages lists the status messages shown this session, newest first, so
+// one that went by too quickly can be read again.
+type Messages struct {
+	theme.ThemeAware
+
+	entries []status.Entry // newest first
+	when    func(time.Time) string
+	offset  int // first entry shown
+	width   int
+	height  int
+	keys    messagesKeyMap
+}
+
+// NewMessages creates the message history screen for history, oldest first
+// as from statusbar.Model.History. when formats the time of each message,
+// such as "2m ago".
+func NewMessages(history []status.Entry, when func(time.Time) string) *Messages {
+	s := &Messages{when: when, keys: defaultMessagesKeyMap()}
+	s.SetHistory(history)
+	return s
+}
+
+// SetHistory replaces the messages listed, oldest first, and scrolls back
+// to the newest.
+func (s *Messages) SetHistory(history []status.Entry) {
+	s.entries = make([]status.Entry, len(history))
+	for i, e := range history {
+		s.entries[len(history)-1-i] = e
+	}
+	s.offset = 0
+}
+
+// ScreenID implements nav.Identifiable.
+func (s *Messages) ScreenID() string { return "messages" }
+
+// Route implements nav.Serializable.
+func (s *Messages) Route() string { return "messages" }
+
+// Params implements nav.Serializable.
+func (s *Messages) Params() map[string]string { return nil }
+
+// SetWidth sets the width messages are cut to.
+func (s *Messages) SetWidth(w int) Screen {
+	s.width = w
+	return s
+}
+
+// SetHeight sets the body height, which bounds the list.
+func (s *Messages) SetHeight(h int) Screen {
+	s.height = h
+	s.scroll(0)
+	return s
+}
+
+// ApplyTheme implements theme.Themeable.
+func (s *Messages) ApplyTheme(state theme.State) {
+	s.ApplyThemeState(state)
+}
+
+// Init is a no-op.
+func (s *Messages) Init() tea.Cmd { return nil }
+
+// Update scrolls the list.
+func (s *Messages) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyPressMsg)
+	if !ok {
+		return s, nil
+	}
+	switch {
+	case key.Matches(keyMsg, s.keys.Up):
+		s.scroll(-1)
+	case key.Matches(keyMsg, s.keys.Down):
+		s.scroll(1)
+	case key.Matches(keyMsg, s.keys.PageUp):
+		s.scroll(-s.rows())
+	case key.Matches(keyMsg, s.keys.PageDown):
+		s.scroll(s.rows())
+	case key.Matches(keyMsg, s.keys.Top):
+		s.scroll(-len(s.entries))
+	case key.Matches(keyMsg, s.keys.Bottom):
+		s.scroll(len(s.entries))
+	case key.Matches(keyMsg, s.keys.Back):
+		return s, func() tea.Msg { return BackMsg{} }
+	}
+	return s, nil
+}
+
+// rows returns how many messages fit: the body less the title lines.
+func (s *Messages) rows() int {
+	if s.height <= 0 {
+		return 10
+	}
+	return max(s.height-3, 3)
+}
+
+// scroll moves the list by n messages, keeping it in range.
+func (s *Messages) scroll(n int) {
+	s.offset = max(min(s.offset+n, len(s.entries)-s.rows()), 0)
+}
+
+// View satisfies tea.Model.
+func (s *Messages) View() tea.View { return tea.NewView(s.Body()) }
+
+// Body returns the renderable content for layout composition.
+func (s *Messages) Body() string {
+	p := s.Palette()
+	title := lipgloss.NewStyle().Bold(true).Foreground(p.Primary).Render("Messages")
+	muted := lipgloss.NewStyle().Foreground(p.ForegroundMuted)
+	if len(s.entries) == 0 {
+		return title + "\n\n" + muted.Render("No messages yet.")
+	}
+	end := min(s.offset+s.rows(), len(s.entries))
+	summary := fmt.Sprintf("%d messages, newest first", len(s.entries))
+	if end-s.offset < len(s.entries) {
+		summary += fmt.Sprintf(" · %d–%d", s.offset+1, end)
+	}
+
+	width := s.width
+	if width <= 0 {
+		width = 80
+	}
+	whenW := 0
+	for _, e := range s.entries[s.offset:end] {
+		whenW = max(whenW, lipgloss.Width(s.when(e.At)))
+	}
+	lines := []string{title, muted.Render(summary), ""}
+	for _, e := range s.entries[s.offset:end] {
+		icon, c := kindIcon(e.Kind, p)
+		text := e.Text
+		if e.Count > 1 {
+			text += fmt.Sprintf(" ×%d", e.Count)
+		}
+		line := muted.Width(whenW+2).Render(s.when(e.At)) +
+			lipgloss.NewStyle().Foreground(c).Render(icon) + " " +
+			lipgloss.NewStyle().Foreground(p.Foreground).Render(text)
+		lines = append(lines, ansi.Truncate(line, width, "…"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// kindIcon returns the marker and color of a status kind.
+func kindIcon(k status.Kind, p theme.Palette) (string, color.Color) {
+	switch k {
+	case status.KindSuccess:
+		return "✓", p.Success
+	case status.KindWarning:
+		return "!", p.Warning
+	case status.KindError:
+		return "✗", p.Error
+	}
+	return "•", p.Info
+}
+
+// ShortHelp returns key bindings for the help bar.
+func (s *Messages) ShortHelp() []key.Binding {
+	return []key.Binding{s.keys.Up, s.keys.Down, s.keys.Back}
+}
+
+// FullHelp returns grouped key bindings for the expanded help bar.
+func (s *Messages) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{s.keys.Up, s.keys.Down, s.keys.PageUp, s.keys.PageDown},
+		{s.keys.Top, s.keys.Bottom, s.keys.Back},
+	}
+}
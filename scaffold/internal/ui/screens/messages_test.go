@@ -0,0 +1,54 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+
+	"scaffold/internal/ui/status"
+	"scaffold/internal/ui/theme/themetest"
+)
+
+func newTestMessages(history []status.Entry) *Messages {
+	s := NewMessages(history, func(t time.Time) string { return t.Format("15:04") })
+	s.ApplyTheme(themetest.State())
+	return s
+}
+
+func TestMessages_NewestFirst(t *testing.T) {
+	at := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+	s := newTestMessages([]status.Entry{
+		{Text: "Settings saved", Kind: status.KindSuccess, At: at, Count: 1},
+		{Text: "Save failed", Kind: status.KindError, At: at.Add(time.Minute), Count: 3},
+	})
+	body := ansi.Strip(s.Body())
+	assert.Contains(t, body, "09:01  ✗ Save failed ×3")
+	assert.Contains(t, body, "09:00  ✓ Settings saved")
+	assert.Less(t, strings.Index(body, "Save failed"), strings.Index(body, "Settings saved"))
+
+	assert.Contains(t, ansi.Strip(newTestMessages(nil).Body()), "No messages yet.")
+}
+
+func TestMessages_Scrolls(t *testing.T) {
+	at := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+	var history []status.Entry
+	for i := range 20 {
+		history = append(history, status.Entry{Text: string(rune('a' + i)), Kind: status.KindInfo, At: at, Count: 1})
+	}
+	s := newTestMessages(history)
+	s.SetHeight(8) // 5 rows
+
+	assert.Contains(t, ansi.Strip(s.Body()), "1–5")
+	s.Update(tea.KeyPressMsg{Code: tea.KeyPgDown})
+	assert.Contains(t, ansi.Strip(s.Body()), "6–10")
+	s.Update(tea.KeyPressMsg{Code: tea.KeyEnd})
+	assert.Contains(t, ansi.Strip(s.Body()), "16–20")
+	s.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	assert.Contains(t, ansi.Strip(s.Body()), "16–20", "scrolling stops at the oldest")
+	s.Update(tea.KeyPressMsg{Code: tea.KeyHome})
+	assert.Contains(t, ansi.Strip(s.Body()), "1–5")
+}
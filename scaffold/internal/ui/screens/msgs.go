@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"scaffold/config"
+	"scaffold/internal/ui/theme"
 )
 
 // BackMsg signals that the current screen wants to go back.
@@ -14,6 +15,32 @@ type SettingsSavedMsg struct {
 	Cfg config.Config
 }
 
+// NotesSavedMsg carries the scratchpad text when the user saves it. Close
+// additionally asks for the notes screen to be popped.
+type NotesSavedMsg struct {
+	Text  string
+	Close bool
+}
+
 // detailTickMsg is sent every second while the detail screen is loading,
 // demonstrating the canonical tea.Tick periodic-task pattern (§7C).
 type detailTickMsg time.Time
+
+// ThemePreviewMsg asks for Spec's palette to be applied live without saving
+// it. A nil Spec ends the preview and restores the configured theme.
+type ThemePreviewMsg struct {
+	Spec *theme.ThemeSpec
+}
+
+// ThemeSaveAsMsg carries a custom theme to be written to the themes
+// directory and made the active theme.
+type ThemeSaveAsMsg struct {
+	Spec theme.ThemeSpec
+}
+
+// CopyToClipboardMsg asks for Text to be copied to the system clipboard
+// (OSC 52). Label names what was copied in the status bar confirmation.
+type CopyToClipboardMsg struct {
+	Text  string
+	Label string
+}
@@ -0,0 +1,144 @@
+package screens
+
+import (
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textarea"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"scaffold/internal/ui/theme"
+)
+
+type notesKeyMap struct {
+	Save  key.Binding
+	Close key.Binding
+}
+
+// Notes is a free-form scratchpad. It never touches the disk itself: saving
+// and closing emit NotesSavedMsg and the root model persists the text.
+type Notes struct {
+	theme.ThemeAware
+
+	editor textarea.Model
+	keys   notesKeyMap
+	saved  string // text as of the last save, for the modified marker
+	width  int
+	height int
+}
+
+// NewNotes creates a Notes screen pre-filled with text.
+func NewNotes(text string) *Notes {
+	editor := textarea.New()
+	editor.Placeholder = "Jot something down…"
+	editor.ShowLineNumbers = false
+	editor.CharLimit = 0
+	editor.MaxHeight = 0
+	editor.SetValue(text)
+	editor.Focus()
+	return &Notes{
+		editor: editor,
+		saved:  text,
+		keys: notesKeyMap{
+			Save: key.NewBinding(
+				key.WithKeys("ctrl+s"),
+				key.WithHelp("ctrl+s", "save"),
+			),
+			Close: key.NewBinding(
+				key.WithKeys("esc"),
+				key.WithHelp("esc", "save & close"),
+			),
+		},
+	}
+}
+
+// ScreenID implements nav.Identifiable.
+func (n *Notes) ScreenID() string { return "notes" }
+
+// Route implements nav.Serializable. The text itself lives in the notes
+// file, so no params are needed to rebuild the screen.
+func (n *Notes) Route() string { return "notes" }
+
+// Params implements nav.Serializable.
+func (n *Notes) Params() map[string]string { return nil }
+
+// CapturingInput implements InputCapturer: every printable key is text.
+func (n *Notes) CapturingInput() bool { return true }
+
+// Value returns the current text.
+func (n *Notes) Value() string { return n.editor.Value() }
+
+// MarkSaved records the current text as persisted.
+func (n *Notes) MarkSaved() { n.saved = n.editor.Value() }
+
+// SetWidth sets the available render width.
+func (n *Notes) SetWidth(w int) Screen {
+	n.width = w
+	n.editor.SetWidth(max(w-6, 10))
+	return n
+}
+
+// SetHeight sets the available body height, reserving a line for the title.
+func (n *Notes) SetHeight(h int) Screen {
+	n.height = h
+	n.editor.SetHeight(max(h-2, 3))
+	return n
+}
+
+// ApplyTheme implements theme.Themeable.
+func (n *Notes) ApplyTheme(state theme.State) {
+	n.ApplyThemeState(state)
+	p := state.Palette
+	s := textarea.DefaultStyles(state.IsDark)
+	s.Focused.Text = s.Focused.Text.Foreground(p.Foreground)
+	s.Focused.Prompt = s.Focused.Prompt.Foreground(p.Primary)
+	s.Focused.Placeholder = s.Focused.Placeholder.Foreground(p.ForegroundSubtle)
+	s.Focused.CursorLine = s.Focused.CursorLine.UnsetBackground().Foreground(p.Foreground)
+	s.Cursor.Color = p.Primary
+	n.editor.SetStyles(s)
+}
+
+// Init starts the cursor blinking.
+func (n *Notes) Init() tea.Cmd { return textarea.Blink }
+
+// Update handles editing and the save/close keys.
+func (n *Notes) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		switch {
+		case key.Matches(keyMsg, n.keys.Save):
+			return n, n.save(false)
+		case key.Matches(keyMsg, n.keys.Close):
+			return n, n.save(true)
+		}
+	}
+	var cmd tea.Cmd
+	n.editor, cmd = n.editor.Update(msg)
+	return n, cmd
+}
+
+func (n *Notes) save(close bool) tea.Cmd {
+	text := n.editor.Value()
+	return func() tea.Msg { return NotesSavedMsg{Text: text, Close: close} }
+}
+
+// View satisfies tea.Model.
+func (n *Notes) View() tea.View { return tea.NewView(n.Body()) }
+
+// Body returns the renderable content for layout composition.
+func (n *Notes) Body() string {
+	p := n.Palette()
+	title := lipgloss.NewStyle().Bold(true).Foreground(p.Primary).Render("Notes")
+	if n.editor.Value() != n.saved {
+		title += lipgloss.NewStyle().Foreground(p.ForegroundSubtle).Render(" (modified)")
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, title, "", n.editor.View())
+}
+
+// ShortHelp returns key bindings for the help bar.
+func (n *Notes) ShortHelp() []key.Binding {
+	return []key.Binding{n.keys.Save, n.keys.Close}
+}
+
+// FullHelp returns grouped key bindings for the expanded help bar.
+func (n *Notes) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{n.keys.Save, n.keys.Close}}
+}
@@ -0,0 +1,192 @@
+package screens
+
+import (
+	"context"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"scaffold/internal/plugin"
+	"scaffold/internal/ui/status"
+	"scaffold/internal/ui/theme"
+)
+
+// PluginScreenID returns the screen and menu item ID of a plugin's screen.
+func PluginScreenID(pluginName, screen string) string {
+	return "plugin:" + pluginName + "/" + screen
+}
+
+// pluginReplyMsg carries a plugin's answer to a render or key call.
+type pluginReplyMsg struct {
+	id    string // PluginScreenID of the screen that asked
+	reply plugin.Reply
+	err   error
+}
+
+// PluginScreen shows a screen rendered by a plugin process. Keys other than
+// esc are sent to the plugin, which answers with the new body; the calls run
+// as commands so a slow plugin does not block the UI. One call is in flight
+// at a time: keys pressed meanwhile wait their turn, so the plugin sees them
+// in order and the last reply shown is for the last key.
+type PluginScreen struct {
+	theme.ThemeAware
+
+	ctx     context.Context
+	client  *plugin.Client
+	spec    plugin.ScreenSpec
+	body    string
+	err     error
+	loading bool
+	busy    bool     // a call is in flight
+	queue   []string // keys waiting for it to finish
+	width   int
+	height  int
+}
+
+// NewPluginScreen creates the screen spec of client's plugin. ctx cancels
+// calls still running when the app quits.
+func NewPluginScreen(ctx context.Context, client *plugin.Client, spec plugin.ScreenSpec) *PluginScreen {
+	return &PluginScreen{ctx: ctx, client: client, spec: spec, loading: true}
+}
+
+// ScreenID implements nav.Identifiable.
+func (p *PluginScreen) ScreenID() string {
+	return PluginScreenID(p.client.Manifest.Name, p.spec.ID)
+}
+
+// Route implements nav.Serializable.
+func (p *PluginScreen) Route() string { return "plugin" }
+
+// Params implements nav.Serializable.
+func (p *PluginScreen) Params() map[string]string {
+	return map[string]string{"name": p.client.Manifest.Name, "screen": p.spec.ID}
+}
+
+// SetWidth sets the screen width. The plugin sees it on its next call.
+func (p *PluginScreen) SetWidth(w int) Screen {
+	p.width = w
+	return p
+}
+
+// SetHeight sets the body height.
+func (p *PluginScreen) SetHeight(h int) Screen {
+	p.height = h
+	return p
+}
+
+// ApplyTheme implements theme.Themeable.
+func (p *PluginScreen) ApplyTheme(state theme.State) {
+	p.ApplyThemeState(state)
+}
+
+// Init asks the plugin for the first body.
+func (p *PluginScreen) Init() tea.Cmd {
+	p.busy = true
+	return p.call(func(ctx context.Context, w, h int) (plugin.Reply, error) {
+		return p.client.Render(ctx, p.spec.ID, w, h)
+	})
+}
+
+// call runs fn as a command with the body's current size and reports the
+// result as a pluginReplyMsg.
+func (p *PluginScreen) call(fn func(ctx context.Context, w, h int) (plugin.Reply, error)) tea.Cmd {
+	id, w, h := p.ScreenID(), max(p.width-6, 1), max(p.height-2, 1) // less the title
+	ctx := p.ctx
+	return func() tea.Msg {
+		reply, err := fn(ctx, w, h)
+		return pluginReplyMsg{id: id, reply: reply, err: err}
+	}
+}
+
+// Update sends keys to the plugin and shows its replies. esc always goes
+// back, whatever the plugin does with keys.
+func (p *PluginScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case pluginReplyMsg:
+		if msg.id != p.ScreenID() {
+			return p, nil
+		}
+		p.loading = false
+		if msg.err != nil {
+			p.err = msg.err
+			return p, tea.Batch(status.SetError(msg.err.Error(), 0), p.next())
+		}
+		p.err = nil
+		if msg.reply.Body != "" || !msg.reply.Close {
+			p.body = msg.reply.Body
+		}
+		var cmds []tea.Cmd
+		if msg.reply.Status != "" {
+			cmds = append(cmds, status.SetInfo(msg.reply.Status, 0))
+		}
+		if msg.reply.Close {
+			p.queue = nil
+			cmds = append(cmds, func() tea.Msg { return BackMsg{} })
+		}
+		return p, tea.Batch(append(cmds, p.next())...)
+	case tea.KeyPressMsg:
+		if msg.String() == "esc" {
+			return p, func() tea.Msg { return BackMsg{} }
+		}
+		if p.busy {
+			p.queue = append(p.queue, msg.String())
+			return p, nil
+		}
+		p.busy = true
+		return p, p.sendKey(msg.String())
+	}
+	return p, nil
+}
+
+// sendKey returns the call sending k to the plugin.
+func (p *PluginScreen) sendKey(k string) tea.Cmd {
+	return p.call(func(ctx context.Context, w, h int) (plugin.Reply, error) {
+		return p.client.Key(ctx, p.spec.ID, k, w, h)
+	})
+}
+
+// next returns the call for the first waiting key, now that the call in
+// flight has finished, or nil when none is waiting.
+func (p *PluginScreen) next() tea.Cmd {
+	if len(p.queue) == 0 {
+		p.busy = false
+		return nil
+	}
+	k := p.queue[0]
+	p.queue = p.queue[1:]
+	return p.sendKey(k)
+}
+
+// View satisfies tea.Model.
+func (p *PluginScreen) View() tea.View { return tea.NewView(p.Body()) }
+
+// Body returns the plugin's last body, cut to the screen, under the
+// screen's title.
+func (p *PluginScreen) Body() string {
+	pal := p.Palette()
+	title := lipgloss.NewStyle().Bold(true).Foreground(pal.Primary).Render(p.spec.Title)
+	muted := lipgloss.NewStyle().Foreground(pal.ForegroundMuted)
+	var body string
+	switch {
+	case p.loading:
+		body = muted.Render("Loading…")
+	case p.err != nil && p.body == "":
+		body = lipgloss.NewStyle().Foreground(pal.Error).Render("✗ " + p.err.Error())
+	default:
+		body = p.body
+	}
+	content := title + "\n\n" + body
+	if p.width > 0 {
+		lines := strings.Split(content, "\n")
+		for i, l := range lines {
+			lines[i] = ansi.Truncate(l, max(p.width-6, 1), "…")
+		}
+		if p.height > 0 && len(lines) > p.height {
+			lines = lines[:p.height]
+		}
+		content = strings.Join(lines, "\n")
+	}
+	return content
+}
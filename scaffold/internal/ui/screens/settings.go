@@ -1,23 +1,35 @@
 package screens
 
 import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
 	"scaffold/config"
+	"scaffold/internal/formgen"
 	"scaffold/internal/ui/modal"
+	"scaffold/internal/ui/nav"
+	"scaffold/internal/ui/status"
 	"scaffold/internal/ui/theme"
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/huh/v2"
+	"charm.land/lipgloss/v2"
 )
 
 // settingsKeyMap defines help-visible keybindings for the settings form.
 type settingsKeyMap struct {
-	Up      key.Binding
-	Down    key.Binding
-	Submit  key.Binding
-	Reset   key.Binding
-	NextTab key.Binding
-	PrevTab key.Binding
+	Up       key.Binding
+	Down     key.Binding
+	Submit   key.Binding
+	Reset    key.Binding
+	NextTab  key.Binding
+	PrevTab  key.Binding
+	Expand   key.Binding
+	Edit     key.Binding
+	Advanced key.Binding
 }
 
 func defaultSettingsKeyMap() settingsKeyMap {
@@ -46,6 +58,18 @@ func defaultSettingsKeyMap() settingsKeyMap {
 			key.WithKeys("{"),
 			key.WithHelp("{", "prev group"),
 		),
+		Expand: key.NewBinding(
+			key.WithKeys("ctrl+o"),
+			key.WithHelp("ctrl+o", "expand field"),
+		),
+		Edit: key.NewBinding(
+			key.WithKeys("ctrl+g"),
+			key.WithHelp("ctrl+g", "edit in $EDITOR"),
+		),
+		Advanced: key.NewBinding(
+			key.WithKeys("ctrl+x"),
+			key.WithHelp("ctrl+x", "show advanced"),
+		),
 	}
 }
 
@@ -55,13 +79,18 @@ type Settings struct {
 
 	cfg          *config.Config
 	form         *huh.Form
-	groups       []config.GroupMeta
+	allGroups    []config.GroupMeta // every field, advanced included
+	groups       []config.GroupMeta // the fields shown in the form
+	advanced     bool               // advanced fields are shown
+	shown        []string           // keys of the fields in the form; see formgen.Shown
 	keys         settingsKeyMap
 	huhKeys      *huh.KeyMap
 	width        int
 	height       int
 	currentGroup int
 	tabStyles    tabStyles
+	compact      bool  // descriptions shown below the form instead of in a column
+	invalid      error // why the last submit was refused; nil once it succeeds
 }
 
 // NewSettings creates a Settings screen from a config snapshot.
@@ -73,7 +102,9 @@ func NewSettings(cfg config.Config) *Settings {
 		keys:         defaultSettingsKeyMap(),
 		currentGroup: 0,
 	}
-	s.groups = config.Schema(s.cfg)
+	s.allGroups = config.Schema(s.cfg)
+	s.groups = formgen.WithoutAdvanced(s.allGroups)
+	s.shown = formgen.Shown(s.groups)
 
 	km := huh.NewDefaultKeyMap()
 	km.Quit = key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back"))
@@ -82,13 +113,19 @@ func NewSettings(cfg config.Config) *Settings {
 	// Note: initTabStyles() is called by ApplyTheme which is invoked by handleNavigate
 
 	// Build single stacked form with all groups at a fixed height
-	s.form = s.buildForm(cfg.UI.ThemeName)
+	s.form = s.buildForm()
 	return s
 }
 
-// SetWidth sets the screen width.
+// SetWidth sets the screen width. When the three-column form does not fit,
+// it is rebuilt without the description column.
 func (s *Settings) SetWidth(w int) Screen {
 	s.width = w
+	compact := w-6 < formgen.New().Width(s.groups)
+	if compact != s.compact {
+		s.compact = compact
+		s.form = s.buildForm()
+	}
 	return s
 }
 
@@ -105,22 +142,63 @@ func (s *Settings) ApplyTheme(state theme.State) {
 	// Rebuild the form so huh re-applies styles from the new theme.
 	// WithTheme alone does not re-style already-initialized fields.
 	// Accessor objects write directly to s.cfg, so current edits are preserved.
-	s.form = s.buildForm(state.Name)
+	s.form = s.buildForm()
 }
 
-// buildForm constructs the settings form with the given theme applied.
-func (s *Settings) buildForm(themeName string) *huh.Form {
-	return buildFormForAllGroups(s.groups).
-		WithTheme(theme.HuhTheme(themeName)).
+// buildForm constructs the settings form styled with the palette on
+// screen, or with the configured theme until the first theme update.
+func (s *Settings) buildForm() *huh.Form {
+	huhTheme := theme.HuhTheme(s.cfg.UI.ThemeName)
+	if s.ThemeName() != "" {
+		p := s.Palette()
+		huhTheme = huh.ThemeFunc(func(bool) *huh.Styles { return theme.HuhFromPalette(p) })
+	}
+	return formgen.New().
+		Compact(s.compact).
+		OptionSource("themes", theme.AvailableThemes).
+		Form(s.groups).
+		WithTheme(huhTheme).
 		WithKeyMap(s.huhKeys).
 		WithShowHelp(false)
 }
 
+// ScreenID implements nav.Identifiable.
+func (s *Settings) ScreenID() string { return "settings" }
+
+// Route implements nav.Serializable. Settings carries no params: it is
+// rebuilt from the current config.
+func (s *Settings) Route() string { return "settings" }
+
+// Params implements nav.Serializable.
+func (s *Settings) Params() map[string]string { return nil }
+
 // Init initializes the settings form.
 func (s *Settings) Init() tea.Cmd {
 	return s.form.Init()
 }
 
+// WillAppear implements nav.LifecycleScreen. A form left completed or
+// aborted, as it is when Forward re-enters the screen, is rebuilt, so
+// coming back neither saves again nor leaves straight away. Edits are kept,
+// since the fields write straight into s.cfg.
+func (s *Settings) WillAppear() tea.Cmd {
+	if s.form.State == huh.StateNormal {
+		return nil
+	}
+	s.currentGroup = 0
+	s.form = s.buildForm()
+	return s.form.Init()
+}
+
+// Appeared implements nav.LifecycleScreen.
+func (s *Settings) Appeared() tea.Cmd { return nil }
+
+// WillDisappear implements nav.LifecycleScreen.
+func (s *Settings) WillDisappear() tea.Cmd { return nil }
+
+// Disappeared implements nav.LifecycleScreen.
+func (s *Settings) Disappeared() tea.Cmd { return nil }
+
 // Update handles messages for the settings screen.
 func (s *Settings) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
@@ -133,6 +211,14 @@ func (s *Settings) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	if edited, ok := msg.(FieldEditedMsg); ok {
+		s.applyEdit(edited)
+		return s, nil
+	}
+	if edited, ok := msg.(ExternalEditedMsg); ok {
+		return s, s.applyExternalEdit(edited)
+	}
+
 	// Handle reset and submit keys
 	if s.form.State == huh.StateNormal {
 		if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
@@ -149,6 +235,18 @@ func (s *Settings) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					s.currentGroup--
 					return s, s.form.PrevGroup()
 				}
+			case key.Matches(keyMsg, s.keys.Advanced):
+				return s, s.toggleAdvanced()
+			case key.Matches(keyMsg, s.keys.Expand):
+				if editor := s.expandFocused(); editor != nil {
+					return s, nav.Present(editor)
+				}
+				return s, nil
+			case key.Matches(keyMsg, s.keys.Edit):
+				if _, fm, ok := s.focusedText(); ok {
+					return s, editExternally(editorCommand(s.cfg.Editor.EditorCommand), fm)
+				}
+				return s, nil
 			case key.Matches(keyMsg, s.keys.Reset):
 				return s, modal.ShowConfirm(
 					"reset-settings",
@@ -161,6 +259,11 @@ func (s *Settings) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if f, ok := form.(*huh.Form); ok {
 					s.form = f
 				}
+				// Fields on other pages are not validated by huh, so check
+				// them all before saving.
+				if s.invalid = formgen.Check(s.allGroups); s.invalid != nil {
+					return s, formCmd
+				}
 				saved := *s.cfg
 				return s, tea.Sequence(formCmd, func() tea.Msg {
 					return SettingsSavedMsg{Cfg: saved}
@@ -173,7 +276,7 @@ func (s *Settings) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if f, ok := form.(*huh.Form); ok {
 		s.form = f
 	}
-	cmds = append(cmds, cmd)
+	cmds = append(cmds, cmd, s.syncVisibility())
 
 	switch s.form.State {
 	case huh.StateCompleted:
@@ -186,6 +289,131 @@ func (s *Settings) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return s, tea.Batch(cmds...)
 }
 
+// toggleAdvanced shows or hides the advanced fields, rebuilding the form
+// from its first group. Values already edited are kept, since the fields
+// write straight into s.cfg.
+func (s *Settings) toggleAdvanced() tea.Cmd {
+	s.advanced = !s.advanced
+	if s.advanced {
+		s.groups = s.allGroups
+		s.keys.Advanced.SetHelp("ctrl+x", "hide advanced")
+	} else {
+		s.groups = formgen.WithoutAdvanced(s.allGroups)
+		s.keys.Advanced.SetHelp("ctrl+x", "show advanced")
+	}
+	s.shown = formgen.Shown(s.groups)
+	s.currentGroup = 0
+	if s.width > 0 {
+		s.compact = s.width-6 < formgen.New().Width(s.groups)
+	}
+	s.form = s.buildForm()
+	return s.form.Init()
+}
+
+// syncVisibility rebuilds the form when an edit shows or hides fields
+// (cfg_visible_if), keeping the focus on the field that was edited.
+func (s *Settings) syncVisibility() tea.Cmd {
+	shown := formgen.Shown(s.groups)
+	if slices.Equal(shown, s.shown) {
+		return nil
+	}
+	s.shown = shown
+	focused := s.focusedKey()
+	s.form = s.buildForm()
+	cmds := []tea.Cmd{s.form.Init()}
+	for range s.currentGroup {
+		cmds = append(cmds, s.form.NextGroup())
+	}
+	for range shown {
+		if s.focusedKey() == focused {
+			break
+		}
+		cmds = append(cmds, s.form.NextField())
+	}
+	return tea.Batch(cmds...)
+}
+
+// focusedKey returns the key of the focused field, or "" if it has none.
+func (s *Settings) focusedKey() string {
+	if keyer, ok := s.form.GetFocusedField().(interface{ GetKey() string }); ok {
+		return keyer.GetKey()
+	}
+	return ""
+}
+
+// focusedText returns the focused field when it is a text input, of any
+// type, along with its schema entry.
+func (s *Settings) focusedText() (*huh.Input, config.FieldMeta, bool) {
+	field := s.form.GetFocusedField()
+	input, ok := formgen.Control(field).(*huh.Input)
+	if !ok {
+		return nil, config.FieldMeta{}, false
+	}
+	for _, g := range s.groups {
+		for _, fm := range g.Fields {
+			if fm.Key == field.GetKey() && fm.Kind == config.FieldInput {
+				return input, fm, true
+			}
+		}
+	}
+	return nil, config.FieldMeta{}, false
+}
+
+// focusedInput returns the focused field when it is a free-text string
+// input, along with its schema entry.
+func (s *Settings) focusedInput() (*huh.Input, config.FieldMeta, bool) {
+	input, fm, ok := s.focusedText()
+	if !ok || fm.Value.Kind() != reflect.String {
+		return nil, config.FieldMeta{}, false
+	}
+	return input, fm, true
+}
+
+// expandFocused returns an editor for the focused string field, or nil when
+// the focused field is not one.
+func (s *Settings) expandFocused() *FieldEditor {
+	_, fm, ok := s.focusedInput()
+	if !ok {
+		return nil
+	}
+	return NewFieldEditor(fm.Key, fm.Label, fm.Value.String())
+}
+
+// applyEdit writes an expanded edit back to the working config and refreshes
+// the focused input so it shows the new value. Edits for a field that is no
+// longer focused are dropped.
+func (s *Settings) applyEdit(msg FieldEditedMsg) {
+	input, fm, ok := s.focusedInput()
+	if !ok || fm.Key != msg.Key {
+		return
+	}
+	acc := formgen.TextAccessor(fm.Value)
+	acc.Set(msg.Value)
+	input.Accessor(acc) // re-reads the value into the text input
+}
+
+// applyExternalEdit validates text back from an external editor and writes
+// it to the working config, refreshing the focused input. Text that does not
+// validate, and editor failures, are reported in the status bar and leave
+// the setting as it was. Edits for a field that is no longer focused are
+// dropped.
+func (s *Settings) applyExternalEdit(msg ExternalEditedMsg) tea.Cmd {
+	input, fm, ok := s.focusedText()
+	if !ok || fm.Key != msg.Key {
+		return nil
+	}
+	if msg.Err != nil {
+		return status.SetError("Editor failed: "+msg.Err.Error(), 0)
+	}
+	if err := fm.Validate(msg.Value); err != nil {
+		return status.SetError(fm.Label+" "+err.Error(), 0)
+	}
+	acc := formgen.TextAccessor(fm.Value)
+	acc.Set(msg.Value)
+	input.Accessor(acc)
+	return tea.Batch(status.SetSuccess(fm.Label+" updated", 0), s.syncVisibility())
+}
+
 // View renders the settings screen.
 func (s *Settings) View() tea.View {
 	return tea.NewView(s.Body())
@@ -198,12 +426,86 @@ func (s *Settings) Body() string {
 	}
 	tabBar := s.renderTabBar()
 	formView := s.form.View()
+	if d := s.focusedDescription(); d != "" {
+		formView += "\n\n" + d
+	}
+	if h := s.renderAdvancedHint(); h != "" {
+		formView += "\n\n" + h
+	}
+	if w := s.renderThemeWarnings(); w != "" {
+		formView += "\n" + w
+	}
+	if s.invalid != nil {
+		formView += "\n" + lipgloss.NewStyle().
+			Foreground(s.Palette().Error).
+			Width(max(s.width-6, 1)).
+			Render("✗ Not saved: "+s.invalid.Error())
+	}
 	if tabBar == "" {
 		return formView
 	}
 	return tabBar + "\n" + formView
 }
 
+// focusedDescription renders the focused field's description in the compact
+// layout, which has no description column.
+func (s *Settings) focusedDescription() string {
+	if !s.compact {
+		return ""
+	}
+	field := s.form.GetFocusedField()
+	keyer, ok := field.(interface{ GetKey() string })
+	if !ok {
+		return ""
+	}
+	for _, g := range s.groups {
+		for _, f := range g.Fields {
+			if f.Key == keyer.GetKey() && f.Desc != "" {
+				return lipgloss.NewStyle().
+					Foreground(s.Palette().ForegroundMuted).
+					Width(max(s.width-6, 1)).
+					Render(f.Desc)
+			}
+		}
+	}
+	return ""
+}
+
+// renderAdvancedHint tells how many advanced settings are hidden.
+func (s *Settings) renderAdvancedHint() string {
+	n := formgen.AdvancedCount(s.allGroups)
+	if s.advanced || n == 0 {
+		return ""
+	}
+	noun := "settings"
+	if n == 1 {
+		noun = "setting"
+	}
+	return lipgloss.NewStyle().
+		Foreground(s.Palette().ForegroundSubtle).
+		Italic(true).
+		Render(fmt.Sprintf("%d advanced %s hidden · %s to show", n, noun, s.keys.Advanced.Help().Key))
+}
+
+// renderThemeWarnings lists contrast warnings for the selected theme when it
+// is user-defined. Built-in themes are curated, so their warnings are not
+// shown.
+func (s *Settings) renderThemeWarnings() string {
+	name := s.cfg.UI.ThemeName
+	if _, ok := theme.Spec(name); !ok || theme.IsBuiltin(name) {
+		return ""
+	}
+	warnings := theme.ValidatePalette(theme.NewPalette(name, s.IsDark()))
+	if len(warnings) == 0 {
+		return ""
+	}
+	lines := make([]string, len(warnings))
+	for i, w := range warnings {
+		lines[i] = "⚠ " + name + ": " + w
+	}
+	return lipgloss.NewStyle().Foreground(s.Palette().Warning).Render(strings.Join(lines, "\n"))
+}
+
 // ShortHelp returns short help key bindings for the global help bar.
 func (s *Settings) ShortHelp() []key.Binding {
 	if len(s.groups) > 1 {
@@ -216,9 +518,9 @@ func (s *Settings) ShortHelp() []key.Binding {
 func (s *Settings) FullHelp() [][]key.Binding {
 	if len(s.groups) > 1 {
 		return [][]key.Binding{
-			{s.keys.Submit, s.keys.Reset},
+			{s.keys.Submit, s.keys.Reset, s.keys.Expand, s.keys.Edit, s.keys.Advanced},
 			{s.keys.NextTab, s.keys.PrevTab},
 		}
 	}
-	return [][]key.Binding{{s.keys.Submit, s.keys.Reset}}
+	return [][]key.Binding{{s.keys.Submit, s.keys.Reset, s.keys.Expand, s.keys.Edit, s.keys.Advanced}}
 }
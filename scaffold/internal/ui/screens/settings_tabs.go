@@ -4,6 +4,8 @@ import (
 	"strings"
 
 	"charm.land/lipgloss/v2"
+
+	"scaffold/internal/ui/theme"
 )
 
 // tabStyles holds lipgloss styles for the group tab bar.
@@ -21,6 +23,7 @@ func (s *Settings) initTabStyles() {
 			Foreground(p.OnPrimary).
 			Background(p.Primary).
 			Bold(true).
+			Underline(theme.Monochrome()).
 			Padding(0, 1),
 		inactive: lipgloss.NewStyle().
 			Foreground(p.ForegroundSubtle).
@@ -47,7 +50,27 @@ func (s *Settings) renderTabBar() string {
 			tabs = append(tabs, s.tabStyles.inactive.Render(g.Label))
 		}
 	}
-	return s.tabStyles.tabBar.Render(strings.Join(tabs, " "))
+	return s.tabStyles.tabBar.Render(wrapTabs(tabs, s.width-6-s.tabStyles.tabBar.GetHorizontalFrameSize()))
+}
+
+// wrapTabs joins rendered tabs with single spaces, starting a new row
+// whenever the next tab would exceed width. A width of 0 or less keeps all
+// tabs on one row.
+func wrapTabs(tabs []string, width int) string {
+	var rows []string
+	var row string
+	for _, t := range tabs {
+		switch {
+		case row == "":
+			row = t
+		case width > 0 && lipgloss.Width(row)+1+lipgloss.Width(t) > width:
+			rows = append(rows, row)
+			row = t
+		default:
+			row += " " + t
+		}
+	}
+	return strings.Join(append(rows, row), "\n")
 }
 
 // syncCurrentGroup updates currentGroup to match the form's actual focused group.
@@ -0,0 +1,46 @@
+package screens
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+
+	"scaffold/config"
+	"scaffold/internal/formgen"
+	"scaffold/internal/ui/theme/themetest"
+)
+
+func TestSettings_AdvancedToggle(t *testing.T) {
+	s := NewSettings(*config.DefaultConfig())
+	s.ApplyTheme(themetest.State())
+	s.SetWidth(100)
+
+	hidden := formgen.AdvancedCount(s.allGroups)
+	assert.Positive(t, hidden)
+	assert.Zero(t, formgen.AdvancedCount(s.groups), "advanced fields start hidden")
+	assert.Contains(t, ansi.Strip(s.Body()), "advanced settings hidden")
+
+	ctrlX := tea.KeyPressMsg{Code: 'x', Mod: tea.ModCtrl}
+	s.Update(ctrlX)
+	assert.Equal(t, hidden, formgen.AdvancedCount(s.groups))
+	assert.NotContains(t, ansi.Strip(s.Body()), "advanced settings hidden")
+	assert.Equal(t, "hide advanced", s.keys.Advanced.Help().Desc)
+
+	s.Update(ctrlX)
+	assert.Zero(t, formgen.AdvancedCount(s.groups))
+}
+
+func TestSettings_VisibleIf(t *testing.T) {
+	s := NewSettings(*config.DefaultConfig())
+	s.ApplyTheme(themetest.State())
+	s.SetWidth(100)
+	assert.Contains(t, s.shown, "notifications.soundEnabled")
+	focused := s.focusedKey()
+
+	s.cfg.Notifications.EnableNotifications = false
+	s.Update(tea.KeyPressMsg{Code: 'j'})
+	assert.NotContains(t, s.shown, "notifications.soundEnabled")
+	assert.Equal(t, focused, s.focusedKey(), "the rebuilt form keeps the focus")
+}
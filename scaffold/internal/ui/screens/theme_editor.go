@@ -0,0 +1,244 @@
+package screens
+
+import (
+	"image/color"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"scaffold/internal/ui/colorpicker"
+	"scaffold/internal/ui/modal"
+	"scaffold/internal/ui/theme"
+)
+
+// saveAsPromptID identifies the theme editor's save-as prompt.
+const saveAsPromptID = "theme-save-as"
+
+// themeSlots are the core palette colors the editor exposes, in tab order.
+var themeSlots = []struct {
+	id    string
+	label string
+}{
+	{"primary", "Primary"},
+	{"secondary", "Secondary"},
+	{"background", "Background"},
+	{"surface", "Surface"},
+	{"foreground", "Foreground"},
+}
+
+type themeEditorKeyMap struct {
+	NextSlot key.Binding
+	PrevSlot key.Binding
+	SaveAs   key.Binding
+	Back     key.Binding
+}
+
+// ThemeEditor edits the core colors of the active theme for the current
+// light/dark mode. Every change is previewed live across the UI via
+// ThemePreviewMsg, contrast warnings from theme.ValidatePalette are shown
+// inline, and save-as emits ThemeSaveAsMsg for the root model to persist.
+type ThemeEditor struct {
+	theme.ThemeAware
+
+	base     string // theme the edit started from
+	isDark   bool
+	pickers  []colorpicker.Model
+	slot     int
+	warnings []string
+	keys     themeEditorKeyMap
+	loaded   bool // pickers seeded from the first theme state
+}
+
+// NewThemeEditor creates a theme editor. The pickers are seeded from the
+// first theme state the screen receives.
+func NewThemeEditor() *ThemeEditor {
+	return &ThemeEditor{
+		keys: themeEditorKeyMap{
+			NextSlot: key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next color")),
+			PrevSlot: key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "prev color")),
+			SaveAs:   key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "save as")),
+			Back:     key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "discard")),
+		},
+	}
+}
+
+// ScreenID implements nav.Identifiable.
+func (e *ThemeEditor) ScreenID() string { return "theme-editor" }
+
+// Route implements nav.Serializable. Unsaved edits are not persisted.
+func (e *ThemeEditor) Route() string { return "theme-editor" }
+
+// Params implements nav.Serializable.
+func (e *ThemeEditor) Params() map[string]string { return nil }
+
+// CapturingInput implements InputCapturer: the hex inputs take text.
+func (e *ThemeEditor) CapturingInput() bool { return true }
+
+// ApplyTheme implements theme.Themeable. Previews re-apply the theme on every
+// change, so only the first call seeds the pickers.
+func (e *ThemeEditor) ApplyTheme(state theme.State) {
+	e.ApplyThemeState(state)
+	if !e.loaded {
+		e.loaded = true
+		e.base = state.Name
+		e.isDark = state.IsDark
+		p := state.Palette
+		colors := []color.Color{p.Primary, p.Secondary, p.Background, p.Surface, p.Foreground}
+		e.pickers = make([]colorpicker.Model, len(themeSlots))
+		for i, s := range themeSlots {
+			e.pickers[i] = colorpicker.New(s.id, s.label, colors[i])
+		}
+		e.pickers[0].Focus()
+		e.validate()
+	}
+	for i := range e.pickers {
+		e.pickers[i].ApplyPalette(state.Palette)
+	}
+}
+
+// Spec returns the edited theme named name. The colors for the mode not being
+// edited come from the base theme.
+func (e *ThemeEditor) Spec(name string) theme.ThemeSpec {
+	spec, _ := theme.Spec(e.base)
+	if resolved, err := theme.ResolveSpec(spec); err == nil {
+		spec = resolved // a saved copy stands alone
+	}
+	spec.Name = name
+	spec.Modify = nil // hooks cannot be saved, so previews skip them too
+	core := theme.CoreColors{
+		Primary:    e.pickers[0].Color(),
+		Secondary:  e.pickers[1].Color(),
+		Background: e.pickers[2].Color(),
+		Surface:    e.pickers[3].Color(),
+		Foreground: e.pickers[4].Color(),
+	}
+	if !e.isDark {
+		spec.Light = &core
+		return spec
+	}
+	spec.Primary = core.Primary
+	spec.Secondary = core.Secondary
+	spec.Background = core.Background
+	spec.Surface = core.Surface
+	spec.Foreground = core.Foreground
+	return spec
+}
+
+// Warnings returns the contrast warnings for the edited colors.
+func (e *ThemeEditor) Warnings() []string { return e.warnings }
+
+func (e *ThemeEditor) validate() {
+	e.warnings = theme.ValidatePalette(theme.PaletteFromSpec(e.Spec(e.base), e.isDark))
+}
+
+// Init is a no-op.
+func (e *ThemeEditor) Init() tea.Cmd { return nil }
+
+// Update handles slot switching, picker edits, and save/discard.
+func (e *ThemeEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if !e.loaded {
+		return e, nil
+	}
+	switch msg := msg.(type) {
+	case colorpicker.ChangedMsg:
+		e.validate()
+		spec := e.Spec(e.base)
+		return e, func() tea.Msg { return ThemePreviewMsg{Spec: &spec} }
+	case modal.PromptSubmittedMsg:
+		if msg.ID != saveAsPromptID {
+			return e, nil
+		}
+		name := strings.TrimSpace(msg.Value)
+		if err := theme.ValidateThemeName(name); err != nil {
+			return e, modal.ShowAlert("theme-save-error", "Cannot save theme", err.Error())
+		}
+		spec := e.Spec(name)
+		return e, func() tea.Msg { return ThemeSaveAsMsg{Spec: spec} }
+	case tea.KeyPressMsg:
+		switch {
+		case key.Matches(msg, e.keys.NextSlot):
+			return e, e.focusSlot((e.slot + 1) % len(e.pickers))
+		case key.Matches(msg, e.keys.PrevSlot):
+			return e, e.focusSlot((e.slot + len(e.pickers) - 1) % len(e.pickers))
+		case key.Matches(msg, e.keys.SaveAs):
+			return e, modal.ShowPrompt(saveAsPromptID, "Save theme as", "Name for the custom theme (lowercase, digits, dashes)")
+		case key.Matches(msg, e.keys.Back):
+			return e, tea.Batch(
+				func() tea.Msg { return ThemePreviewMsg{} },
+				func() tea.Msg { return BackMsg{} },
+			)
+		}
+	}
+	var cmd tea.Cmd
+	e.pickers[e.slot], cmd = e.pickers[e.slot].Update(msg)
+	return e, cmd
+}
+
+func (e *ThemeEditor) focusSlot(i int) tea.Cmd {
+	e.pickers[e.slot].Blur()
+	e.slot = i
+	return e.pickers[i].Focus()
+}
+
+// View satisfies tea.Model.
+func (e *ThemeEditor) View() tea.View { return tea.NewView(e.Body()) }
+
+// Body returns the renderable content for layout composition.
+func (e *ThemeEditor) Body() string {
+	if !e.loaded {
+		return ""
+	}
+	p := e.Palette()
+	mode := "light"
+	if e.isDark {
+		mode = "dark"
+	}
+	title := lipgloss.NewStyle().Bold(true).Foreground(p.Primary).
+		Render("Theme editor · " + e.base + " (" + mode + ")")
+
+	tabs := make([]string, len(themeSlots))
+	for i, s := range themeSlots {
+		style := lipgloss.NewStyle().Foreground(p.ForegroundMuted).Padding(0, 1)
+		if i == e.slot {
+			style = style.Foreground(p.OnPrimary).Background(p.Primary)
+		}
+		tabs[i] = style.Render(s.label)
+	}
+
+	var warnings string
+	if len(e.warnings) == 0 {
+		warnings = lipgloss.NewStyle().Foreground(p.Success).Render("✓ No contrast warnings")
+	} else {
+		lines := make([]string, len(e.warnings))
+		for i, w := range e.warnings {
+			lines[i] = "⚠ " + w
+		}
+		warnings = lipgloss.NewStyle().Foreground(p.Warning).Render(strings.Join(lines, "\n"))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		title,
+		"",
+		lipgloss.JoinHorizontal(lipgloss.Top, tabs...),
+		"",
+		e.pickers[e.slot].View(),
+		"",
+		warnings,
+	)
+}
+
+// ShortHelp returns key bindings for the help bar.
+func (e *ThemeEditor) ShortHelp() []key.Binding {
+	return []key.Binding{e.keys.NextSlot, e.keys.SaveAs, e.keys.Back}
+}
+
+// FullHelp returns grouped key bindings for the expanded help bar.
+func (e *ThemeEditor) FullHelp() [][]key.Binding {
+	var picker []key.Binding
+	if e.loaded {
+		picker = e.pickers[e.slot].ShortHelp()
+	}
+	return [][]key.Binding{{e.keys.NextSlot, e.keys.PrevSlot, e.keys.SaveAs, e.keys.Back}, picker}
+}
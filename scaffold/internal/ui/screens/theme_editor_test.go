@@ -0,0 +1,66 @@
+package screens
+
+import (
+	"image/color"
+	"testing"
+
+	colorful "github.com/lucasb-eyer/go-colorful"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"scaffold/internal/ui/colorpicker"
+	"scaffold/internal/ui/modal"
+	"scaffold/internal/ui/theme"
+)
+
+func newTestThemeEditor(isDark bool) *ThemeEditor {
+	e := NewThemeEditor()
+	e.ApplyTheme(theme.State{Name: "ocean", IsDark: isDark, Palette: theme.NewPalette("ocean", isDark)})
+	return e
+}
+
+func hexOf(t *testing.T, c color.Color) string {
+	t.Helper()
+	cf, ok := colorful.MakeColor(c)
+	require.True(t, ok)
+	return cf.Hex()
+}
+
+func TestThemeEditor_EditPreviewsLive(t *testing.T) {
+	e := newTestThemeEditor(true)
+	red, _ := colorpicker.ParseHex("#ff0000")
+	e.pickers[0].SetColor(red)
+
+	_, cmd := e.Update(colorpicker.ChangedMsg{ID: "primary", Color: red})
+	require.NotNil(t, cmd)
+	preview, ok := cmd().(ThemePreviewMsg)
+	require.True(t, ok)
+	require.NotNil(t, preview.Spec)
+	assert.Equal(t, "#ff0000", hexOf(t, preview.Spec.Primary))
+	assert.Nil(t, preview.Spec.Modify)
+}
+
+func TestThemeEditor_LightModeEditsLightColors(t *testing.T) {
+	e := newTestThemeEditor(false)
+
+	spec := e.Spec("mine")
+
+	base, _ := theme.Spec("ocean")
+	require.NotNil(t, spec.Light)
+	assert.Equal(t, hexOf(t, base.Primary), hexOf(t, spec.Primary), "dark colors stay as in the base theme")
+}
+
+func TestThemeEditor_SaveAs(t *testing.T) {
+	e := newTestThemeEditor(true)
+
+	_, cmd := e.Update(modal.PromptSubmittedMsg{ID: saveAsPromptID, Value: "ocean"})
+	require.NotNil(t, cmd)
+	_, isAlert := cmd().(modal.ShowMsg)
+	assert.True(t, isAlert, "built-in names are rejected")
+
+	_, cmd = e.Update(modal.PromptSubmittedMsg{ID: saveAsPromptID, Value: " deep-sea "})
+	require.NotNil(t, cmd)
+	saved, ok := cmd().(ThemeSaveAsMsg)
+	require.True(t, ok)
+	assert.Equal(t, "deep-sea", saved.Spec.Name)
+}
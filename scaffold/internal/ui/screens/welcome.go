@@ -5,6 +5,7 @@ import (
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
+	"scaffold/internal/ui/text"
 	"scaffold/internal/ui/theme"
 )
 
@@ -36,6 +37,9 @@ func NewWelcome() *Welcome {
 	}
 }
 
+// ScreenID implements nav.Identifiable.
+func (w *Welcome) ScreenID() string { return "welcome" }
+
 // SetWidth sets the available render width.
 func (w *Welcome) SetWidth(width int) Screen {
 	w.width = width
@@ -69,7 +73,6 @@ func (w *Welcome) Body() string {
 
 	headingStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(p.Primary).
 		MarginBottom(1)
 
 	subStyle := lipgloss.NewStyle().
@@ -96,7 +99,7 @@ func (w *Welcome) Body() string {
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left,
-		headingStyle.Render("Welcome to Scaffold"),
+		headingStyle.Render(text.Gradient("Welcome to Scaffold", theme.Ramp(p, 2))),
 		textStyle.Render("A production-ready BubbleTea v2 application template."),
 		"",
 		subStyle.Render("What's included:"),
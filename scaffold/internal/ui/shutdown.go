@@ -0,0 +1,95 @@
+// Package ui — shutting down on SIGTERM and SIGHUP.
+package ui
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"scaffold/internal/logger"
+)
+
+// shutdownGrace is how long Run waits, after the program quits, for the
+// background funcs to see the cancelled context and return.
+const shutdownGrace = 2 * time.Second
+
+// SignalError is returned by Run when the program was shut down by a
+// signal, after the UI quit cleanly and its state was saved.
+type SignalError struct {
+	Signal os.Signal
+}
+
+// Error implements error.
+func (e SignalError) Error() string {
+	return fmt.Sprintf("shut down by %v", e.Signal)
+}
+
+// ExitCode returns the shell's status for a process ended by the signal,
+// 128 plus its number: 143 for SIGTERM, 129 for SIGHUP.
+func (e SignalError) ExitCode() int {
+	if s, ok := e.Signal.(syscall.Signal); ok {
+		return 128 + int(s)
+	}
+	return 1
+}
+
+// shutdownMsg asks the program to quit because sig was received.
+type shutdownMsg struct {
+	sig os.Signal
+}
+
+// handleSignals forwards SIGTERM and SIGHUP to p as a shutdownMsg, and
+// SIGINT, which only arrives when input is not a terminal, as an interrupt,
+// until the returned stop func is called. After the first signal the default
+// handlers are restored, so a second one ends a shutdown that hangs.
+func handleSignals(p *tea.Program) (stop func()) {
+	sigs := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	go func() {
+		select {
+		case sig := <-sigs:
+			signal.Stop(sigs)
+			if sig == syscall.SIGINT {
+				p.Send(tea.InterruptMsg{})
+				return
+			}
+			p.Send(shutdownMsg{sig: sig})
+		case <-done:
+		}
+	}()
+	return func() {
+		signal.Stop(sigs)
+		close(done)
+	}
+}
+
+func (m rootModel) handleShutdown(msg shutdownMsg) (tea.Model, tea.Cmd) {
+	logger.Debug("received %v, shutting down", msg.sig)
+	m.quitSignal = msg.sig
+	return m, tea.Quit
+}
+
+// stopBackground cancels the app context and waits up to shutdownGrace for
+// the background funcs to return, so they can finish or abandon their
+// current work cleanly.
+func (m rootModel) stopBackground(wg *sync.WaitGroup) {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(shutdownGrace):
+		logger.Debug("background work still running after %v", shutdownGrace)
+	}
+}
@@ -0,0 +1,183 @@
+// Package ui — deterministic frame snapshots for visual regression checks.
+package ui
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"scaffold/config"
+	"scaffold/internal/task"
+	"scaffold/internal/ui/menu"
+	"scaffold/internal/ui/modal"
+	"scaffold/internal/ui/screens"
+	"scaffold/internal/ui/status"
+	"scaffold/internal/ui/theme"
+)
+
+// Snapshot rendering parameters. They are fixed so that two runs of the same
+// build always produce byte-identical frames.
+const (
+	SnapshotWidth  = 100
+	SnapshotHeight = 30
+	snapshotSeed   = 1
+)
+
+// Frame is a single rendered screen captured by Snapshot.
+type Frame struct {
+	Name    string // file-safe identifier, e.g. "03-detail"
+	Content string // plain-text render with ANSI sequences stripped
+}
+
+// snapshotRunner drives a rootModel by feeding messages directly to Update.
+// Commands returned by Update are never executed, so no timers, tasks, or
+// wall-clock reads can influence the rendered output.
+type snapshotRunner struct {
+	m      rootModel
+	frames []Frame
+}
+
+func (r *snapshotRunner) send(msg tea.Msg) {
+	updated, _ := r.m.Update(msg)
+	r.m = updated.(rootModel)
+}
+
+// syncTheme delivers the theme manager's current state as a ThemeChangedMsg.
+// The manager mutates its state synchronously, so this is equivalent to
+// running the command it returned without executing arbitrary commands.
+func (r *snapshotRunner) syncTheme() {
+	r.send(theme.ThemeChangedMsg{State: r.m.themeMgr.State()})
+}
+
+func (r *snapshotRunner) capture(name string) {
+	r.frames = append(r.frames, Frame{
+		Name:    fmt.Sprintf("%02d-%s", len(r.frames)+1, name),
+		Content: plainFrame(r.m.View().Content),
+	})
+}
+
+// Snapshot renders a fixed sequence of screens at SnapshotWidth×SnapshotHeight
+// using the default config, a dark background, and a fixed random seed.
+func Snapshot() []Frame {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	menu.SetASCIIIcons(false)
+	cfg := *config.DefaultConfig()
+	m := newRootModel(ctx, cancel, cfg, "", false)
+	m.rng = rand.New(rand.NewSource(snapshotSeed))
+	m.themeMgr.Init(cfg.UI.ThemeName, false, 0)
+
+	r := &snapshotRunner{m: m}
+	r.send(tea.WindowSizeMsg{Width: SnapshotWidth, Height: SnapshotHeight})
+	r.send(tea.BackgroundColorMsg{Color: color.Black})
+	r.syncTheme()
+	r.capture("home")
+
+	r.send(tea.KeyPressMsg{Code: tea.KeyDown})
+	r.capture("home-cursor")
+
+	r.send(menu.SelectionMsg{Item: menu.NewItem("Dashboard", "View application dashboard", "dashboard")})
+	r.capture("detail-loading")
+
+	r.send(task.DoneMsg[string]{Label: "detail-load", Value: "loaded"})
+	r.capture("detail")
+
+	r.send(screens.BackMsg{})
+	r.send(menu.SelectionMsg{Item: menu.NewItem("Settings", "Configure application settings", "settings")})
+	r.capture("settings")
+
+	r.send(modal.ShowMsg{ID: "snapshot", Kind: modal.KindConfirm, Title: "Confirm", Body: "Snapshot modal"})
+	r.capture("modal-confirm")
+
+	r.send(modal.CancelledMsg{ID: "snapshot"})
+	r.send(screens.BackMsg{})
+	r.send(NavigateMsg{Screen: screens.NewWelcome()})
+	r.capture("welcome")
+
+	r.send(screens.BackMsg{})
+	r.send(tea.KeyPressMsg{Code: 't', Mod: tea.ModCtrl})
+	r.syncTheme()
+	// Mirror the status command handleRandomTheme returns so the seeded pick
+	// is visible in the plain-text frame.
+	r.send(status.Msg{Text: "Theme: " + r.m.cfg.UI.ThemeName, Kind: status.KindInfo})
+	r.capture("random-theme")
+
+	return r.frames
+}
+
+// plainFrame strips ANSI sequences and trailing whitespace so frames diff
+// cleanly as text files.
+func plainFrame(s string) string {
+	lines := strings.Split(ansi.Strip(s), "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " ")
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// WriteSnapshots writes each frame to dir as <name>.txt, creating dir if needed.
+func WriteSnapshots(dir string, frames []Frame) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("snapshot: creating directory: %w", err)
+	}
+	for _, f := range frames {
+		path := filepath.Join(dir, f.Name+".txt")
+		if err := os.WriteFile(path, []byte(f.Content), 0o644); err != nil {
+			return fmt.Errorf("snapshot: writing %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// CompareSnapshots compares frames against the golden files in dir and returns
+// one human-readable description per mismatch. An empty result means every
+// frame matched. Missing golden files are reported as mismatches.
+func CompareSnapshots(dir string, frames []Frame) ([]string, error) {
+	var diffs []string
+	for _, f := range frames {
+		path := filepath.Join(dir, f.Name+".txt")
+		want, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			diffs = append(diffs, fmt.Sprintf("%s: golden file missing", f.Name))
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("snapshot: reading %s: %w", path, err)
+		}
+		if d := diffFrame(string(want), f.Content); d != "" {
+			diffs = append(diffs, fmt.Sprintf("%s: %s", f.Name, d))
+		}
+	}
+	return diffs, nil
+}
+
+// diffFrame reports the first differing line between want and got, or ""
+// when they are identical.
+func diffFrame(want, got string) string {
+	if want == got {
+		return ""
+	}
+	wl := strings.Split(want, "\n")
+	gl := strings.Split(got, "\n")
+	for i := range max(len(wl), len(gl)) {
+		var w, g string
+		if i < len(wl) {
+			w = wl[i]
+		}
+		if i < len(gl) {
+			g = gl[i]
+		}
+		if w != g {
+			return fmt.Sprintf("line %d: want %q, got %q", i+1, w, g)
+		}
+	}
+	return "content differs"
+}
@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// updateSnapshots regenerates the golden frames:
+//
+//	go test ./internal/ui -run TestSnapshot -update
+var updateSnapshots = flag.Bool("update", false, "rewrite golden snapshot frames")
+
+var snapshotGoldenDir = filepath.Join("testdata", "snapshots")
+
+func TestSnapshot_MatchesGolden(t *testing.T) {
+	frames := Snapshot()
+	if *updateSnapshots {
+		require.NoError(t, WriteSnapshots(snapshotGoldenDir, frames))
+	}
+
+	diffs, err := CompareSnapshots(snapshotGoldenDir, frames)
+	require.NoError(t, err)
+	assert.Empty(t, diffs, "rendered frames differ from golden files; rerun with -update if the change is intended")
+}
+
+func TestSnapshot_IsDeterministic(t *testing.T) {
+	first := Snapshot()
+	second := Snapshot()
+	assert.Equal(t, first, second, "two snapshot runs must render identical frames")
+}
+
+func TestDiffFrame_ReportsFirstDifferingLine(t *testing.T) {
+	assert.Empty(t, diffFrame("a\nb\n", "a\nb\n"))
+	assert.Equal(t, `line 2: want "b", got "c"`, diffFrame("a\nb\n", "a\nc\n"))
+}
@@ -0,0 +1,71 @@
+// Package ui — global state stripe for rootModel.
+package ui
+
+import (
+	"image/color"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"scaffold/internal/ui/status"
+	"scaffold/internal/ui/theme"
+)
+
+// StateColor reports the app's global state for the state stripe, a line
+// above the header, as the status kind whose theme color it is drawn in:
+// for example KindSuccess while tests pass, KindError after a failed run and
+// KindWarning while paused. ok is false when there is no state to show.
+// It is called on every render, so it must be cheap and safe to call from
+// the UI goroutine.
+type StateColor func() (kind status.Kind, ok bool)
+
+// StateChangedMsg makes the root model redraw the state stripe. Send it
+// through the program after changing the state a StateColor reports.
+type StateChangedMsg struct{}
+
+// WithStateColor returns m with fn as its StateColor. The stripe is shown
+// while the State Stripe setting is on.
+func (m rootModel) WithStateColor(fn StateColor) rootModel {
+	m.stateColor = fn
+	m.bodyH = m.bodyHeight()
+	return m
+}
+
+// stripeLines returns how many lines the state stripe takes. The line is
+// kept while there is no state to show, so the layout does not jump.
+func (m rootModel) stripeLines() int {
+	if m.stateColor == nil || !m.cfg.UI.StateStripe {
+		return 0
+	}
+	return 1
+}
+
+// stripeView renders the state stripe across the content width, blank when
+// there is no state to show.
+func (m rootModel) stripeView() string {
+	if m.stripeLines() == 0 {
+		return ""
+	}
+	kind, ok := m.stateColor()
+	c := stateKindColor(m.themeMgr.State().Palette, kind)
+	if !ok || c == nil {
+		return strings.Repeat(" ", m.styles.MaxWidth)
+	}
+	return lipgloss.NewStyle().Foreground(c).Render(strings.Repeat("▀", m.styles.MaxWidth))
+}
+
+// stateKindColor returns the palette's status color for kind, or nil for
+// KindNone.
+func stateKindColor(p theme.Palette, kind status.Kind) color.Color {
+	switch kind {
+	case status.KindSuccess:
+		return p.Success
+	case status.KindError:
+		return p.Error
+	case status.KindWarning:
+		return p.Warning
+	case status.KindInfo:
+		return p.Info
+	}
+	return nil
+}
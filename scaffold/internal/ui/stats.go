@@ -0,0 +1,68 @@
+// Package ui — local usage stats for rootModel.
+package ui
+
+import (
+	"path/filepath"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"scaffold/internal/logger"
+	"scaffold/internal/stats"
+	"scaffold/internal/ui/nav"
+	"scaffold/internal/ui/screens"
+	"scaffold/internal/ui/status"
+)
+
+// statsPath returns where usage stats are kept, or "" when there is no
+// config file to sit beside.
+func (m rootModel) statsPath() string {
+	if m.configPath == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(m.configPath), stats.File)
+}
+
+// startStats opens the usage stats and counts a new session on the screen
+// the app opens on, unless the config turns stats off.
+func (m *rootModel) startStats(now time.Time) {
+	path := m.statsPath()
+	if path == "" || m.cfg.App.DisableStats {
+		return
+	}
+	var err error
+	if m.stats, err = stats.Open(path); err != nil {
+		logger.Debug("stats: %v (starting afresh)", err)
+	}
+	m.stats.StartSession(now)
+	m.stats.Screen(screenRoute(m.stack.Top()))
+}
+
+// recordStats counts msg in the usage stats: user input (key presses,
+// clicks, wheel scrolls and pastes) as iterations, screens when pushed or
+// swapped in, and error statuses against the active screen. Ticks, resizes,
+// mouse motion and the like are not counted, so the iterations follow use
+// rather than time left idle.
+func (m rootModel) recordStats(msg tea.Msg) {
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg, tea.MouseClickMsg, tea.MouseWheelMsg, tea.PasteMsg:
+		m.stats.Iteration()
+	case nav.PushedMsg:
+		m.stats.Screen(screenRoute(msg.To))
+	case nav.ReplacedMsg:
+		m.stats.Screen(screenRoute(msg.To))
+	case status.Msg:
+		if msg.Kind == status.KindError {
+			m.stats.Error(screenRoute(m.stack.Top()))
+		}
+	}
+}
+
+// screenRoute names a screen for the stats by its route, which leaves out
+// parameters such as ids, falling back to its ID or type.
+func screenRoute(s screens.Screen) string {
+	if r, ok := s.(nav.Serializable); ok {
+		return r.Route()
+	}
+	return screenName(s)
+}
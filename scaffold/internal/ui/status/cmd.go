@@ -22,12 +22,10 @@ func Set(text string, kind Kind, duration time.Duration) tea.Cmd {
 	}
 }
 
-// SetWithClear sets a status message and schedules automatic clearing.
+// SetWithClear sets a status message that clears itself after duration.
+// The Queue showing it times it, so a message set meanwhile is not cut short.
 func SetWithClear(text string, kind Kind, duration time.Duration) tea.Cmd {
-	return tea.Batch(
-		Set(text, kind, duration),
-		tea.Tick(duration, func(time.Time) tea.Msg { return ClearMsg{} }),
-	)
+	return Set(text, kind, duration)
 }
 
 // SetInfo sets an informational status message.
@@ -66,7 +64,8 @@ func SetError(text string, duration time.Duration) tea.Cmd {
 	return SetWithClear(text, KindError, duration)
 }
 
-// Clear returns a command that clears the status.
+// Clear returns a command that clears the status and any messages waiting
+// to be shown.
 func Clear() tea.Cmd {
 	return func() tea.Msg { return ClearMsg{} }
 }
@@ -0,0 +1,161 @@
+package status
+
+import (
+	"slices"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// MinDisplay is the shortest time a message is shown before a newer one
+// takes its place; messages posted sooner wait their turn.
+var MinDisplay = 1500 * time.Millisecond
+
+// HistorySize is how many messages a Queue remembers.
+const HistorySize = 100
+
+// Entry is a message in a Queue's history.
+type Entry struct {
+	Text  string
+	Kind  Kind
+	At    time.Time // when it was last posted
+	Count int       // times it was posted in a row
+}
+
+// queueTickMsg shows the next queued message or clears an expired one.
+// Ticks from before the queue last changed carry an older gen and are
+// ignored.
+type queueTickMsg struct {
+	gen int
+	at  time.Time
+}
+
+// Queue shows status messages one at a time. Each stays up for at least
+// MinDisplay, so messages posted in quick succession are all seen rather
+// than overwriting each other, and then for its Duration unless a newer one
+// is waiting. A message posted again while it is shown or waiting is
+// counted once. Every message is kept in a bounded history.
+//
+// The zero value is an empty queue. Like the breaks timer, the methods take
+// the current time, so it can be tested without waiting; Update reads the
+// clock.
+type Queue struct {
+	current Msg       // Text is empty when nothing is shown
+	since   time.Time // when current was shown, or last posted again
+	pending []Msg
+	history []Entry // oldest first
+	gen     int
+}
+
+// Current returns the message shown, with an empty Text when there is none.
+func (q Queue) Current() State {
+	return State{Text: q.current.Text, Kind: q.current.Kind}
+}
+
+// Pending returns how many messages are waiting to be shown.
+func (q Queue) Pending() int {
+	return len(q.pending)
+}
+
+// History returns the messages posted, oldest first.
+func (q Queue) History() []Entry {
+	return slices.Clone(q.history)
+}
+
+// Update posts Msgs, clears the queue on ClearMsg and moves it on on its
+// own ticks.
+func (q Queue) Update(msg tea.Msg) (Queue, tea.Cmd) {
+	switch msg := msg.(type) {
+	case Msg:
+		return q.Push(msg, time.Now())
+	case ClearMsg:
+		return q.Clear(), nil
+	case queueTickMsg:
+		if msg.gen != q.gen {
+			return q, nil
+		}
+		return q.Advance(msg.at)
+	}
+	return q, nil
+}
+
+// Push posts msg at now: it is shown at once when the message shown has
+// been up for MinDisplay, and queued otherwise.
+func (q Queue) Push(msg Msg, now time.Time) (Queue, tea.Cmd) {
+	q.history = record(q.history, msg, now)
+	switch {
+	case q.current.Text != "" && sameMsg(q.current, msg):
+		q.current = msg
+		if len(q.pending) == 0 {
+			q.since = now // show it for its duration again
+		}
+	case len(q.pending) > 0 && sameMsg(q.pending[len(q.pending)-1], msg):
+		q.pending = slices.Clone(q.pending)
+		q.pending[len(q.pending)-1] = msg
+	case q.current.Text == "" || len(q.pending) == 0 && !now.Before(q.since.Add(MinDisplay)):
+		q.current, q.since = msg, now
+	default:
+		q.pending = append(slices.Clip(q.pending), msg)
+	}
+	return q, q.schedule(now)
+}
+
+// Advance moves q on to now: the next queued message is shown once the
+// current one has been up for MinDisplay, and the current one cleared once
+// its Duration is over.
+func (q Queue) Advance(now time.Time) (Queue, tea.Cmd) {
+	switch {
+	case len(q.pending) > 0 && !now.Before(q.since.Add(MinDisplay)):
+		q.current, q.since = q.pending[0], now
+		q.pending = slices.Delete(slices.Clone(q.pending), 0, 1)
+	case len(q.pending) == 0 && q.current.Duration > 0 && !now.Before(q.since.Add(q.current.Duration)):
+		q.current = Msg{}
+	}
+	return q, q.schedule(now)
+}
+
+// Clear removes the message shown and those waiting. The history is kept.
+func (q Queue) Clear() Queue {
+	q.current, q.pending = Msg{}, nil
+	q.gen++
+	return q
+}
+
+// schedule returns the tick for the next change to q, or nil when the
+// message shown stays until cleared.
+func (q *Queue) schedule(now time.Time) tea.Cmd {
+	q.gen++
+	var at time.Time
+	switch {
+	case len(q.pending) > 0:
+		at = q.since.Add(MinDisplay)
+	case q.current.Text != "" && q.current.Duration > 0:
+		at = q.since.Add(q.current.Duration)
+	default:
+		return nil
+	}
+	gen := q.gen
+	return tea.Tick(max(at.Sub(now), 0), func(t time.Time) tea.Msg { return queueTickMsg{gen: gen, at: t} })
+}
+
+// sameMsg reports whether a and b say the same thing.
+func sameMsg(a, b Msg) bool {
+	return a.Text == b.Text && a.Kind == b.Kind
+}
+
+// record adds msg, posted at now, to history, counting it with the last
+// entry when it repeats it and dropping the oldest beyond HistorySize.
+// history is not modified.
+func record(history []Entry, msg Msg, now time.Time) []Entry {
+	if n := len(history); n > 0 && history[n-1].Text == msg.Text && history[n-1].Kind == msg.Kind {
+		history = slices.Clone(history)
+		history[n-1].At = now
+		history[n-1].Count++
+		return history
+	}
+	history = append(slices.Clip(history), Entry{Text: msg.Text, Kind: msg.Kind, At: now, Count: 1})
+	if len(history) > HistorySize {
+		history = history[len(history)-HistorySize:]
+	}
+	return history
+}
@@ -0,0 +1,82 @@
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueue_ShowsEachMessageForMinDisplay(t *testing.T) {
+	now := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+	var q Queue
+	q, _ = q.Push(Msg{Text: "one", Kind: KindInfo, Duration: 3 * time.Second}, now)
+	q, cmd := q.Push(Msg{Text: "two", Kind: KindError, Duration: 5 * time.Second}, now.Add(10*time.Millisecond))
+	require.NotNil(t, cmd)
+	assert.Equal(t, State{Text: "one", Kind: KindInfo}, q.Current(), "a message is not overwritten at once")
+	assert.Equal(t, 1, q.Pending())
+
+	q, _ = q.Advance(now.Add(MinDisplay))
+	assert.Equal(t, State{Text: "two", Kind: KindError}, q.Current())
+	assert.Zero(t, q.Pending())
+
+	q, _ = q.Advance(now.Add(MinDisplay + 4*time.Second))
+	assert.Equal(t, "two", q.Current().Text, "shown for its own duration")
+	q, cmd = q.Advance(now.Add(MinDisplay + 5*time.Second))
+	assert.Empty(t, q.Current().Text)
+	assert.Nil(t, cmd, "nothing left to time")
+
+	q, _ = q.Push(Msg{Text: "three", Kind: KindInfo, Duration: time.Second}, now.Add(time.Minute))
+	assert.Equal(t, "three", q.Current().Text, "an empty queue shows a message at once")
+}
+
+func TestQueue_CoalescesDuplicates(t *testing.T) {
+	now := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+	saved := Msg{Text: "Saved", Kind: KindSuccess, Duration: 3 * time.Second}
+	var q Queue
+	q, _ = q.Push(saved, now)
+	q, _ = q.Push(saved, now.Add(2*time.Second))
+	q, _ = q.Advance(now.Add(4 * time.Second))
+	assert.Equal(t, "Saved", q.Current().Text, "posting again restarts its duration")
+	assert.Zero(t, q.Pending())
+
+	failed := Msg{Text: "Failed", Kind: KindError, Duration: 3 * time.Second}
+	q, _ = q.Push(Msg{Text: "Retrying", Kind: KindInfo}, now.Add(4*time.Second))
+	q, _ = q.Push(failed, now.Add(4*time.Second))
+	q, _ = q.Push(failed, now.Add(4*time.Second))
+	assert.Equal(t, "Retrying", q.Current().Text)
+	assert.Equal(t, 1, q.Pending(), "a repeat of a waiting message waits once")
+
+	h := q.History()
+	require.Len(t, h, 3)
+	assert.Equal(t, Entry{Text: "Saved", Kind: KindSuccess, At: now.Add(2 * time.Second), Count: 2}, h[0])
+	assert.Equal(t, 2, h[2].Count)
+}
+
+func TestQueue_StaleTicksAndClear(t *testing.T) {
+	now := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+	var q Queue
+	q, _ = q.Push(Msg{Text: "one", Kind: KindInfo, Duration: time.Second}, now)
+	stale := queueTickMsg{gen: q.gen, at: now.Add(time.Second)}
+	q, _ = q.Push(Msg{Text: "one", Kind: KindInfo, Duration: time.Second}, now.Add(900*time.Millisecond))
+	q, _ = q.Update(stale)
+	assert.Equal(t, "one", q.Current().Text, "a tick from before the last change is ignored")
+
+	q, _ = q.Push(Msg{Text: "two", Kind: KindInfo}, now.Add(time.Second))
+	q, _ = q.Update(ClearMsg{})
+	assert.Empty(t, q.Current().Text)
+	assert.Zero(t, q.Pending())
+	assert.Len(t, q.History(), 2, "clearing keeps the history")
+}
+
+func TestQueue_HistoryIsBounded(t *testing.T) {
+	now := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+	var q Queue
+	for i := range HistorySize + 5 {
+		q, _ = q.Push(Msg{Text: string(rune('a' + i%26)), Kind: KindInfo}, now)
+	}
+	h := q.History()
+	assert.Len(t, h, HistorySize)
+	assert.Equal(t, string(rune('a'+(HistorySize+4)%26)), h[len(h)-1].Text)
+}
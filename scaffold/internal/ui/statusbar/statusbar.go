@@ -1,44 +1,58 @@
 // Package statusbar provides the self-contained footer / status-bar component
-// for the TUI. It owns the status state and renders the full footer line
+// for the TUI. It owns the status queue and renders the full footer line
 // including the left status message and the right version/debug indicator.
 package statusbar
 
 import (
+	"slices"
+	"strings"
+
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
 
 	"scaffold/config"
 	"scaffold/internal/ui/status"
 	"scaffold/internal/ui/theme"
+	"scaffold/internal/version"
 )
 
 // Model is the statusbar component.
 type Model struct {
-	state     status.State
+	queue     status.Queue
 	statusSty status.Styles
 	footerSty lipgloss.Style
 	rightSty  lipgloss.Style
 	cfg       config.Config
 	maxW      int
+	segments  []Segment // in order of first appearance
+	narration string    // shown in place of the status while set
+}
+
+// Segment is a short piece of text contributed by something other than the
+// app, such as a plugin, shown to the left of the version.
+type Segment struct {
+	Source string // who contributed it; a later segment from it replaces this one
+	Text   string
 }
 
+// SegmentMsg sets the segment of Segment.Source. An empty Text removes it.
+type SegmentMsg Segment
+
 // New creates a statusbar Model. Styles are populated on the first
 // ThemeChangedMsg; until then View returns an unstyled empty string.
 func New(cfg config.Config) Model {
-	return Model{
-		cfg:   cfg,
-		state: status.State{Text: "Ready", Kind: status.KindNone},
-	}
+	return Model{cfg: cfg}
 }
 
 // Update handles messages relevant to the statusbar.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
-	switch msg := msg.(type) {
-	case status.Msg:
-		m.state = status.State{Text: msg.Text, Kind: msg.Kind}
+	var cmd tea.Cmd
+	m.queue, cmd = m.queue.Update(msg)
 
-	case status.ClearMsg:
-		m.state = status.State{Text: "Ready", Kind: status.KindNone}
+	switch msg := msg.(type) {
+	case SegmentMsg:
+		m.segments = setSegment(m.segments, Segment(msg))
 
 	case theme.ThemeChangedMsg:
 		p := msg.State.Palette
@@ -53,36 +67,86 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 		m.rightSty = lipgloss.NewStyle().Foreground(p.ForegroundSubtle)
 
-		// Mirror the MaxWidth calculation from theme.newStylesFromPalette so that
-		// the gap arithmetic matches the rest of the layout.
-		w := msg.State.Width
-		maxWidth := w * 90 / 100
-		if maxWidth < 40 {
-			maxWidth = w - 4
-		}
-		m.maxW = maxWidth
+		// Match Styles.MaxWidth so the gap arithmetic fits the layout.
+		m.maxW = theme.ContentWidth(msg.State.Width)
 	}
 
-	return m, nil
+	return m, cmd
 }
 
-// State returns the current status state. Exposed for tests.
+// State returns the current status state, "Ready" when no message is shown.
+// Exposed for tests.
 func (m Model) State() status.State {
-	return m.state
+	if st := m.queue.Current(); st.Text != "" {
+		return st
+	}
+	return status.State{Text: "Ready", Kind: status.KindNone}
+}
+
+// History returns the status messages shown this session, oldest first.
+func (m Model) History() []status.Entry {
+	return m.queue.History()
 }
 
-// View renders the full footer: left status badge + spacer + right version text.
+// WithNarration returns m showing text in place of the status message until
+// it is called again with "". Status messages that arrive meanwhile are
+// kept, so the status reappears when the narration ends.
+func (m Model) WithNarration(text string) Model {
+	m.narration = text
+	return m
+}
+
+// Segments returns the contributed segments. Exposed for tests.
+func (m Model) Segments() []Segment {
+	return m.segments
+}
+
+// setSegment replaces or appends seg in segs, or removes it when its text is
+// empty. segs is not modified.
+func setSegment(segs []Segment, seg Segment) []Segment {
+	i := slices.IndexFunc(segs, func(s Segment) bool { return s.Source == seg.Source })
+	switch {
+	case i < 0 && seg.Text == "":
+		return segs
+	case i < 0:
+		return append(slices.Clip(segs), seg)
+	case seg.Text == "":
+		return slices.Delete(slices.Clone(segs), i, i+1)
+	}
+	segs = slices.Clone(segs)
+	segs[i] = seg
+	return segs
+}
+
+// View renders the full footer: left status badge + spacer + right segments
+// and version text.
 func (m Model) View() tea.View {
-	left := m.statusSty.Render(m.state.Text, m.state.Kind)
+	st := m.State()
+	left := m.statusSty.Render(st.Text, st.Kind)
+	if m.narration != "" {
+		left = m.statusSty.Render(m.narration, status.KindInfo)
+	}
 
-	rightContent := " v" + m.cfg.App.Version
+	rightContent := " v" + version.Version
+	if len(m.segments) > 0 {
+		texts := make([]string, len(m.segments))
+		for i, seg := range m.segments {
+			texts[i] = seg.Text
+		}
+		rightContent = " " + strings.Join(texts, " · ") + " │" + rightContent
+	}
 	if m.cfg.Debug {
 		rightContent += " [DEBUG]"
 	}
 	right := m.rightSty.Render(rightContent + " ")
 
-	// Account for footer border (2) and padding (1).
-	innerWidth := m.maxW - 3
+	// Account for footer border (2) and padding (1). When both sides do not
+	// fit, the version is dropped and the status truncated.
+	innerWidth := max(m.maxW-3, 1)
+	if lipgloss.Width(left)+lipgloss.Width(right) > innerWidth {
+		right = ""
+		left = ansi.Truncate(left, innerWidth, "…")
+	}
 	gapW := max(0, innerWidth-lipgloss.Width(left)-lipgloss.Width(right))
 	gap := lipgloss.NewStyle().Width(gapW).Render("")
 
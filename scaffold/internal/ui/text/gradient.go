@@ -0,0 +1,66 @@
+// Package text provides helpers for styling plain strings, as opposed to
+// the figlet art of package banner.
+package text
+
+import (
+	"image/color"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/rivo/uniseg"
+
+	"scaffold/internal/ui/theme"
+)
+
+// Gradient colors s from the first of stops to the last, blending in HCL
+// through any stops in between (see [theme.RampThrough]). Each grapheme
+// cluster gets one color, so emoji, flags and combined accents are never
+// split. Line breaks are kept and do not take a step of the gradient.
+//
+// Only the foreground color is set, and it is reset to the default at the
+// end, so attributes such as bold from a style s is rendered in are kept.
+// With no stops s is returned unchanged.
+func Gradient(s string, stops []color.Color) string {
+	if s == "" || len(stops) == 0 {
+		return s
+	}
+	var clusters []string
+	steps := 0
+	g := uniseg.NewGraphemes(s)
+	for g.Next() {
+		c := g.Str()
+		clusters = append(clusters, c)
+		if !isLineBreak(c) {
+			steps++
+		}
+	}
+	colors := theme.RampThrough(steps, stops...)
+
+	var b strings.Builder
+	i := 0
+	for _, c := range clusters {
+		if isLineBreak(c) {
+			b.WriteString(c)
+			continue
+		}
+		b.WriteString(ansi.Style{}.ForegroundColor(sgrColor(colors[i])).String())
+		b.WriteString(c)
+		i++
+	}
+	b.WriteString(ansi.Style{}.ForegroundColor(nil).String())
+	return b.String()
+}
+
+func isLineBreak(cluster string) bool {
+	return cluster == "\n" || cluster == "\r\n"
+}
+
+// sgrColor returns c for an SGR sequence, with lipgloss.NoColor, as used by
+// monochrome palettes, as the terminal's default color.
+func sgrColor(c color.Color) color.Color {
+	if _, ok := c.(lipgloss.NoColor); ok {
+		return nil
+	}
+	return c
+}
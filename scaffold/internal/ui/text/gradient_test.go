@@ -0,0 +1,46 @@
+package text
+
+import (
+	"image/color"
+	"strings"
+	"testing"
+
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	red  = lipgloss.Color("#ff0000")
+	blue = lipgloss.Color("#0000ff")
+)
+
+func TestGradient_ColorsEachCluster(t *testing.T) {
+	out := Gradient("abc", []color.Color{red, blue})
+
+	assert.Equal(t, "abc", ansi.Strip(out))
+	assert.True(t, strings.HasPrefix(out, ansi.Style{}.ForegroundColor(red).String()+"a"), "starts at the first stop")
+	assert.Contains(t, out, ansi.Style{}.ForegroundColor(blue).String()+"c", "ends at the last stop")
+	assert.True(t, strings.HasSuffix(out, ansi.Style{}.ForegroundColor(nil).String()))
+	assert.NotContains(t, out, ansi.ResetStyle, "other attributes are kept")
+}
+
+func TestGradient_KeepsGraphemeClusters(t *testing.T) {
+	family := "👨‍👩‍👧"
+	out := Gradient("e\u0301"+family, []color.Color{red, blue})
+
+	assert.Contains(t, out, "e\u0301", "a combining accent stays with its letter")
+	assert.Contains(t, out, family, "a ZWJ sequence is colored as one")
+	assert.Equal(t, 3, strings.Count(out, "\x1b["), "two clusters and the reset")
+}
+
+func TestGradient_LineBreaks(t *testing.T) {
+	out := Gradient("ab\ncd", []color.Color{red, blue})
+	assert.Equal(t, "ab\ncd", ansi.Strip(out))
+	assert.Contains(t, out, "b\n\x1b[", "the break is not colored")
+}
+
+func TestGradient_NothingToDo(t *testing.T) {
+	assert.Equal(t, "abc", Gradient("abc", nil))
+	assert.Equal(t, "", Gradient("", []color.Color{red}))
+}
@@ -0,0 +1,174 @@
+package theme
+
+import (
+	"fmt"
+	"image/color"
+	"os"
+	"path/filepath"
+	"strings"
+
+	colorful "github.com/lucasb-eyer/go-colorful"
+	"gopkg.in/yaml.v3"
+)
+
+// ImportBase16 reads a base16 color scheme from a YAML file and maps it onto
+// a ThemeSpec. Both the classic flat format (scheme, base00…base0F) and the
+// tinted-theming format (system, variant, palette) are accepted; base24
+// schemes work too, using their base16 subset.
+//
+// The theme is named after the file, e.g. "gruvbox-dark-hard.yaml" becomes
+// "gruvbox-dark-hard". Colors follow the base16 styling guidelines:
+//
+//	base00 → Background   base0D → Primary   base08 → Error
+//	base01 → Surface      base0E → Secondary base0B → Success
+//	base05 → Foreground                      base0A → Warning
+//	                                         base0C → Info
+//
+// The scheme's own variant uses its status colors; the opposite variant is
+// derived by inverting lightness and keeps the default status colors.
+func ImportBase16(path string) (ThemeSpec, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return ThemeSpec{}, fmt.Errorf("theme: reading %s: %w", path, err)
+	}
+	var doc base16File
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return ThemeSpec{}, fmt.Errorf("theme: decoding %s: %w", path, err)
+	}
+
+	name := slugThemeName(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
+	if err := ValidateThemeName(name); err != nil {
+		return ThemeSpec{}, err
+	}
+
+	colors := doc.Flat
+	if doc.System != "" {
+		if doc.System != "base16" && doc.System != "base24" {
+			return ThemeSpec{}, fmt.Errorf("theme: %s: unsupported scheme system %q", path, doc.System)
+		}
+		colors = doc.Palette
+	}
+
+	var base [16]color.Color
+	for i := range base {
+		k := fmt.Sprintf("base%02X", i)
+		v := string(colors[k])
+		if v == "" {
+			return ThemeSpec{}, fmt.Errorf("theme: %s: missing %s", path, k)
+		}
+		cf, err := colorful.Hex("#" + strings.TrimPrefix(strings.TrimSpace(v), "#"))
+		if err != nil {
+			return ThemeSpec{}, fmt.Errorf("theme: %s: %s: %q is not a hex color", path, k, v)
+		}
+		base[i] = cf
+	}
+
+	core := CoreColors{
+		Primary:    base[0x0D],
+		Secondary:  base[0x0E],
+		Background: base[0x00],
+		Surface:    base[0x01],
+		Foreground: base[0x05],
+	}
+	status := &paletteOverrides{
+		errColor: base[0x08],
+		warning:  base[0x0A],
+		success:  base[0x0B],
+		info:     base[0x0C],
+	}
+
+	isLight := hclLightness(core.Background) > hclLightness(core.Foreground)
+	if doc.Variant != "" {
+		isLight = doc.Variant == "light"
+	}
+	return specFromScheme(name, core, status, isLight), nil
+}
+
+// specFromScheme builds a ThemeSpec from a scheme's core and status colors.
+// core is the light variant when isLight is set, and the other variant is
+// derived from it by inverting lightness. Only the scheme's own variant
+// uses its status colors.
+func specFromScheme(name string, core CoreColors, status *paletteOverrides, isLight bool) ThemeSpec {
+	spec := ThemeSpec{Name: name}
+	dark := core
+	if isLight {
+		spec.Light = &core
+		dark = deriveDark(core)
+	}
+	spec.Primary = dark.Primary
+	spec.Secondary = dark.Secondary
+	spec.Background = dark.Background
+	spec.Surface = dark.Surface
+	spec.Foreground = dark.Foreground
+	spec.Modify = func(p Palette, isDark bool) Palette {
+		if isDark == isLight {
+			return p
+		}
+		return status.apply(p)
+	}
+	return spec
+}
+
+// base16File covers both scheme layouts: tinted-theming nests the colors
+// under palette, the classic format lists them at the top level.
+type base16File struct {
+	System  string                  `yaml:"system"`
+	Variant string                  `yaml:"variant"`
+	Palette map[string]base16Scalar `yaml:"palette"`
+	Flat    map[string]base16Scalar `yaml:",inline"`
+}
+
+// base16Scalar keeps a YAML scalar's text as written, so unquoted colors
+// such as 282828 or 000000 are not decoded as numbers.
+type base16Scalar string
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (s *base16Scalar) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*s = base16Scalar(node.Value)
+	}
+	return nil
+}
+
+// deriveDark is the counterpart of DeriveLight for schemes that only define
+// a light variant: it inverts the lightness of Background, Surface, and
+// Foreground and keeps the accents.
+func deriveDark(light CoreColors) CoreColors {
+	return CoreColors{
+		Primary:    light.Primary,
+		Secondary:  light.Secondary,
+		Background: invertLightness(light.Background),
+		Surface:    invertLightness(light.Surface),
+		Foreground: invertLightness(light.Foreground),
+	}
+}
+
+func hclLightness(c color.Color) float64 {
+	cf, ok := colorful.MakeColor(c)
+	if !ok {
+		return 0
+	}
+	_, _, l := cf.Hcl()
+	return l
+}
+
+// slugThemeName lowercases s and replaces runs of other characters with
+// dashes so that scheme file names become valid theme names.
+func slugThemeName(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			dash = false
+		} else if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	name := strings.TrimSuffix(b.String(), "-")
+	if len(name) > 32 {
+		name = strings.TrimSuffix(name[:32], "-")
+	}
+	return name
+}
@@ -0,0 +1,118 @@
+package theme
+
+import (
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/colorprofile"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Capabilities is what the terminal is known to support, for the palette
+// builder, the banner and screens to consult instead of assuming a modern
+// truecolor terminal. Start from [DetectCapabilities], which sees only the
+// environment, and feed the terminal's replies to [RequestCapabilities] to
+// [Capabilities.Record].
+type Capabilities struct {
+	Profile    colorprofile.Profile // color depth
+	Unicode    bool                 // symbols and emoji render; false on non-UTF-8 locales and the Linux console
+	Graphemes  bool                 // the terminal sizes grapheme clusters as uniseg does (mode 2027), so emoji and combined accents line up
+	Hyperlinks bool                 // OSC 8 hyperlinks are likely rendered; there is no query for them
+	Terminal   string               // the terminal's name, from XTVERSION or $TERM_PROGRAM; "" when unknown
+}
+
+// hyperlinkTerminals are terminal names known to render OSC 8 hyperlinks,
+// matched case-insensitively against the XTVERSION reply and $TERM_PROGRAM.
+var hyperlinkTerminals = []string{
+	"kitty", "wezterm", "iterm", "ghostty", "foot", "alacritty", "contour",
+	"vscode", "konsole", "rio", "tabby", "hyper", "warp",
+}
+
+// DetectCapabilities returns the capabilities the environment advertises.
+// environ is in the form of os.Environ. Grapheme sizing cannot be told from
+// the environment, so it is false until the terminal answers.
+func DetectCapabilities(environ []string) Capabilities {
+	env := make(map[string]string, len(environ))
+	for _, kv := range environ {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			env[k] = v
+		}
+	}
+	c := Capabilities{
+		Profile:  colorprofile.Env(environ),
+		Unicode:  unicodeLocale(env),
+		Terminal: env["TERM_PROGRAM"],
+	}
+	c.Hyperlinks = HyperlinkTerminal(env["TERM_PROGRAM"], env["TERM"]) != "" ||
+		env["WT_SESSION"] != "" || env["VTE_VERSION"] != ""
+	return c
+}
+
+// unicodeLocale reports whether env advertises a UTF-8 locale on a terminal
+// other than the Linux console.
+func unicodeLocale(env map[string]string) bool {
+	if env["TERM"] == "linux" {
+		return false
+	}
+	for _, k := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := strings.ToUpper(env[k]); v != "" {
+			return strings.Contains(v, "UTF-8") || strings.Contains(v, "UTF8")
+		}
+	}
+	return false
+}
+
+// HyperlinkTerminal returns the first of [hyperlinkTerminals] that one of
+// names contains, or "" if none does.
+func HyperlinkTerminal(names ...string) string {
+	for _, name := range names {
+		lower := strings.ToLower(name)
+		for _, t := range hyperlinkTerminals {
+			if lower != "" && strings.Contains(lower, t) {
+				return t
+			}
+		}
+	}
+	return ""
+}
+
+// RequestCapabilities asks the terminal for its name and whether it sizes
+// grapheme clusters. The color profile arrives on its own at startup.
+func RequestCapabilities() tea.Cmd {
+	return tea.Batch(
+		tea.RequestTerminalVersion,
+		tea.Raw(ansi.RequestModeUnicodeCore),
+	)
+}
+
+// Record updates c from a terminal reply and reports whether c changed.
+// Other messages are ignored.
+func (c *Capabilities) Record(msg tea.Msg) bool {
+	old := *c
+	switch msg := msg.(type) {
+	case tea.ColorProfileMsg:
+		c.Profile = msg.Profile
+	case tea.TerminalVersionMsg:
+		c.Terminal = msg.Name
+		c.Hyperlinks = c.Hyperlinks || HyperlinkTerminal(msg.Name) != ""
+	case tea.ModeReportMsg:
+		if msg.Mode != ansi.ModeUnicodeCore {
+			return false
+		}
+		// Bubble Tea turns the mode on whenever the terminal knows it.
+		c.Graphemes = msg.Value == ansi.ModeSet || msg.Value == ansi.ModeReset ||
+			msg.Value == ansi.ModePermanentlySet
+	}
+	return *c != old
+}
+
+// TrueColor reports whether the terminal shows 24-bit colors.
+func (c Capabilities) TrueColor() bool {
+	return c.Profile == colorprofile.TrueColor
+}
+
+// Colors256 reports whether the terminal shows at least the 256-color
+// palette.
+func (c Capabilities) Colors256() bool {
+	return c.Profile >= colorprofile.ANSI256
+}
@@ -0,0 +1,95 @@
+package theme
+
+import (
+	"image/color"
+
+	"charm.land/lipgloss/v2"
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// ColorVision is a type of color vision deficiency that can be simulated.
+type ColorVision int
+
+// Simulated color vision deficiencies, each at full severity.
+const (
+	Deuteranopia ColorVision = iota // no green cones
+	Protanopia                      // no red cones
+	Tritanopia                      // no blue cones
+)
+
+// ColorVisions lists every simulated deficiency.
+var ColorVisions = []ColorVision{Deuteranopia, Protanopia, Tritanopia}
+
+// String returns the deficiency's name.
+func (v ColorVision) String() string {
+	switch v {
+	case Deuteranopia:
+		return "deuteranopia"
+	case Protanopia:
+		return "protanopia"
+	case Tritanopia:
+		return "tritanopia"
+	}
+	return "unknown"
+}
+
+// colorVisionMatrices are the Machado, Oliveira and Fernandes (2009)
+// simulation matrices at severity 1.0, applied in linear RGB.
+var colorVisionMatrices = map[ColorVision][3][3]float64{
+	Deuteranopia: {
+		{0.367322, 0.860646, -0.227968},
+		{0.280085, 0.672501, 0.047413},
+		{-0.011820, 0.042940, 0.968881},
+	},
+	Protanopia: {
+		{0.152286, 1.052583, -0.204868},
+		{0.114503, 0.786281, 0.099216},
+		{-0.003882, -0.048116, 1.051998},
+	},
+	Tritanopia: {
+		{1.255528, -0.076749, -0.178779},
+		{-0.078411, 0.930809, 0.147602},
+		{0.004733, 0.691367, 0.303900},
+	},
+}
+
+// SimulateColorVision returns c as it appears to someone with deficiency v.
+func SimulateColorVision(c color.Color, v ColorVision) color.Color {
+	cf, ok := colorful.MakeColor(c)
+	if !ok {
+		return c
+	}
+	m, ok := colorVisionMatrices[v]
+	if !ok {
+		return c
+	}
+	r, g, b := cf.LinearRgb()
+	return colorful.LinearRgb(
+		m[0][0]*r+m[0][1]*g+m[0][2]*b,
+		m[1][0]*r+m[1][1]*g+m[1][2]*b,
+		m[2][0]*r+m[2][1]*g+m[2][2]*b,
+	).Clamped()
+}
+
+// ColorblindSafeStatus returns p with its status colors replaced by hues from
+// the Okabe-Ito palette, which stay distinguishable under deuteranopia,
+// protanopia and tritanopia. Use it as, or from, a [ThemeSpec] Modify hook,
+// or enable it for every theme with [Manager.SetColorblindSafe].
+func ColorblindSafeStatus(p Palette, isDark bool) Palette {
+	if isDark {
+		p.Success = lipgloss.Color("#009E73") // bluish green
+		p.Error = lipgloss.Color("#D55E00")   // vermillion
+		p.Warning = lipgloss.Color("#F0E442") // yellow
+		p.Info = lipgloss.Color("#56B4E9")    // sky blue
+	} else {
+		p.Success = lipgloss.Color("#00705F") // darkened for light backgrounds
+		p.Error = lipgloss.Color("#C45500")
+		p.Warning = lipgloss.Color("#E69F00") // orange
+		p.Info = lipgloss.Color("#0072B2")    // blue
+	}
+	p.OnSuccess = readableOn(p.Success)
+	p.OnError = readableOn(p.Error)
+	p.OnWarning = readableOn(p.Warning)
+	p.OnInfo = readableOn(p.Info)
+	return p
+}
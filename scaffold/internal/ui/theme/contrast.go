@@ -0,0 +1,108 @@
+package theme
+
+import (
+	"image/color"
+
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// DefaultMinContrast is the WCAG AA contrast ratio for normal text.
+const DefaultMinContrast = 4.5
+
+// minContrast is the ratio palettes are corrected to; 0 disables correction.
+// It is guarded by settingsMu.
+var minContrast = DefaultMinContrast
+
+// SetMinContrast sets the WCAG contrast ratio that generated palettes are
+// corrected to meet, e.g. 4.5 for AA or 7 for AAA. A ratio of 0 or less
+// disables correction, leaving theme colors exactly as specified. The ratio
+// applies to every Manager in the process; call it before the TUI starts.
+func SetMinContrast(ratio float64) {
+	settingsMu.Lock()
+	minContrast = ratio
+	settingsMu.Unlock()
+	resetMemo()
+}
+
+// MinContrast returns the contrast ratio palettes are corrected to, or 0
+// when correction is disabled.
+func MinContrast() float64 {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return max(minContrast, 0)
+}
+
+// ContrastRatio returns the WCAG 2 contrast ratio between a and b, from 1
+// (identical luminance) to 21 (black on white).
+func ContrastRatio(a, b color.Color) float64 {
+	la, lb := relativeLuminance(a), relativeLuminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// relativeLuminance is the WCAG relative luminance of c.
+func relativeLuminance(c color.Color) float64 {
+	cf, ok := colorful.MakeColor(c)
+	if !ok {
+		return 0
+	}
+	r, g, b := cf.LinearRgb()
+	return 0.2126*r + 0.7152*g + 0.0722*b
+}
+
+// readableOn returns the text color for fills of bg: contrastingForeground,
+// corrected to the minimum contrast ratio.
+func readableOn(bg color.Color) color.Color {
+	return ensureContrast(contrastingForeground(bg), bg)
+}
+
+// contrastStep is the HCL lightness increment used when correcting.
+const contrastStep = 0.02
+
+// ensureContrast nudges fg's HCL lightness until it meets the minimum
+// contrast ratio against every color in bgs, keeping its hue and chroma. It
+// first moves away from the first background and then, if that cannot reach
+// the ratio, the other way. When neither direction reaches it, the best
+// candidate found is returned.
+func ensureContrast(fg color.Color, bgs ...color.Color) color.Color {
+	minRatio := MinContrast()
+	if minRatio <= 0 || len(bgs) == 0 {
+		return fg
+	}
+	worst := func(c color.Color) float64 {
+		r := ContrastRatio(c, bgs[0])
+		for _, bg := range bgs[1:] {
+			r = min(r, ContrastRatio(c, bg))
+		}
+		return r
+	}
+	best, bestRatio := fg, worst(fg)
+	if bestRatio >= minRatio {
+		return fg
+	}
+	cf, ok := colorful.MakeColor(fg)
+	if !ok {
+		return fg
+	}
+	h, chroma, l := cf.Hcl()
+
+	dir := 1.0
+	if relativeLuminance(fg) < relativeLuminance(bgs[0]) {
+		dir = -1
+	}
+	for _, d := range []float64{dir, -dir} {
+		for nl := l + d*contrastStep; nl >= 0 && nl <= 1; nl += d * contrastStep {
+			candidate := colorful.Hcl(h, chroma, nl).Clamped()
+			r := worst(candidate)
+			if r >= minRatio {
+				return candidate
+			}
+			if r > bestRatio {
+				best, bestRatio = candidate, r
+			}
+		}
+	}
+	return best
+}
@@ -0,0 +1,105 @@
+package theme
+
+import (
+	"image/color"
+
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/colorprofile"
+)
+
+// colorProfile is the terminal color profile palettes are quantized to,
+// guarded by settingsMu.
+var colorProfile = colorprofile.TrueColor
+
+// monochrome strips every color from generated palettes, guarded by
+// settingsMu.
+var monochrome bool
+
+// SetColorProfile sets the terminal color profile that generated palettes
+// are quantized to. With [colorprofile.ANSI256] or [colorprofile.ANSI] every
+// palette color is replaced by the nearest color the terminal can show, so
+// that what the theme code compares and derives from is what the user sees.
+// TrueColor, the default, and the colorless profiles leave palettes as
+// specified; the renderer strips colors for the latter. The profile applies
+// to every Manager in the process; once the TUI is running, call
+// [Manager.Refresh] or [Manager.SetCapabilities] afterwards to restyle.
+func SetColorProfile(p colorprofile.Profile) {
+	settingsMu.Lock()
+	colorProfile = p
+	settingsMu.Unlock()
+	resetMemo()
+}
+
+// ColorProfile returns the profile palettes are quantized to.
+func ColorProfile() colorprofile.Profile {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return colorProfile
+}
+
+// SetMonochrome turns monochrome rendering on or off, as requested by
+// NO_COLOR or --no-color. Monochrome palettes hold [lipgloss.NoColor] in
+// every field, so styles built from them set no foreground or background
+// colors and rely on bold and underline alone. Like the color profile it
+// applies to every Manager in the process; call it before the TUI starts.
+func SetMonochrome(on bool) {
+	settingsMu.Lock()
+	monochrome = on
+	settingsMu.Unlock()
+	resetMemo()
+}
+
+// Monochrome reports whether monochrome rendering is on.
+func Monochrome() bool {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return monochrome
+}
+
+// degradePalette strips the colors from p in monochrome mode, and otherwise
+// quantizes them to the color profile.
+func degradePalette(p Palette) Palette {
+	if Monochrome() {
+		for _, c := range paletteColors(&p) {
+			*c = lipgloss.NoColor{}
+		}
+		return p
+	}
+	profile := ColorProfile()
+	if profile != colorprofile.ANSI256 && profile != colorprofile.ANSI {
+		return p
+	}
+	for _, c := range paletteColors(&p) {
+		if *c != nil {
+			*c = profile.Convert(*c)
+		}
+	}
+	return p
+}
+
+// degradeColor strips or quantizes a single color as degradePalette does.
+func degradeColor(c color.Color) color.Color {
+	profile := ColorProfile()
+	switch {
+	case Monochrome():
+		return lipgloss.NoColor{}
+	case c == nil:
+		return nil
+	case profile == colorprofile.ANSI256 || profile == colorprofile.ANSI:
+		return profile.Convert(c)
+	}
+	return c
+}
+
+// paletteColors returns pointers to every color field of p.
+func paletteColors(p *Palette) []*color.Color {
+	return []*color.Color{
+		&p.Primary, &p.Secondary, &p.Background, &p.Surface, &p.Foreground,
+		&p.SurfaceRaised, &p.Overlay, &p.Border, &p.BorderMuted,
+		&p.ForegroundMuted, &p.ForegroundSubtle,
+		&p.OnPrimary, &p.PrimaryMuted, &p.OnSecondary, &p.SecondaryMuted,
+		&p.Focus,
+		&p.Success, &p.Error, &p.Warning, &p.Info,
+		&p.OnSuccess, &p.OnError, &p.OnWarning, &p.OnInfo,
+	}
+}
@@ -0,0 +1,161 @@
+package theme
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"charm.land/lipgloss/v2"
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// ExportFormat selects the output of ExportPalette.
+type ExportFormat string
+
+const (
+	// ExportJSON is an object of camelCase color names to hex values, in
+	// Palette field order.
+	ExportJSON ExportFormat = "json"
+	// ExportCSS is a :root rule declaring one custom property per color,
+	// e.g. --on-primary.
+	ExportCSS ExportFormat = "css"
+	// ExportBase16 is a tinted-theming base16 scheme in YAML, which
+	// ImportBase16 reads back.
+	ExportBase16 ExportFormat = "base16"
+)
+
+// ExportFormats lists the formats ExportPalette accepts.
+var ExportFormats = []ExportFormat{ExportJSON, ExportCSS, ExportBase16}
+
+// ExportPalette renders every color of p, computed ones included, in format
+// so the palette can be reused in other tools and in documentation. Colors
+// without a value, such as those of a monochrome palette, are left out.
+//
+// base16 has only sixteen slots, so that format carries the core and status
+// colors, with the remaining slots filled from muted and blended ones:
+//
+//	base00 Background        base08 Error
+//	base01 Surface           base09 Error/Warning blend
+//	base02 SurfaceRaised     base0A Warning
+//	base03 ForegroundSubtle  base0B Success
+//	base04 ForegroundMuted   base0C Info
+//	base05 Foreground        base0D Primary
+//	base06 Foreground, 1/3 toward white (black on light palettes)
+//	base07 Foreground, 2/3 toward white (black on light palettes)
+//	base0E Secondary         base0F Error/Background blend
+func ExportPalette(p Palette, format ExportFormat) ([]byte, error) {
+	switch format {
+	case ExportJSON:
+		return exportJSON(p), nil
+	case ExportCSS:
+		return exportCSS(p), nil
+	case ExportBase16:
+		return exportBase16(p), nil
+	}
+	return nil, fmt.Errorf("theme: unknown export format %q (want one of %v)", format, ExportFormats)
+}
+
+// namedColor is one color of a Palette with its field name.
+type namedColor struct {
+	name string // Palette field name, e.g. "OnPrimary"
+	hex  string
+}
+
+// paletteHexes returns p's colors in field order, skipping those without a
+// value.
+func paletteHexes(p Palette) []namedColor {
+	rv := reflect.ValueOf(p)
+	var out []namedColor
+	for i := range rv.NumField() {
+		c, ok := rv.Field(i).Interface().(color.Color)
+		if !ok || c == nil {
+			continue
+		}
+		if _, none := c.(lipgloss.NoColor); none {
+			continue
+		}
+		out = append(out, namedColor{name: rv.Type().Field(i).Name, hex: hexOf(c)})
+	}
+	return out
+}
+
+func exportJSON(p Palette) []byte {
+	var b bytes.Buffer
+	b.WriteString("{\n")
+	colors := paletteHexes(p)
+	for i, c := range colors {
+		key, _ := json.Marshal(lowerFirst(c.name))
+		fmt.Fprintf(&b, "  %s: %q", key, c.hex)
+		if i < len(colors)-1 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('\n')
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
+
+func exportCSS(p Palette) []byte {
+	var b bytes.Buffer
+	b.WriteString(":root {\n")
+	for _, c := range paletteHexes(p) {
+		fmt.Fprintf(&b, "  --%s: %s;\n", kebab(c.name), c.hex)
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
+
+func exportBase16(p Palette) []byte {
+	isLight := hclLightness(p.Background) > hclLightness(p.Foreground)
+	variant, toward := "dark", colorful.Color{R: 1, G: 1, B: 1}
+	if isLight {
+		variant, toward = "light", colorful.Color{}
+	}
+	base := [16]color.Color{
+		p.Background, p.Surface, p.SurfaceRaised, p.ForegroundSubtle,
+		p.ForegroundMuted, p.Foreground,
+		blendLab(p.Foreground, toward, 1.0/3), blendLab(p.Foreground, toward, 2.0/3),
+		p.Error, blendLab(p.Error, p.Warning, 0.5), p.Warning, p.Success,
+		p.Info, p.Primary, p.Secondary, blendLab(p.Error, p.Background, 0.5),
+	}
+
+	var b bytes.Buffer
+	b.WriteString("system: \"base16\"\n")
+	b.WriteString("name: \"Scaffold export\"\n")
+	b.WriteString("author: \"scaffold theme export\"\n")
+	fmt.Fprintf(&b, "variant: %q\n", variant)
+	b.WriteString("palette:\n")
+	for i, c := range base {
+		fmt.Fprintf(&b, "  base%02X: %q\n", i, hexOf(c))
+	}
+	return b.Bytes()
+}
+
+// lowerFirst lowercases the first letter of a field name: "OnPrimary" →
+// "onPrimary".
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// kebab turns a field name into a CSS property name: "OnPrimary" →
+// "on-primary".
+func kebab(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
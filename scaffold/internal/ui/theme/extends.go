@@ -0,0 +1,116 @@
+package theme
+
+import (
+	"fmt"
+	"image/color"
+)
+
+// ResolveSpec returns spec with everything it inherits through Extends
+// filled in, so the result stands alone:
+//
+//   - each dark core color left nil is the parent's
+//   - each light core color is, in order of preference, the one set in
+//     spec.Light, derived by [DeriveLight] from a dark color spec sets
+//     itself, or the parent's light color
+//   - Modify runs the parent's hook, then spec's
+//
+// Parents are looked up in the registry when ResolveSpec is called, so a
+// theme follows later changes to the one it extends. It fails for an
+// unknown parent or a cycle of themes extending each other.
+func ResolveSpec(spec ThemeSpec) (ThemeSpec, error) {
+	return resolveSpec(spec, map[string]bool{spec.Name: true})
+}
+
+func resolveSpec(spec ThemeSpec, seen map[string]bool) (ThemeSpec, error) {
+	if spec.Extends == "" {
+		return spec, nil
+	}
+	if seen[spec.Extends] {
+		return ThemeSpec{}, fmt.Errorf("theme: %s: extends %q, which extends it back", spec.Name, spec.Extends)
+	}
+	raw, ok := themeRegistry[spec.Extends]
+	if !ok {
+		return ThemeSpec{}, fmt.Errorf("theme: %s: extends unknown theme %q", spec.Name, spec.Extends)
+	}
+	seen[spec.Extends] = true
+	parent, err := resolveSpec(raw, seen)
+	if err != nil {
+		return ThemeSpec{}, err
+	}
+	return inherit(spec, parent), nil
+}
+
+// extendsTheme reports whether the registered theme name is base or extends
+// it, directly or through other themes.
+func extendsTheme(name, base string) bool {
+	seen := map[string]bool{}
+	for name != "" && !seen[name] {
+		if name == base {
+			return true
+		}
+		seen[name] = true
+		name = themeRegistry[name].Extends
+	}
+	return false
+}
+
+// inherit fills spec's unset colors from the resolved parent.
+func inherit(spec, parent ThemeSpec) ThemeSpec {
+	own := spec.dark()
+	dark := mergeCore(own, parent.dark())
+	derived := DeriveLight(dark)
+	light := parent.core(false)
+	light = mergeCore(CoreColors{
+		Primary:    pick(own.Primary, derived.Primary),
+		Secondary:  pick(own.Secondary, derived.Secondary),
+		Background: pick(own.Background, derived.Background),
+		Surface:    pick(own.Surface, derived.Surface),
+		Foreground: pick(own.Foreground, derived.Foreground),
+	}, light)
+	if spec.Light != nil {
+		light = mergeCore(*spec.Light, light)
+	}
+
+	out := spec
+	out.Extends = ""
+	out.Primary, out.Secondary = dark.Primary, dark.Secondary
+	out.Background, out.Surface, out.Foreground = dark.Background, dark.Surface, dark.Foreground
+	out.Light = &light
+	switch {
+	case parent.Modify != nil && spec.Modify != nil:
+		out.Modify = func(p Palette, isDark bool) Palette {
+			return spec.Modify(parent.Modify(p, isDark), isDark)
+		}
+	case parent.Modify != nil:
+		out.Modify = parent.Modify
+	}
+	return out
+}
+
+// mergeCore returns c with its nil colors taken from base.
+func mergeCore(c, base CoreColors) CoreColors {
+	return CoreColors{
+		Primary:    orColor(c.Primary, base.Primary),
+		Secondary:  orColor(c.Secondary, base.Secondary),
+		Background: orColor(c.Background, base.Background),
+		Surface:    orColor(c.Surface, base.Surface),
+		Foreground: orColor(c.Foreground, base.Foreground),
+	}
+}
+
+// orColor returns c, or fallback when c is nil.
+func orColor(c, fallback color.Color) color.Color {
+	if c == nil {
+		return fallback
+	}
+	return c
+}
+
+// pick returns derived when own is set and nil otherwise, so only the
+// colors a theme overrides are re-derived for its light variant.
+func pick(own, derived color.Color) color.Color {
+	if own == nil {
+		return nil
+	}
+	return derived
+}
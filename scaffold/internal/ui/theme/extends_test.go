@@ -0,0 +1,125 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"charm.land/lipgloss/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// registerForTest registers specs and removes them when the test ends.
+func registerForTest(t *testing.T, specs ...ThemeSpec) {
+	t.Helper()
+	for _, spec := range specs {
+		RegisterTheme(spec)
+		t.Cleanup(func() { delete(themeRegistry, spec.Name); resetMemo() })
+	}
+}
+
+func TestResolveSpec_InheritsUnsetColors(t *testing.T) {
+	base := testSpec("ext-base", "#ff0000")
+	base.Light = &CoreColors{
+		Primary:    lipgloss.Color("#aa0000"),
+		Secondary:  lipgloss.Color("#4b30df"),
+		Background: lipgloss.Color("#fafafa"),
+		Surface:    lipgloss.Color("#ffffff"),
+		Foreground: lipgloss.Color("#202020"),
+	}
+	base.Modify = func(p Palette, isDark bool) Palette {
+		p.Info = lipgloss.Color("#123456")
+		return p
+	}
+	registerForTest(t, base)
+
+	child := ThemeSpec{
+		Name:      "ext-child",
+		Extends:   "ext-base",
+		Secondary: lipgloss.Color("#00ff00"),
+		Modify: func(p Palette, isDark bool) Palette {
+			p.Warning = lipgloss.Color("#654321")
+			return p
+		},
+	}
+	got, err := ResolveSpec(child)
+	require.NoError(t, err)
+	assert.Empty(t, got.Extends)
+	assert.Equal(t, "#ff0000", hexOf(got.Primary), "dark color inherited")
+	assert.Equal(t, "#00ff00", hexOf(got.Secondary), "dark color overridden")
+	require.NotNil(t, got.Light)
+	assert.Equal(t, "#aa0000", hexOf(got.Light.Primary), "light color inherited")
+	assert.Equal(t, hexOf(DeriveLight(got.dark()).Secondary), hexOf(got.Light.Secondary),
+		"an overridden dark color is re-derived for light mode")
+
+	p := PaletteFromSpec(child, true)
+	assert.Equal(t, "#123456", hexOf(p.Info), "parent hook runs")
+	assert.Equal(t, "#654321", hexOf(p.Warning), "child hook runs")
+}
+
+func TestResolveSpec_Errors(t *testing.T) {
+	registerForTest(t,
+		ThemeSpec{Name: "ext-a", Extends: "ext-b"},
+		ThemeSpec{Name: "ext-b", Extends: "ext-a"},
+	)
+
+	_, err := ResolveSpec(ThemeSpec{Name: "ext-orphan", Extends: "nope"})
+	assert.ErrorContains(t, err, `unknown theme "nope"`)
+	_, err = ResolveSpec(themeRegistry["ext-a"])
+	assert.ErrorContains(t, err, "extends it back")
+
+	p := PaletteFromSpec(ThemeSpec{Name: "ext-orphan", Extends: "nope"}, true)
+	assert.Equal(t, hexOf(NewPalette("default", true).Primary), hexOf(p.Primary),
+		"an unresolvable theme falls back to the default colors")
+}
+
+func TestThemeFile_ExtendsRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	raw := `{"name": "ocean-warm", "extends": "ocean", "dark": {"primary": "#ff8800"}}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ocean-warm.json"), []byte(raw), 0o644))
+
+	specs, err := LoadThemeDir(dir)
+	require.NoError(t, err)
+	require.Len(t, specs, 1)
+	spec := specs[0]
+	assert.Equal(t, "ocean", spec.Extends)
+	assert.Nil(t, spec.Background, "unset colors stay nil")
+
+	p := PaletteFromSpec(spec, true)
+	assert.Equal(t, "#ff8800", hexOf(p.Primary))
+	assert.Equal(t, hexOf(NewPalette("ocean", true).Background), hexOf(p.Background))
+
+	_, err = SaveThemeFile(dir, spec)
+	require.NoError(t, err)
+	saved, err := os.ReadFile(filepath.Join(dir, "ocean-warm.json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "ocean-warm", "extends": "ocean", "dark": {"primary": "#ff8800"}}`, string(saved))
+}
+
+func TestThemeFile_StandaloneNeedsAllColors(t *testing.T) {
+	dir := t.TempDir()
+	raw := `{"name": "half", "dark": {"primary": "#ff8800"}}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "half.json"), []byte(raw), 0o644))
+
+	_, err := LoadThemeDir(dir)
+	assert.ErrorContains(t, err, "secondary")
+}
+
+func TestReload_RestylesThemesExtendingIt(t *testing.T) {
+	registerForTest(t,
+		testSpec("ext-parent", "#ff0000"),
+		ThemeSpec{Name: "ext-kid", Extends: "ext-parent", Surface: lipgloss.Color("#222222")},
+	)
+	m := NewManager()
+	m.Init("ext-kid", true, 80)
+	assert.Equal(t, "#ff0000", hexOf(m.State().Palette.Primary))
+	assert.Equal(t, "#222222", hexOf(m.State().Palette.Surface))
+
+	cmd := m.Reload(testSpec("ext-parent", "#0000ff"))
+	require.NotNil(t, cmd, "the active theme extends the reloaded one")
+	changed, ok := cmd().(ThemeChangedMsg)
+	require.True(t, ok)
+	assert.Equal(t, "#0000ff", hexOf(changed.State.Palette.Primary))
+	assert.Equal(t, "#222222", hexOf(changed.State.Palette.Surface), "the child's overrides survive")
+}
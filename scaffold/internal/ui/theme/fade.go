@@ -0,0 +1,115 @@
+package theme
+
+import (
+	"image/color"
+	"sync/atomic"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// fadeInterval is the delay between cross-fade frames (~30 fps).
+const fadeInterval = time.Second / 30
+
+// fadeSeq issues cross-fade IDs so ticks from an interrupted fade are
+// ignored.
+var fadeSeq atomic.Int64
+
+// FadeFrameMsg advances a theme cross-fade started by
+// [Manager.SetThemeName]. Deliver it to [Manager.Fade].
+type FadeFrameMsg struct {
+	id int64
+}
+
+// fade is a cross-fade in progress from one palette to the current one.
+type fade struct {
+	id     int64
+	from   Palette
+	frame  int
+	frames int
+}
+
+// active reports whether the fade has frames left to show.
+func (f fade) active() bool {
+	return f.id != 0 && f.frame < f.frames
+}
+
+// palette returns the palette shown at the fade's current frame on the way
+// to to.
+func (f fade) palette(to Palette) Palette {
+	t := float64(f.frame) / float64(f.frames)
+	return BlendPalettes(f.from, to, t*t*(3-2*t)) // ease in and out
+}
+
+// SetFadeFrames makes SetThemeName cross-fade from the old palette to the
+// new one over n frames at ~30 fps. 0, the default, switches instantly.
+func (m *Manager) SetFadeFrames(n int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.fadeFrames = max(n, 0)
+}
+
+// startFade begins a cross-fade from the palette on screen, which is from
+// unless a fade is already running, to m.state's palette.
+func (m *Manager) startFade(from Palette) tea.Cmd {
+	if m.fade.active() {
+		from = m.fade.palette(from)
+	}
+	m.fade = fade{id: fadeSeq.Add(1), from: from, frames: m.fadeFrames}
+	return m.fadeTick()
+}
+
+func (m *Manager) fadeTick() tea.Cmd {
+	id := m.fade.id
+	return tea.Tick(fadeInterval, func(time.Time) tea.Msg { return FadeFrameMsg{id: id} })
+}
+
+// Fade advances the running cross-fade by one frame and returns a command
+// broadcasting the blended palette, then the final one once the fade ends.
+// Frames of a fade that was superseded are ignored. The manager's State
+// always holds the final palette.
+func (m *Manager) Fade(msg FadeFrameMsg) tea.Cmd {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if msg.id != m.fade.id || !m.fade.active() {
+		return nil
+	}
+	m.fade.frame++
+	if !m.fade.active() {
+		m.fade = fade{}
+		return RequestThemeUpdate(m.state)
+	}
+	state := m.state
+	state.Palette = m.fade.palette(m.state.Palette)
+	return tea.Batch(RequestThemeUpdate(state), m.fadeTick())
+}
+
+// BlendPalettes mixes every color of from and to in HCL, t of the way
+// from from (0) to to (1). Colors that cannot be blended, such as the
+// absent colors of monochrome palettes, are taken from to.
+func BlendPalettes(from, to Palette, t float64) Palette {
+	out := to
+	src, dst := paletteColors(&from), paletteColors(&out)
+	for i := range dst {
+		*dst[i] = blendColor(*src[i], *dst[i], t)
+	}
+	return degradePalette(out)
+}
+
+func blendColor(a, b color.Color, t float64) color.Color {
+	if a == nil || b == nil {
+		return b
+	}
+	ca, ok := colorful.MakeColor(a)
+	if !ok {
+		return b
+	}
+	cb, ok := colorful.MakeColor(b)
+	if !ok {
+		return b
+	}
+	return ca.BlendHcl(cb, t).Clamped()
+}
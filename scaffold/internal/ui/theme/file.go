@@ -0,0 +1,312 @@
+package theme
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/color"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// themeNamePattern restricts custom theme names to safe file names.
+var themeNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+// themeFile is the on-disk form of a custom theme.
+type themeFile struct {
+	Name    string    `json:"name"`
+	Extends string    `json:"extends,omitempty"`
+	Dark    coreFile  `json:"dark"`
+	Light   *coreFile `json:"light,omitempty"`
+}
+
+// coreFile holds CoreColors as hex strings, plus optional overrides for
+// colors that are otherwise derived. A theme that extends another may leave
+// any of them out to inherit it.
+type coreFile struct {
+	Primary    string         `json:"primary,omitempty"`
+	Secondary  string         `json:"secondary,omitempty"`
+	Background string         `json:"background,omitempty"`
+	Surface    string         `json:"surface,omitempty"`
+	Foreground string         `json:"foreground,omitempty"`
+	Overrides  *overridesFile `json:"overrides,omitempty"`
+}
+
+// overridesFile replaces derived palette colors. Empty fields keep the
+// derived value.
+type overridesFile struct {
+	Focus   string `json:"focus,omitempty"`
+	Border  string `json:"border,omitempty"`
+	Success string `json:"success,omitempty"`
+	Error   string `json:"error,omitempty"`
+	Warning string `json:"warning,omitempty"`
+	Info    string `json:"info,omitempty"`
+}
+
+// paletteOverrides is a parsed overridesFile; nil colors are not overridden.
+type paletteOverrides struct {
+	focus, border, success, errColor, warning, info color.Color
+}
+
+// ValidateThemeName reports whether name can be used for a custom theme.
+func ValidateThemeName(name string) error {
+	if !themeNamePattern.MatchString(name) {
+		return fmt.Errorf("theme: %q must be 1-32 lowercase letters, digits, or dashes", name)
+	}
+	if IsBuiltin(name) || name == TerminalThemeName {
+		return fmt.Errorf("theme: %q is a built-in theme", name)
+	}
+	return nil
+}
+
+// SaveThemeFile writes spec to dir/<name>.json and returns the path. The
+// Modify hook cannot be serialised and is dropped, including one built from
+// a loaded file's overrides. Extends is kept, along with only the colors
+// spec sets.
+func SaveThemeFile(dir string, spec ThemeSpec) (string, error) {
+	if err := ValidateThemeName(spec.Name); err != nil {
+		return "", err
+	}
+	f := themeFile{Name: spec.Name, Extends: spec.Extends, Dark: toCoreFile(spec.dark())}
+	if spec.Light != nil {
+		light := toCoreFile(*spec.Light)
+		f.Light = &light
+	}
+	out, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("theme: encoding %s: %w", spec.Name, err)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("theme: creating directory: %w", err)
+	}
+	path := filepath.Join(dir, spec.Name+".json")
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		return "", fmt.Errorf("theme: writing %s: %w", path, err)
+	}
+	return path, nil
+}
+
+// LoadThemeDir reads every *.json theme file in dir, plus base16 schemes in
+// *.yaml or *.yml files (see [ImportBase16]). A missing directory yields no
+// themes. Files that fail to parse are skipped and reported together in the
+// returned error, alongside the themes that did load.
+func LoadThemeDir(dir string) ([]ThemeSpec, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("theme: listing %s: %w", dir, err)
+	}
+	var specs []ThemeSpec
+	var errs []error
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		if !isThemeFile(path) {
+			continue
+		}
+		spec, err := LoadThemeFile(path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		specs = append(specs, spec)
+	}
+	return specs, errors.Join(errs...)
+}
+
+// isThemeFile reports whether path has an extension LoadThemeFile reads.
+func isThemeFile(path string) bool {
+	switch filepath.Ext(path) {
+	case ".json", ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// LoadThemeFile reads a single theme: a *.json theme file or a base16
+// scheme in *.yaml or *.yml.
+func LoadThemeFile(path string) (ThemeSpec, error) {
+	switch filepath.Ext(path) {
+	case ".json":
+		return loadJSONTheme(path)
+	case ".yaml", ".yml":
+		return ImportBase16(path)
+	}
+	return ThemeSpec{}, fmt.Errorf("theme: %s: not a theme file", path)
+}
+
+func loadJSONTheme(path string) (ThemeSpec, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return ThemeSpec{}, fmt.Errorf("theme: reading %s: %w", path, err)
+	}
+	var f themeFile
+	if err := json.Unmarshal(raw, &f); err != nil {
+		return ThemeSpec{}, fmt.Errorf("theme: decoding %s: %w", path, err)
+	}
+	if want := strings.TrimSuffix(filepath.Base(path), ".json"); f.Name != want {
+		return ThemeSpec{}, fmt.Errorf("theme: %s declares name %q", path, f.Name)
+	}
+	if err := ValidateThemeName(f.Name); err != nil {
+		return ThemeSpec{}, err
+	}
+	partial := f.Extends != ""
+	dark, err := f.Dark.colors(partial)
+	if err != nil {
+		return ThemeSpec{}, fmt.Errorf("theme: %s: %w", path, err)
+	}
+	spec := ThemeSpec{
+		Name:       f.Name,
+		Extends:    f.Extends,
+		Primary:    dark.Primary,
+		Secondary:  dark.Secondary,
+		Background: dark.Background,
+		Surface:    dark.Surface,
+		Foreground: dark.Foreground,
+	}
+	if f.Light != nil {
+		light, err := f.Light.colors(partial)
+		if err != nil {
+			return ThemeSpec{}, fmt.Errorf("theme: %s: %w", path, err)
+		}
+		spec.Light = &light
+	}
+
+	darkOver, err := f.Dark.Overrides.parse()
+	if err != nil {
+		return ThemeSpec{}, fmt.Errorf("theme: %s: dark overrides: %w", path, err)
+	}
+	var lightOver *paletteOverrides
+	if f.Light != nil {
+		if lightOver, err = f.Light.Overrides.parse(); err != nil {
+			return ThemeSpec{}, fmt.Errorf("theme: %s: light overrides: %w", path, err)
+		}
+	}
+	if darkOver != nil || lightOver != nil {
+		spec.Modify = func(p Palette, isDark bool) Palette {
+			if isDark {
+				return darkOver.apply(p)
+			}
+			return lightOver.apply(p)
+		}
+	}
+	return spec, nil
+}
+
+// parse validates the override colors. A nil file yields nil overrides.
+func (f *overridesFile) parse() (*paletteOverrides, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var o paletteOverrides
+	for _, field := range []struct {
+		name string
+		hex  string
+		dst  *color.Color
+	}{
+		{"focus", f.Focus, &o.focus},
+		{"border", f.Border, &o.border},
+		{"success", f.Success, &o.success},
+		{"error", f.Error, &o.errColor},
+		{"warning", f.Warning, &o.warning},
+		{"info", f.Info, &o.info},
+	} {
+		if field.hex == "" {
+			continue
+		}
+		cf, err := colorful.Hex(field.hex)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %q is not a hex color", field.name, field.hex)
+		}
+		*field.dst = cf
+	}
+	return &o, nil
+}
+
+// apply replaces the overridden colors in p, recomputing the matching
+// high-contrast text colors for status overrides.
+func (o *paletteOverrides) apply(p Palette) Palette {
+	if o == nil {
+		return p
+	}
+	if o.focus != nil {
+		p.Focus = o.focus
+	}
+	if o.border != nil {
+		p.Border = o.border
+	}
+	if o.success != nil {
+		p.Success, p.OnSuccess = o.success, readableOn(o.success)
+	}
+	if o.errColor != nil {
+		p.Error, p.OnError = o.errColor, readableOn(o.errColor)
+	}
+	if o.warning != nil {
+		p.Warning, p.OnWarning = o.warning, readableOn(o.warning)
+	}
+	if o.info != nil {
+		p.Info, p.OnInfo = o.info, readableOn(o.info)
+	}
+	return p
+}
+
+// toCoreFile encodes c; nil colors, left to a parent theme, stay empty.
+func toCoreFile(c CoreColors) coreFile {
+	return coreFile{
+		Primary:    hexOrEmpty(c.Primary),
+		Secondary:  hexOrEmpty(c.Secondary),
+		Background: hexOrEmpty(c.Background),
+		Surface:    hexOrEmpty(c.Surface),
+		Foreground: hexOrEmpty(c.Foreground),
+	}
+}
+
+// colors parses the hex colors. When partial, for a theme extending
+// another, empty fields are left nil instead of failing.
+func (f coreFile) colors(partial bool) (CoreColors, error) {
+	var c CoreColors
+	for _, field := range []struct {
+		name string
+		hex  string
+		dst  *color.Color
+	}{
+		{"primary", f.Primary, &c.Primary},
+		{"secondary", f.Secondary, &c.Secondary},
+		{"background", f.Background, &c.Background},
+		{"surface", f.Surface, &c.Surface},
+		{"foreground", f.Foreground, &c.Foreground},
+	} {
+		if partial && field.hex == "" {
+			continue
+		}
+		cf, err := colorful.Hex(field.hex)
+		if err != nil {
+			return CoreColors{}, fmt.Errorf("%s: %q is not a hex color", field.name, field.hex)
+		}
+		*field.dst = cf
+	}
+	return c, nil
+}
+
+func hexOrEmpty(c color.Color) string {
+	if c == nil {
+		return ""
+	}
+	return hexOf(c)
+}
+
+func hexOf(c color.Color) string {
+	cf, ok := colorful.MakeColor(c)
+	if !ok {
+		return "#000000"
+	}
+	return cf.Clamped().Hex()
+}
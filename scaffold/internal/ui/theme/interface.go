@@ -2,10 +2,11 @@ package theme
 
 // State represents the complete theme state.
 type State struct {
-	Name    string  // theme name (e.g., "ocean", "forest")
-	IsDark  bool    // dark/light mode
-	Palette Palette // cached palette (computed once)
-	Width   int     // for width-dependent styles
+	Name    string       // theme name (e.g., "ocean", "forest")
+	IsDark  bool         // dark/light mode
+	Palette Palette      // cached palette (computed once)
+	Width   int          // for width-dependent styles
+	Caps    Capabilities // what the terminal supports; see Manager.SetCapabilities
 }
 
 // Themeable is implemented by components that need theme updates.
@@ -1,6 +1,7 @@
 package theme
 
 import (
+	"context"
 	"sync"
 
 	tea "charm.land/bubbletea/v2"
@@ -12,20 +13,69 @@ var (
 )
 
 // GetManager returns the singleton theme manager.
+//
+// Deprecated: create a Manager with [NewManager] and pass it where it is
+// needed, e.g. with [NewContext], so tests do not share theme state.
 func GetManager() *Manager {
 	managerOnce.Do(func() {
-		manager = &Manager{
-			paletteCache: make(map[string]map[bool]Palette),
-		}
+		manager = NewManager()
 	})
 	return manager
 }
 
+// PaletteSource builds the palette of the named theme.
+type PaletteSource func(name string, isDark bool) Palette
+
 // Manager holds theme state and provides cached palette access.
 type Manager struct {
 	mu           sync.RWMutex
 	state        State
+	source       PaletteSource               // builds uncached palettes; NewPalette by default
 	paletteCache map[string]map[bool]Palette // name -> isDark -> Palette
+	cacheGen     uint64                      // memoGen when paletteCache was last cleared
+
+	colorblindSafe bool // replace status colors via ColorblindSafeStatus
+
+	fadeFrames int  // SetThemeName cross-fades over this many frames; 0 = snap
+	fade       fade // cross-fade in progress, if any
+}
+
+// NewManager returns a theme manager with no theme set; call Init before
+// use. Palettes come from the theme registry.
+func NewManager() *Manager {
+	return &Manager{
+		source:       NewPalette,
+		paletteCache: make(map[string]map[bool]Palette),
+	}
+}
+
+// SetPaletteSource makes the manager build palettes with src instead of
+// [NewPalette], dropping those already built. Test doubles use it to show a
+// fixed palette whatever theme is selected.
+func (m *Manager) SetPaletteSource(src PaletteSource) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.source = src
+	clear(m.paletteCache)
+	if m.state.Name != "" {
+		m.state.Palette = m.getCachedPalette(m.state.Name, m.state.IsDark)
+	}
+}
+
+type managerKey struct{}
+
+// NewContext returns a copy of ctx carrying m, for handing the app's theme
+// manager to code that is given the app context.
+func NewContext(ctx context.Context, m *Manager) context.Context {
+	return context.WithValue(ctx, managerKey{}, m)
+}
+
+// FromContext returns the theme manager carried by ctx, or nil if there is
+// none.
+func FromContext(ctx context.Context) *Manager {
+	m, _ := ctx.Value(managerKey{}).(*Manager)
+	return m
 }
 
 // Init initializes the manager and returns initial theme command.
@@ -39,6 +89,7 @@ func (m *Manager) Init(name string, isDark bool, width int) tea.Cmd {
 		IsDark:  isDark,
 		Palette: m.getCachedPalette(name, isDark),
 		Width:   width,
+		Caps:    m.state.Caps,
 	}
 
 	// Don't fire theme update until we have a valid width
@@ -48,19 +99,94 @@ func (m *Manager) Init(name string, isDark bool, width int) tea.Cmd {
 	return nil
 }
 
-// getCachedPalette returns cached palette or creates and caches one.
+// getCachedPalette returns cached palette or creates and caches one. The
+// cache is dropped whenever a package-level setting has changed since it
+// was filled.
 func (m *Manager) getCachedPalette(name string, isDark bool) Palette {
+	if gen := memoGen(); gen != m.cacheGen {
+		clear(m.paletteCache)
+		m.cacheGen = gen
+	}
 	if m.paletteCache[name] == nil {
 		m.paletteCache[name] = make(map[bool]Palette)
 	}
 	if p, ok := m.paletteCache[name][isDark]; ok {
 		return p
 	}
-	p := NewPalette(name, isDark)
+	p := m.adjust(m.source(name, isDark), isDark)
 	m.paletteCache[name][isDark] = p
 	return p
 }
 
+// adjust applies the manager-wide palette options to p.
+func (m *Manager) adjust(p Palette, isDark bool) Palette {
+	if m.colorblindSafe {
+		p = degradePalette(ColorblindSafeStatus(p, isDark))
+	}
+	return p
+}
+
+// SetCapabilities records what the terminal supports and returns a command
+// if that changed; c is passed on to components in [State.Caps]. The color
+// profile palettes are quantized to is process-wide, so SetCapabilities
+// leaves it alone: call [SetColorProfile] with c.Profile first to follow it.
+func (m *Manager) SetCapabilities(c Capabilities) tea.Cmd {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.state.Caps == c {
+		return nil
+	}
+	m.state.Caps = c
+	if m.state.Name == "" {
+		return nil
+	}
+	m.state.Palette = m.getCachedPalette(m.state.Name, m.state.IsDark)
+	return RequestThemeUpdate(m.state)
+}
+
+// Capabilities returns what the terminal is known to support.
+func (m *Manager) Capabilities() Capabilities {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.state.Caps
+}
+
+// Refresh rebuilds the palette from the package-level settings, such as
+// the tokens set with [SetTokens] and the profile set with
+// [SetColorProfile], and returns a command that restyles the UI. Those
+// settings are shared by every Manager; call Refresh on the app's manager
+// after changing them while the TUI runs.
+func (m *Manager) Refresh() tea.Cmd {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.state.Name == "" {
+		return nil // not initialised yet; Init sends the first update
+	}
+	m.state.Palette = m.getCachedPalette(m.state.Name, m.state.IsDark)
+	return RequestThemeUpdate(m.state)
+}
+
+// SetColorblindSafe switches every theme to the status colors of
+// [ColorblindSafeStatus], or back to the theme's own, and returns a command
+// if the setting changed.
+func (m *Manager) SetColorblindSafe(on bool) tea.Cmd {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.colorblindSafe == on {
+		return nil
+	}
+	m.colorblindSafe = on
+	clear(m.paletteCache)
+	if m.state.Name == "" {
+		return nil // not initialised yet; Init builds the palette
+	}
+	m.state.Palette = m.getCachedPalette(m.state.Name, m.state.IsDark)
+	return RequestThemeUpdate(m.state)
+}
+
 // SetDarkMode updates dark mode and returns command if changed.
 func (m *Manager) SetDarkMode(isDark bool) tea.Cmd {
 	m.mu.Lock()
@@ -74,7 +200,9 @@ func (m *Manager) SetDarkMode(isDark bool) tea.Cmd {
 	return RequestThemeUpdate(m.state)
 }
 
-// SetThemeName updates theme name and returns command if changed.
+// SetThemeName updates theme name and returns command if changed. When
+// [Manager.SetFadeFrames] is set the command starts a cross-fade to the new
+// palette, whose frames the program must pass to [Manager.Fade].
 func (m *Manager) SetThemeName(name string) tea.Cmd {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -82,8 +210,42 @@ func (m *Manager) SetThemeName(name string) tea.Cmd {
 	if m.state.Name == name {
 		return nil
 	}
+	from := m.state.Palette
 	m.state.Name = name
 	m.state.Palette = m.getCachedPalette(name, m.state.IsDark)
+	if m.fadeFrames > 0 && m.state.Width > 0 && !Monochrome() {
+		return m.startFade(from)
+	}
+	return RequestThemeUpdate(m.state)
+}
+
+// Register adds spec to the theme registry, dropping cached palettes since
+// themes extending spec change with it. Use it for themes created at
+// runtime.
+func (m *Manager) Register(spec ThemeSpec) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	RegisterTheme(spec)
+	clear(m.paletteCache)
+}
+
+// Preview applies spec's palette without changing the theme name or caching
+// it, so an editor can show unsaved colors live. EndPreview reverts it.
+func (m *Manager) Preview(spec ThemeSpec) tea.Cmd {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.state.Palette = m.adjust(PaletteFromSpec(spec, m.state.IsDark), m.state.IsDark)
+	return RequestThemeUpdate(m.state)
+}
+
+// EndPreview restores the palette of the current theme name.
+func (m *Manager) EndPreview() tea.Cmd {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.state.Palette = m.getCachedPalette(m.state.Name, m.state.IsDark)
 	return RequestThemeUpdate(m.state)
 }
 
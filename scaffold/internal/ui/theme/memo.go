@@ -0,0 +1,109 @@
+package theme
+
+import (
+	"image/color"
+	"sync"
+)
+
+// memo caches generated palettes and styles, which are rebuilt from scratch
+// on every call otherwise: palettes take dozens of HCL conversions and
+// Styles a fresh style tree, and both are asked for on every resize.
+//
+// Palettes are keyed by theme and mode. Styles are kept for the palette
+// they were last built from, keyed by width, so a resize storm builds each
+// width once and switching themes starts over. Everything that changes
+// what a palette or style would be built from calls resetMemo. Like the
+// settings it depends on, the memo is shared by every Manager; gen counts
+// the resets so a Manager can tell when its own cache is stale.
+var memo = struct {
+	sync.Mutex
+	gen        uint64
+	palettes   map[paletteKey]Palette
+	stylesFrom Palette
+	styles     map[int]Styles // by terminal width
+}{
+	palettes: map[paletteKey]Palette{},
+	styles:   map[int]Styles{},
+}
+
+type paletteKey struct {
+	name   string
+	isDark bool
+}
+
+// resetMemo drops every cached palette and style.
+func resetMemo() {
+	memo.Lock()
+	defer memo.Unlock()
+	memo.gen++
+	clear(memo.palettes)
+	clear(memo.styles)
+}
+
+// memoGen returns the number of times the memo has been reset.
+func memoGen() uint64 {
+	memo.Lock()
+	defer memo.Unlock()
+	return memo.gen
+}
+
+// memoPalette returns the cached palette for name and isDark, building it
+// with build on a miss.
+func memoPalette(name string, isDark bool, build func() Palette) Palette {
+	key := paletteKey{name, isDark}
+	memo.Lock()
+	p, ok := memo.palettes[key]
+	memo.Unlock()
+	if ok {
+		return p
+	}
+	p = build()
+	memo.Lock()
+	memo.palettes[key] = p
+	memo.Unlock()
+	return p
+}
+
+// memoStyles returns the cached styles for p at width, building them with
+// build on a miss.
+func memoStyles(p Palette, width int, build func() Styles) Styles {
+	memo.Lock()
+	if !samePalette(memo.stylesFrom, p) {
+		memo.stylesFrom = p
+		clear(memo.styles)
+	}
+	s, ok := memo.styles[width]
+	memo.Unlock()
+	if ok {
+		return s
+	}
+	s = build()
+	memo.Lock()
+	if samePalette(memo.stylesFrom, p) {
+		memo.styles[width] = s
+	}
+	memo.Unlock()
+	return s
+}
+
+// samePalette reports whether a and b hold identical colors.
+func samePalette(a, b Palette) bool {
+	ca, cb := paletteColors(&a), paletteColors(&b)
+	for i := range ca {
+		if !sameColor(*ca[i], *cb[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameColor reports whether a and b are the same color value. Colors whose
+// type cannot be compared are never the same, which only costs a rebuild.
+func sameColor(a, b color.Color) (same bool) {
+	defer func() {
+		if recover() != nil {
+			same = false
+		}
+	}()
+	return a == b
+}
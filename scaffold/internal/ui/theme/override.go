@@ -0,0 +1,56 @@
+package theme
+
+import "charm.land/lipgloss/v2"
+
+// StyleOverride restyles one component. It receives the style this package
+// built and the palette it was built from, and returns the style to use.
+type StyleOverride func(s lipgloss.Style, p Palette) lipgloss.Style
+
+// styleOverrides holds the registered overrides by component, in
+// registration order. It is guarded by settingsMu.
+var styleOverrides = map[string][]StyleOverride{}
+
+// Override registers fn to restyle component whenever its styles are built,
+// so an app can change one component without forking this package.
+// Overrides for the same component run in the order they were registered.
+// Overrides apply to every Manager in the process; call Override before the
+// TUI starts.
+//
+// Components are named after the constructor and field they restyle:
+//
+//   - [Styles]: app.frame, app.header, app.title, app.body, app.help,
+//     app.footer, app.status-left, app.status-right
+//   - [DetailStyles]: detail.title, detail.desc, detail.content, detail.info
+//   - [ModalStyles]: modal.title, modal.body, modal.hint, modal.dialog
+//   - [StatusStyles]: status.success, status.error, status.warning,
+//     status.info
+//   - [ListStyles]: list.titlebar, list.title, list.pagination, list.help,
+//     list.statusbar, list.no-items
+//   - [ListItemStyles]: list.item.title, list.item.desc,
+//     list.item.selected-title, list.item.selected-desc,
+//     list.item.dimmed-title, list.item.dimmed-desc, list.item.filter-match
+//
+// For example:
+//
+//	theme.Override("modal.dialog", func(s lipgloss.Style, p theme.Palette) lipgloss.Style {
+//		return s.Border(lipgloss.DoubleBorder()).BorderForeground(p.Secondary)
+//	})
+func Override(component string, fn StyleOverride) {
+	settingsMu.Lock()
+	styleOverrides[component] = append(styleOverrides[component], fn)
+	settingsMu.Unlock()
+	resetMemo()
+}
+
+// applyOverrides runs the registered overrides on each style in styles,
+// keyed by component.
+func applyOverrides(p Palette, styles map[string]*lipgloss.Style) {
+	for component, s := range styles {
+		settingsMu.RLock()
+		fns := styleOverrides[component]
+		settingsMu.RUnlock()
+		for _, fn := range fns {
+			*s = fn(*s, p)
+		}
+	}
+}
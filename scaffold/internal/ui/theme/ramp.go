@@ -0,0 +1,38 @@
+package theme
+
+import "image/color"
+
+// Ramp returns n colors blended in HCL from p.Primary to p.Secondary, for
+// progress bars, charts and banners that should follow the theme.
+func Ramp(p Palette, n int) []color.Color {
+	return RampThrough(n, p.Primary, p.Secondary)
+}
+
+// StatusRamp returns n colors from p.Success through p.Warning to p.Error,
+// for meters where a higher value is worse, such as load or error rates.
+func StatusRamp(p Palette, n int) []color.Color {
+	return RampThrough(n, p.Success, p.Warning, p.Error)
+}
+
+// RampThrough returns n colors blended in HCL through stops, which are
+// spread evenly along the ramp: the first color is the first stop and the
+// last color the last stop. Colors are quantized to the color profile like
+// palettes (see [SetColorProfile]). It returns nil when n or the number of
+// stops is not positive, and n copies of a single stop.
+func RampThrough(n int, stops ...color.Color) []color.Color {
+	if n <= 0 || len(stops) == 0 {
+		return nil
+	}
+	out := make([]color.Color, n)
+	for i := range out {
+		if len(stops) == 1 || n == 1 {
+			out[i] = degradeColor(stops[0])
+			continue
+		}
+		// Position along the ramp, in units of stop-to-stop segments.
+		pos := float64(i) / float64(n-1) * float64(len(stops)-1)
+		seg := min(int(pos), len(stops)-2)
+		out[i] = degradeColor(blendColor(stops[seg], stops[seg+1], pos-float64(seg)))
+	}
+	return out
+}
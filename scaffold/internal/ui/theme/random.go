@@ -0,0 +1,86 @@
+package theme
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// RandomThemeName is the name of themes made by [GenerateRandom]. Each new
+// one replaces the last, so rolling themes does not grow the registry.
+const RandomThemeName = "random"
+
+// Bounds the seed is clamped to so that the accents read well as text and
+// as fills, with black text in dark mode and white text in light mode.
+const (
+	randomMinChroma      = 0.3
+	randomMaxChroma      = 0.8
+	randomMinLightness   = 0.7 // dark mode accents
+	randomMaxLightness   = 0.8
+	randomLightLightness = 0.3 // light mode accents
+)
+
+// minSecondaryDistance keeps Secondary distinguishable from Primary.
+const minSecondaryDistance = 0.2
+
+// GenerateRandom builds a new theme around seed. Primary is seed with its
+// chroma and lightness clamped to readable bounds, the neutrals are tinted
+// with its hue, and Secondary is the first of [GenerateVariants] that stays
+// distinct from Primary and passes [ValidatePalette] in dark and light
+// mode. It returns an error when seed is not a usable color or no variant
+// passes. The spec is named [RandomThemeName]; register it like any other
+// theme.
+func GenerateRandom(seed color.Color) (ThemeSpec, error) {
+	cf, ok := colorful.MakeColor(seed)
+	if !ok {
+		return ThemeSpec{}, fmt.Errorf("theme: unusable seed color %v", seed)
+	}
+	h, c, l := cf.Hcl()
+	c = min(max(c, randomMinChroma), randomMaxChroma)
+	l = min(max(l, randomMinLightness), randomMaxLightness)
+	primary := colorful.Hcl(h, c, l).Clamped()
+
+	spec := ThemeSpec{
+		Name:       RandomThemeName,
+		Primary:    primary,
+		Background: colorful.Hcl(h, 0.03, 0.10).Clamped(),
+		Surface:    colorful.Hcl(h, 0.05, 0.16).Clamped(),
+		Foreground: colorful.Hcl(h, 0.03, 0.92).Clamped(),
+		Light: &CoreColors{
+			Primary:    colorful.Hcl(h, c, randomLightLightness).Clamped(),
+			Background: colorful.Hcl(h, 0.02, 0.97).Clamped(),
+			Surface:    colorful.Hcl(h, 0.03, 0.99).Clamped(),
+			Foreground: colorful.Hcl(h, 0.03, 0.15).Clamped(),
+		},
+	}
+	for _, v := range GenerateVariants(primary) {
+		if colorDistance(primary, v.Color) < minSecondaryDistance {
+			continue
+		}
+		vc, _ := colorful.MakeColor(v.Color)
+		vh, _, _ := vc.Hcl()
+		spec.Secondary = v.Color
+		spec.Light.Secondary = colorful.Hcl(vh, c, randomLightLightness).Clamped()
+		if len(generatedWarnings(spec, true)) == 0 && len(generatedWarnings(spec, false)) == 0 {
+			return spec, nil
+		}
+	}
+	return ThemeSpec{}, fmt.Errorf("theme: no valid theme around %s", hexOf(primary))
+}
+
+// generatedWarnings returns the [ValidatePalette] warnings about the colors
+// GenerateRandom chooses. Warnings about the status colors, which every
+// theme shares, are left out.
+func generatedWarnings(spec ThemeSpec, isDark bool) []string {
+	var out []string
+	for _, w := range ValidatePalette(PaletteFromSpec(spec, isDark)) {
+		for _, name := range []string{"Foreground ", "Primary ", "Secondary "} {
+			if strings.HasPrefix(w, name) {
+				out = append(out, w)
+			}
+		}
+	}
+	return out
+}
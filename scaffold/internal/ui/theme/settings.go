@@ -0,0 +1,11 @@
+package theme
+
+import "sync"
+
+// settingsMu guards the package-level settings every palette and style is
+// built from: the design tokens, the color profile, monochrome mode, the
+// minimum contrast and the style overrides. They are process-wide, shared by
+// every Manager, so they are set with package functions rather than Manager
+// methods. Once the TUI is running, call [Manager.Refresh] after changing
+// one to restyle with it.
+var settingsMu sync.RWMutex
@@ -0,0 +1,143 @@
+package theme
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	uv "github.com/charmbracelet/ultraviolet"
+	"github.com/charmbracelet/x/ansi"
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// TerminalThemeName names the theme derived from the terminal's own colors.
+// It is registered once the terminal has answered the color queries, and is
+// reserved so custom theme files cannot take it.
+const TerminalThemeName = "terminal"
+
+// RequestANSIColors asks the terminal for its 16 ANSI colors with OSC 4.
+// Terminals that support it reply with one OSC sequence per color, which
+// Bubble Tea passes on unparsed; feed them to [TerminalColors.Record].
+func RequestANSIColors() tea.Cmd {
+	var b strings.Builder
+	for i := range 16 {
+		fmt.Fprintf(&b, "\x1b]4;%d;?\x07", i)
+	}
+	return tea.Raw(b.String())
+}
+
+// ParseANSIColor parses an OSC 4 reply such as
+// "\x1b]4;1;rgb:cdcd/0000/0000\x07" into the color's index and value.
+func ParseANSIColor(reply string) (int, color.Color, bool) {
+	s := strings.TrimPrefix(reply, "\x1b]")
+	s = strings.TrimPrefix(s, "\x9d")
+	s = strings.TrimSuffix(strings.TrimSuffix(s, "\x07"), "\x1b\\")
+	rest, ok := strings.CutPrefix(s, "4;")
+	if !ok {
+		return 0, nil, false
+	}
+	idx, spec, ok := strings.Cut(rest, ";")
+	if !ok {
+		return 0, nil, false
+	}
+	i, err := strconv.Atoi(idx)
+	if err != nil || i < 0 || i > 15 {
+		return 0, nil, false
+	}
+	c := ansi.XParseColor(spec)
+	if c == nil {
+		return 0, nil, false
+	}
+	return i, c, true
+}
+
+// TerminalColors collects the terminal's color scheme from its replies to
+// [RequestANSIColors], [tea.RequestForegroundColor] and
+// [tea.RequestBackgroundColor]. The zero value is empty and ready to use.
+type TerminalColors struct {
+	ANSI       [16]color.Color
+	Foreground color.Color // nil when the terminal did not report it
+	Background color.Color
+}
+
+// Record stores the color in msg when it is one of the replies, and
+// reports whether it was.
+func (t *TerminalColors) Record(msg tea.Msg) bool {
+	switch msg := msg.(type) {
+	case tea.ForegroundColorMsg:
+		t.Foreground = msg.Color
+	case tea.BackgroundColorMsg:
+		t.Background = msg.Color
+	case uv.UnknownOscEvent:
+		i, c, ok := ParseANSIColor(string(msg))
+		if !ok {
+			return false
+		}
+		t.ANSI[i] = c
+	default:
+		return false
+	}
+	return true
+}
+
+// Complete reports whether every color Spec needs has arrived: the 16 ANSI
+// colors and the background. The foreground is optional.
+func (t *TerminalColors) Complete() bool {
+	if t.Background == nil {
+		return false
+	}
+	for _, c := range t.ANSI {
+		if c == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Spec maps the colors onto the "terminal" theme so the app blends in with
+// the user's scheme. Call it once Complete reports true.
+//
+//	Background → Background      ANSI 4 (blue)    → Primary
+//	Foreground → Foreground      ANSI 5 (magenta) → Secondary
+//	ANSI 1 (red)    → Error      ANSI 2 (green)   → Success
+//	ANSI 3 (yellow) → Warning    ANSI 6 (cyan)    → Info
+//
+// Surface is the background shifted slightly toward the foreground. Without
+// a reported foreground, bright white (or black on light backgrounds) is
+// used. The opposite variant is derived as for base16 schemes.
+func (t *TerminalColors) Spec() ThemeSpec {
+	isLight := hclLightness(t.Background) > 0.5
+	fg := t.Foreground
+	if fg == nil {
+		fg = t.ANSI[15]
+		if isLight {
+			fg = t.ANSI[0]
+		}
+	}
+	core := CoreColors{
+		Primary:    t.ANSI[4],
+		Secondary:  t.ANSI[5],
+		Background: t.Background,
+		Surface:    blendLab(t.Background, fg, 0.06),
+		Foreground: fg,
+	}
+	status := &paletteOverrides{
+		errColor: t.ANSI[1],
+		success:  t.ANSI[2],
+		warning:  t.ANSI[3],
+		info:     t.ANSI[6],
+	}
+	return specFromScheme(TerminalThemeName, core, status, isLight)
+}
+
+// blendLab mixes a toward b by t in Lab space.
+func blendLab(a, b color.Color, t float64) color.Color {
+	ca, okA := colorful.MakeColor(a)
+	cb, okB := colorful.MakeColor(b)
+	if !okA || !okB {
+		return a
+	}
+	return ca.BlendLab(cb, t).Clamped()
+}
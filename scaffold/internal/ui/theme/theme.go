@@ -143,8 +143,15 @@ func GenerateVariants(base color.Color) []ColorVariant {
 // -----------------------------------------------------------------------------
 
 // ValidatePalette checks that palette colors meet perceptual distance requirements.
-// Returns warnings for colors that are too similar (confusion risk).
+// Returns warnings for colors that are too similar (confusion risk), including
+// Success, Error and Warning pairs that only become too similar under a
+// simulated color vision deficiency; see [ColorblindSafeStatus] for a fix.
+// In monochrome mode no colors are shown, so there is nothing to warn about.
 func ValidatePalette(p Palette) []string {
+	if Monochrome() {
+		return nil
+	}
+
 	const (
 		minTextContrastDistance = 0.5
 		minStatusColorDistance  = 0.15
@@ -207,6 +214,25 @@ func ValidatePalette(p Palette) []string {
 		}
 	}
 
+	// Check that Success, Error and Warning stay distinct under color vision
+	// deficiencies. Pairs already too similar above are not reported again.
+	alerts := statusColors[:3]
+	for i := 0; i < len(alerts); i++ {
+		for j := i + 1; j < len(alerts); j++ {
+			if colorDistance(alerts[i].col, alerts[j].col) < minStatusColorDistance {
+				continue
+			}
+			for _, v := range ColorVisions {
+				dist := colorDistance(SimulateColorVision(alerts[i].col, v), SimulateColorVision(alerts[j].col, v))
+				if dist < minStatusColorDistance {
+					warnings = append(warnings, fmt.Sprintf(
+						"%s and %s may be indistinguishable with %s (distance: %.2f)",
+						alerts[i].name, alerts[j].name, v, dist))
+				}
+			}
+		}
+	}
+
 	return warnings
 }
 
@@ -254,21 +280,102 @@ type Palette struct {
 // Available Themes
 // -----------------------------------------------------------------------------
 
+// CoreColors is the set of colors a theme chooses explicitly; everything else
+// in a [Palette] is derived from them.
+type CoreColors struct {
+	Primary    color.Color // primary brand/action
+	Secondary  color.Color // secondary brand/action
+	Background color.Color // page/app background
+	Surface    color.Color // card, panel, sheet
+	Foreground color.Color // primary text/icons
+}
+
 // ThemeSpec defines a named theme by its core colors.
 // Register themes with [RegisterTheme] before calling [NewPalette].
+// The top-level colors are the dark variant. Light supplies the light variant;
+// when nil it is derived from the dark colors by [DeriveLight].
 // An optional Modify hook can adjust the generated Palette after derivation.
+// A spec that Extends another theme may leave colors nil to inherit them;
+// see [ResolveSpec].
 type ThemeSpec struct {
 	Name       string
+	Extends    string      // name of a registered theme to inherit from; optional
 	Primary    color.Color // primary brand/action
 	Secondary  color.Color // secondary brand/action
 	Background color.Color // page/app background
 	Surface    color.Color // card, panel, sheet
 	Foreground color.Color // primary text/icons
 
+	// Optional light-mode core colors
+	Light *CoreColors
+
 	// Optional override hook
 	Modify func(p Palette, isDark bool) Palette
 }
 
+// dark returns the spec's dark core colors.
+func (s ThemeSpec) dark() CoreColors {
+	return CoreColors{
+		Primary:    s.Primary,
+		Secondary:  s.Secondary,
+		Background: s.Background,
+		Surface:    s.Surface,
+		Foreground: s.Foreground,
+	}
+}
+
+// core returns the core colors for the requested mode.
+func (s ThemeSpec) core(isDark bool) CoreColors {
+	if isDark {
+		return s.dark()
+	}
+	if s.Light != nil {
+		return *s.Light
+	}
+	return DeriveLight(s.dark())
+}
+
+// maxLightAccentL caps accent lightness in derived light variants so that
+// pastel accents chosen for dark backgrounds stay legible on light ones.
+const maxLightAccentL = 0.55
+
+// DeriveLight builds a light variant from dark core colors by inverting the
+// HCL lightness of Background, Surface, and Foreground. Hue and chroma are
+// kept, so the theme's tint survives. Accents keep their hue but are darkened
+// when too light to read on a light background.
+func DeriveLight(dark CoreColors) CoreColors {
+	return CoreColors{
+		Primary:    capLightness(dark.Primary, maxLightAccentL),
+		Secondary:  capLightness(dark.Secondary, maxLightAccentL),
+		Background: invertLightness(dark.Background),
+		Surface:    invertLightness(dark.Surface),
+		Foreground: invertLightness(dark.Foreground),
+	}
+}
+
+// invertLightness maps HCL lightness l to 1-l.
+func invertLightness(c color.Color) color.Color {
+	cf, ok := colorful.MakeColor(c)
+	if !ok {
+		return c
+	}
+	h, chroma, l := cf.Hcl()
+	return colorful.Hcl(h, chroma, 1-l).Clamped()
+}
+
+// capLightness darkens c to at most lightness maxL.
+func capLightness(c color.Color, maxL float64) color.Color {
+	cf, ok := colorful.MakeColor(c)
+	if !ok {
+		return c
+	}
+	h, chroma, l := cf.Hcl()
+	if l <= maxL {
+		return c
+	}
+	return colorful.Hcl(h, chroma, maxL).Clamped()
+}
+
 var themeRegistry = map[string]ThemeSpec{}
 
 // -----------------------------------------------------------------------------
@@ -276,10 +383,12 @@ var themeRegistry = map[string]ThemeSpec{}
 // -----------------------------------------------------------------------------
 
 // RegisterTheme adds spec to the global theme registry.
-// Call this in an init function before the TUI starts.
-// RegisterTheme is not concurrency-safe; call only from init().
+// Call this in an init function or otherwise before the TUI starts; at runtime
+// use [Manager.Register] from the UI goroutine.
+// RegisterTheme is not concurrency-safe.
 func RegisterTheme(spec ThemeSpec) {
 	themeRegistry[spec.Name] = spec
+	resetMemo()
 }
 
 // AvailableThemes returns the sorted names of all registered themes.
@@ -297,30 +406,35 @@ func AvailableThemes() []string {
 // -----------------------------------------------------------------------------
 
 func buildPalette(spec ThemeSpec, isDark bool) Palette {
+	core := spec.core(isDark)
+
+	// ── Foreground is corrected to the minimum contrast on both backgrounds
+	core.Foreground = ensureContrast(core.Foreground, core.Background, core.Surface)
+
 	// ── SurfaceRaised: lighten Surface in light mode, darken in dark mode
 	var surfaceRaised color.Color
 	if isDark {
-		surfaceRaised = darkenHcl(spec.Surface, 0.08)
+		surfaceRaised = darkenHcl(core.Surface, 0.08)
 	} else {
-		surfaceRaised = lightenHcl(spec.Surface, 0.08)
+		surfaceRaised = lightenHcl(core.Surface, 0.08)
 	}
 
 	// ── Overlay, Border, BorderMuted from Foreground with simulated alpha
-	overlay := withAlpha(spec.Foreground, 0.5)
-	border := withAlpha(spec.Foreground, 0.12)
-	borderMuted := withAlpha(spec.Foreground, 0.06)
+	overlay := withAlpha(core.Foreground, 0.5)
+	border := withAlpha(core.Foreground, 0.12)
+	borderMuted := withAlpha(core.Foreground, 0.06)
 
 	// ── ForegroundMuted, ForegroundSubtle from Foreground
-	foregroundMuted := withAlpha(spec.Foreground, 0.6)
-	foregroundSubtle := withAlpha(spec.Foreground, 0.38)
+	foregroundMuted := withAlpha(core.Foreground, 0.6)
+	foregroundSubtle := withAlpha(core.Foreground, 0.38)
 
 	// ── OnPrimary, PrimaryMuted from Primary
-	onPrimary := contrastingForeground(spec.Primary)
-	primaryMuted := withAlpha(spec.Primary, 0.12)
+	onPrimary := readableOn(core.Primary)
+	primaryMuted := withAlpha(core.Primary, 0.12)
 
 	// ── OnSecondary, SecondaryMuted from Secondary
-	onSecondary := contrastingForeground(spec.Secondary)
-	secondaryMuted := withAlpha(spec.Secondary, 0.12)
+	onSecondary := readableOn(core.Secondary)
+	secondaryMuted := withAlpha(core.Secondary, 0.12)
 
 	// ── Status colors (defaults; can be overridden via Modify)
 	var success, warning, info, errColor color.Color
@@ -338,11 +452,11 @@ func buildPalette(spec ThemeSpec, isDark bool) Palette {
 
 	return Palette{
 		// Core colors (from spec)
-		Primary:    spec.Primary,
-		Secondary:  spec.Secondary,
-		Background: spec.Background,
-		Surface:    spec.Surface,
-		Foreground: spec.Foreground,
+		Primary:    core.Primary,
+		Secondary:  core.Secondary,
+		Background: core.Background,
+		Surface:    core.Surface,
+		Foreground: core.Foreground,
 
 		// Computed from Surface
 		SurfaceRaised: surfaceRaised,
@@ -363,17 +477,17 @@ func buildPalette(spec ThemeSpec, isDark bool) Palette {
 		SecondaryMuted: secondaryMuted,
 
 		// Interactive
-		Focus: spec.Primary,
+		Focus: core.Primary,
 
 		// Status
 		Success:   success,
 		Error:     errColor,
 		Warning:   warning,
 		Info:      info,
-		OnSuccess: contrastingForeground(success),
-		OnError:   contrastingForeground(errColor),
-		OnWarning: contrastingForeground(warning),
-		OnInfo:    contrastingForeground(info),
+		OnSuccess: readableOn(success),
+		OnError:   readableOn(errColor),
+		OnWarning: readableOn(warning),
+		OnInfo:    readableOn(info),
 	}
 }
 
@@ -384,33 +498,72 @@ func buildPalette(spec ThemeSpec, isDark bool) Palette {
 // NewPalette generates a [Palette] for named theme.
 // If name is unknown, it falls back to "default" theme.
 // If "default" is also not registered, it uses hardcoded sentinel colors.
-// isDark selects the dark or light variant.
+// isDark selects the dark or light variant. Colors are quantized to the
+// terminal's color profile; see [SetColorProfile]. Palettes are cached, so
+// repeated calls are cheap.
 func NewPalette(name string, isDark bool) Palette {
-	spec, ok := themeRegistry[name]
-	if !ok {
-		spec, ok = themeRegistry["default"]
+	return memoPalette(name, isDark, func() Palette {
+		spec, ok := themeRegistry[name]
 		if !ok {
-			// Fallback sentinel colors
-			spec = ThemeSpec{
-				Name:       "default",
-				Primary:    lipgloss.Color("#10B1AE"),
-				Secondary:  lipgloss.Color("#6B50FF"),
-				Background: lipgloss.Color("#16161A"),
-				Surface:    lipgloss.Color("#1A1A1F"),
-				Foreground: lipgloss.Color("#F1EFEF"),
-			}
+			spec = defaultSpec()
 		}
+		return PaletteFromSpec(spec, isDark)
+	})
+}
+
+// defaultSpec returns the resolved "default" theme, or sentinel colors when
+// it is not registered or cannot be resolved.
+func defaultSpec() ThemeSpec {
+	if spec, ok := themeRegistry["default"]; ok {
+		if resolved, err := ResolveSpec(spec); err == nil {
+			return resolved
+		}
+	}
+	// Fallback sentinel colors
+	return ThemeSpec{
+		Name:       "default",
+		Primary:    lipgloss.Color("#10B1AE"),
+		Secondary:  lipgloss.Color("#6B50FF"),
+		Background: lipgloss.Color("#16161A"),
+		Surface:    lipgloss.Color("#1A1A1F"),
+		Foreground: lipgloss.Color("#F1EFEF"),
 	}
+}
 
+// PaletteFromSpec generates a [Palette] for a spec that need not be
+// registered, e.g. one being edited. A spec whose Extends cannot be resolved
+// inherits from the default theme instead.
+func PaletteFromSpec(spec ThemeSpec, isDark bool) Palette {
+	resolved, err := ResolveSpec(spec)
+	if err != nil {
+		spec.Extends = ""
+		resolved = inherit(spec, defaultSpec())
+	}
+	spec = resolved
 	p := buildPalette(spec, isDark)
 
 	if spec.Modify != nil {
 		p = spec.Modify(p, isDark)
 	}
 
-	return p
+	return degradePalette(p)
 }
 
+// Spec returns the registered spec for name.
+func Spec(name string) (ThemeSpec, bool) {
+	spec, ok := themeRegistry[name]
+	return spec, ok
+}
+
+// IsBuiltin reports whether name is one of the themes shipped with the
+// application, which custom theme files may not replace.
+func IsBuiltin(name string) bool {
+	return builtinThemes[name]
+}
+
+// builtinThemes records the themes registered by this package's init.
+var builtinThemes = map[string]bool{}
+
 // -----------------------------------------------------------------------------
 // Theme Definitions
 // -----------------------------------------------------------------------------
@@ -474,9 +627,11 @@ func init() {
 		Background: lipgloss.Color("#1A0F13"),
 		Surface:    lipgloss.Color("#25161C"),
 		Foreground: lipgloss.Color("#FFF8F0"),
-		Modify: func(p Palette, _ bool) Palette {
-			p.OnPrimary = lipgloss.Color("#F1EFEF")
-			p.OnSecondary = lipgloss.Color("#201F26")
+		Modify: func(p Palette, isDark bool) Palette {
+			if isDark { // the derived light accents get computed on-colors
+				p.OnPrimary = lipgloss.Color("#F1EFEF")
+				p.OnSecondary = lipgloss.Color("#201F26")
+			}
 			return p
 		},
 	})
@@ -489,15 +644,21 @@ func init() {
 		Background: lipgloss.Color("#0A1A1C"),
 		Surface:    lipgloss.Color("#12272A"),
 		Foreground: lipgloss.Color("#F0FFFA"),
-		Modify: func(p Palette, _ bool) Palette {
+		Modify: func(p Palette, isDark bool) Palette {
 			// Brighter, more saturated status colors
 			p.Error = lipgloss.Color("#FF3B3B")
 			p.Success = lipgloss.Color("#00FF85")
 			p.Warning = lipgloss.Color("#FFD60A")
 			p.Info = lipgloss.Color("#FF00C8")
+			p.OnError = readableOn(p.Error)
+			p.OnSuccess = readableOn(p.Success)
+			p.OnWarning = readableOn(p.Warning)
+			p.OnInfo = readableOn(p.Info)
 			p.Focus = lipgloss.Color("#FF00C8") // magenta focus
-			p.OnPrimary = lipgloss.Color("#201F26")
-			p.OnSecondary = lipgloss.Color("#F1EFEF")
+			if isDark {
+				p.OnPrimary = lipgloss.Color("#201F26")
+				p.OnSecondary = lipgloss.Color("#F1EFEF")
+			}
 			return p
 		},
 	})
@@ -541,6 +702,10 @@ func init() {
 		Surface:    lipgloss.Color("#1C1C1C"),
 		Foreground: lipgloss.Color("#E8E8E8"),
 	})
+
+	for name := range themeRegistry {
+		builtinThemes[name] = true
+	}
 }
 
 // Styles holds all styled components for the UI.
@@ -556,46 +721,65 @@ type Styles struct {
 	MaxWidth    int
 }
 
-// newStylesFromPalette creates Styles from a Palette.
-func newStylesFromPalette(p Palette, width int) Styles {
+// ContentWidth returns the width of the application frame in a terminal
+// width cells wide: 90% of it, or all but 4 cells when that is under 40.
+// Components that size themselves use it to match Styles.MaxWidth.
+func ContentWidth(width int) int {
 	maxWidth := width * 90 / 100
 	if maxWidth < 40 {
 		maxWidth = width - 4
 	}
+	return max(maxWidth, 0)
+}
+
+// newStylesFromPalette creates Styles from a Palette.
+func newStylesFromPalette(p Palette, width int) Styles {
+	maxWidth := ContentWidth(width)
+	t := CurrentTokens()
 
-	return Styles{
+	s := Styles{
 		MaxWidth: maxWidth,
 		App:      lipgloss.NewStyle().Width(maxWidth).Padding(0, 0),
-		Header:   lipgloss.NewStyle().Padding(2).MarginBottom(0).PaddingBottom(0),
-		PlainTitle: lipgloss.NewStyle().
-			Bold(true).
+		Header:   lipgloss.NewStyle().Padding(t.Gap.SM, t.Space.SM, 0).MarginBottom(0),
+		PlainTitle: t.Emphasis.Title.Apply(lipgloss.NewStyle()).
 			Foreground(p.Primary).
-			Border(lipgloss.NormalBorder(), false, false, true, false).
+			Border(t.Border.Rule, false, false, true, false).
 			BorderForeground(p.Secondary).
-			PaddingBottom(1),
-		Body: lipgloss.NewStyle().Padding(0, 3).Foreground(p.Foreground),
-		Help: lipgloss.NewStyle().MarginTop(0).Padding(0, 3),
+			PaddingBottom(t.Gap.XS),
+		Body: lipgloss.NewStyle().Padding(0, t.Space.MD).Foreground(p.Foreground),
+		Help: lipgloss.NewStyle().MarginTop(0).Padding(0, t.Space.MD),
 		Footer: lipgloss.NewStyle().
-			MarginTop(1).
-			Border(lipgloss.RoundedBorder(), true).
+			MarginTop(t.Gap.XS).
+			Border(t.Border.Frame, true).
 			BorderForeground(p.Border).
-			PaddingLeft(1),
-		StatusLeft: lipgloss.NewStyle().
+			PaddingLeft(t.Space.XS),
+		StatusLeft: t.Emphasis.Strong.Apply(lipgloss.NewStyle()).
 			Background(p.PrimaryMuted).
-			Foreground(p.OnPrimary).
-			Bold(true),
+			Foreground(p.OnPrimary),
 		StatusRight: lipgloss.NewStyle().Foreground(p.ForegroundSubtle),
 	}
+	applyOverrides(p, map[string]*lipgloss.Style{
+		"app.frame":        &s.App,
+		"app.header":       &s.Header,
+		"app.title":        &s.PlainTitle,
+		"app.body":         &s.Body,
+		"app.help":         &s.Help,
+		"app.footer":       &s.Footer,
+		"app.status-left":  &s.StatusLeft,
+		"app.status-right": &s.StatusRight,
+	})
+	return s
 }
 
 // New creates Styles with adaptive colors for the given theme name.
 func New(name string, isDark bool, width int) Styles {
-	return newStylesFromPalette(NewPalette(name, isDark), width)
+	return NewFromPalette(NewPalette(name, isDark), width)
 }
 
 // NewFromPalette creates Styles from an existing Palette (avoids recalculation).
+// Styles for the most recent palette are cached by width.
 func NewFromPalette(p Palette, width int) Styles {
-	return newStylesFromPalette(p, width)
+	return memoStyles(p, width, func() Styles { return newStylesFromPalette(p, width) })
 }
 
 // DetailStyles holds styles for the detail screen.
@@ -608,12 +792,20 @@ type DetailStyles struct {
 
 // newDetailStylesFromPalette creates DetailStyles from a Palette.
 func newDetailStylesFromPalette(p Palette) DetailStyles {
-	return DetailStyles{
-		Title:   lipgloss.NewStyle().Bold(true).Foreground(p.Primary).MarginBottom(1),
-		Desc:    lipgloss.NewStyle().Foreground(p.SecondaryMuted).MarginBottom(2),
+	t := CurrentTokens()
+	s := DetailStyles{
+		Title:   t.Emphasis.Title.Apply(lipgloss.NewStyle()).Foreground(p.Primary).MarginBottom(t.Gap.XS),
+		Desc:    lipgloss.NewStyle().Foreground(p.SecondaryMuted).MarginBottom(t.Gap.SM),
 		Content: lipgloss.NewStyle().Foreground(p.Foreground),
-		Info:    lipgloss.NewStyle().Foreground(p.Primary).Italic(true).MarginBottom(1),
+		Info:    t.Emphasis.Hint.Apply(lipgloss.NewStyle()).Foreground(p.Primary).MarginBottom(t.Gap.XS),
 	}
+	applyOverrides(p, map[string]*lipgloss.Style{
+		"detail.title":   &s.Title,
+		"detail.desc":    &s.Desc,
+		"detail.content": &s.Content,
+		"detail.info":    &s.Info,
+	})
+	return s
 }
 
 // NewDetailStyles creates detail styles with adaptive colors for the given theme name.
@@ -636,16 +828,24 @@ type ModalStyles struct {
 
 // newModalStylesFromPalette creates ModalStyles from a Palette.
 func newModalStylesFromPalette(p Palette) ModalStyles {
-	return ModalStyles{
-		Title: lipgloss.NewStyle().Bold(true).Foreground(p.Primary),
+	t := CurrentTokens()
+	s := ModalStyles{
+		Title: t.Emphasis.Title.Apply(lipgloss.NewStyle()).Foreground(p.Primary),
 		Body:  lipgloss.NewStyle().Foreground(p.Foreground),
-		Hint:  lipgloss.NewStyle().Foreground(p.ForegroundSubtle).Italic(true),
+		Hint:  t.Emphasis.Hint.Apply(lipgloss.NewStyle()).Foreground(p.ForegroundSubtle),
 		Dialog: lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
+			Border(t.Border.Frame).
 			BorderForeground(p.Primary).
-			Padding(1, 2).
+			Padding(t.Gap.XS, t.Space.SM).
 			Width(52),
 	}
+	applyOverrides(p, map[string]*lipgloss.Style{
+		"modal.title":  &s.Title,
+		"modal.body":   &s.Body,
+		"modal.hint":   &s.Hint,
+		"modal.dialog": &s.Dialog,
+	})
+	return s
 }
 
 // NewModalStylesFromPalette creates ModalStyles from an existing Palette.
@@ -664,33 +864,50 @@ type StatusStyles struct {
 // NewStatusStyles creates status styles from a Palette for the given theme name.
 func NewStatusStyles(name string, isDark bool) StatusStyles {
 	p := NewPalette(name, isDark)
-	return StatusStyles{
-		Success: lipgloss.NewStyle().Foreground(p.Success).Bold(true),
-		Error:   lipgloss.NewStyle().Foreground(p.Error).Bold(true),
+	strong := CurrentTokens().Emphasis.Strong.Apply(lipgloss.NewStyle())
+	s := StatusStyles{
+		Success: strong.Foreground(p.Success),
+		Error:   strong.Foreground(p.Error),
 		Warning: lipgloss.NewStyle().Foreground(p.Warning),
 		Info:    lipgloss.NewStyle().Foreground(p.Info),
 	}
+	applyOverrides(p, map[string]*lipgloss.Style{
+		"status.success": &s.Success,
+		"status.error":   &s.Error,
+		"status.warning": &s.Warning,
+		"status.info":    &s.Info,
+	})
+	return s
 }
 
 // ListStyles creates list.Styles from a Palette.
 func ListStyles(p Palette) list.Styles {
 	s := list.DefaultStyles(false)
+	t := CurrentTokens()
 
-	s.TitleBar = lipgloss.NewStyle().Padding(0, 0, 1, 2)
+	s.TitleBar = lipgloss.NewStyle().Padding(0, 0, t.Gap.XS, t.Space.SM)
 	s.Title = lipgloss.NewStyle().
 		Background(p.Primary).
 		Foreground(p.OnPrimary).
-		Padding(0, 1)
+		Padding(0, t.Space.XS)
 	s.Spinner = lipgloss.NewStyle().Foreground(p.Primary)
-	s.PaginationStyle = lipgloss.NewStyle().Foreground(p.ForegroundSubtle).PaddingLeft(2)
-	s.HelpStyle = lipgloss.NewStyle().Foreground(p.ForegroundMuted).Padding(1, 0, 0, 2)
-	s.StatusBar = lipgloss.NewStyle().Foreground(p.ForegroundMuted).Padding(0, 0, 1, 2)
+	s.PaginationStyle = lipgloss.NewStyle().Foreground(p.ForegroundSubtle).PaddingLeft(t.Space.SM)
+	s.HelpStyle = lipgloss.NewStyle().Foreground(p.ForegroundMuted).Padding(t.Gap.XS, 0, 0, t.Space.SM)
+	s.StatusBar = lipgloss.NewStyle().Foreground(p.ForegroundMuted).Padding(0, 0, t.Gap.XS, t.Space.SM)
 	s.StatusEmpty = lipgloss.NewStyle().Foreground(p.ForegroundSubtle)
 	s.NoItems = lipgloss.NewStyle().Foreground(p.ForegroundMuted)
 	s.ActivePaginationDot = lipgloss.NewStyle().Foreground(p.Primary).SetString("•")
 	s.InactivePaginationDot = lipgloss.NewStyle().Foreground(p.ForegroundSubtle).SetString("•")
 	s.DividerDot = lipgloss.NewStyle().Foreground(p.ForegroundSubtle).SetString(" • ")
 
+	applyOverrides(p, map[string]*lipgloss.Style{
+		"list.titlebar":   &s.TitleBar,
+		"list.title":      &s.Title,
+		"list.pagination": &s.PaginationStyle,
+		"list.help":       &s.HelpStyle,
+		"list.statusbar":  &s.StatusBar,
+		"list.no-items":   &s.NoItems,
+	})
 	return s
 }
 
@@ -703,10 +920,9 @@ func ListItemStyles(p Palette) list.DefaultItemStyles {
 	s.NormalDesc = lipgloss.NewStyle().Foreground(p.ForegroundSubtle)
 
 	// Selected state (focused item)
-	s.SelectedTitle = lipgloss.NewStyle().
+	s.SelectedTitle = CurrentTokens().Emphasis.Strong.Apply(lipgloss.NewStyle()).
 		Foreground(p.Primary).
-		SetString(">").
-		Bold(true)
+		SetString(">")
 	s.SelectedDesc = lipgloss.NewStyle().Foreground(p.Secondary)
 
 	// Dimmed state (when filter input is activated)
@@ -716,5 +932,14 @@ func ListItemStyles(p Palette) list.DefaultItemStyles {
 	// Filter match
 	s.FilterMatch = lipgloss.NewStyle().Foreground(p.Primary)
 
+	applyOverrides(p, map[string]*lipgloss.Style{
+		"list.item.title":          &s.NormalTitle,
+		"list.item.desc":           &s.NormalDesc,
+		"list.item.selected-title": &s.SelectedTitle,
+		"list.item.selected-desc":  &s.SelectedDesc,
+		"list.item.dimmed-title":   &s.DimmedTitle,
+		"list.item.dimmed-desc":    &s.DimmedDesc,
+		"list.item.filter-match":   &s.FilterMatch,
+	})
 	return s
 }
@@ -0,0 +1,806 @@
+package theme
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/colorprofile"
+	uv "github.com/charmbracelet/ultraviolet"
+	"github.com/charmbracelet/x/ansi"
+	colorful "github.com/lucasb-eyer/go-colorful"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lightness(t *testing.T, c any) float64 {
+	t.Helper()
+	cf, ok := colorful.MakeColor(c.(interface{ RGBA() (r, g, b, a uint32) }))
+	if !ok {
+		t.Fatalf("invalid color %v", c)
+	}
+	_, _, l := cf.Hcl()
+	return l
+}
+
+func TestNewPalette_LightVariantHasLightBackground(t *testing.T) {
+	for _, name := range AvailableThemes() {
+		dark := NewPalette(name, true)
+		light := NewPalette(name, false)
+
+		assert.Less(t, lightness(t, dark.Background), 0.5, name)
+		assert.Greater(t, lightness(t, light.Background), 0.5, name)
+		assert.Less(t, lightness(t, light.Foreground), 0.5, name)
+		assert.LessOrEqual(t, lightness(t, light.Primary), maxLightAccentL+0.03, name) // gamut clamping shifts L slightly
+	}
+}
+
+func TestNewPalette_ExplicitLightColorsWin(t *testing.T) {
+	bg := lipgloss.Color("#FAFAFA")
+	RegisterTheme(ThemeSpec{
+		Name:       "test-explicit-light",
+		Primary:    lipgloss.Color("#10B1AE"),
+		Secondary:  lipgloss.Color("#6B50FF"),
+		Background: lipgloss.Color("#16161A"),
+		Surface:    lipgloss.Color("#1A1A1F"),
+		Foreground: lipgloss.Color("#F1EFEF"),
+		Light: &CoreColors{
+			Primary:    lipgloss.Color("#0E7C7A"),
+			Secondary:  lipgloss.Color("#4B30DF"),
+			Background: bg,
+			Surface:    lipgloss.Color("#FFFFFF"),
+			Foreground: lipgloss.Color("#202020"),
+		},
+	})
+	t.Cleanup(func() { delete(themeRegistry, "test-explicit-light"); resetMemo() })
+
+	assert.Equal(t, bg, NewPalette("test-explicit-light", false).Background)
+}
+
+func TestDeriveLight_KeepsHue(t *testing.T) {
+	dark := CoreColors{
+		Primary:    lipgloss.Color("#8B1E3F"),
+		Secondary:  lipgloss.Color("#2BC4C4"),
+		Background: lipgloss.Color("#0A1628"),
+		Surface:    lipgloss.Color("#111D32"),
+		Foreground: lipgloss.Color("#E8F4FD"),
+	}
+	light := DeriveLight(dark)
+
+	hue := func(c any) float64 {
+		cf, _ := colorful.MakeColor(c.(interface{ RGBA() (r, g, b, a uint32) }))
+		h, _, _ := cf.Hcl()
+		return h
+	}
+	assert.InDelta(t, hue(dark.Background), hue(light.Background), 5)
+	assert.Equal(t, dark.Primary, light.Primary, "accents already dark enough are kept")
+}
+
+func TestThemeFile_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	spec := ThemeSpec{
+		Name:       "my-theme",
+		Primary:    lipgloss.Color("#10B1AE"),
+		Secondary:  lipgloss.Color("#6B50FF"),
+		Background: lipgloss.Color("#16161A"),
+		Surface:    lipgloss.Color("#1A1A1F"),
+		Foreground: lipgloss.Color("#F1EFEF"),
+	}
+
+	_, err := SaveThemeFile(dir, spec)
+	require.NoError(t, err)
+	specs, err := LoadThemeDir(dir)
+	require.NoError(t, err)
+
+	require.Len(t, specs, 1)
+	assert.Equal(t, "my-theme", specs[0].Name)
+	assert.Equal(t, "#10b1ae", hexOf(specs[0].Primary))
+	assert.Nil(t, specs[0].Light)
+}
+
+func TestSaveThemeFile_RejectsBuiltinAndUnsafeNames(t *testing.T) {
+	for _, name := range []string{"default", "../evil", "Upper", ""} {
+		_, err := SaveThemeFile(t.TempDir(), ThemeSpec{Name: name})
+		assert.Error(t, err, name)
+	}
+}
+
+func TestLoadThemeDir_MissingDir(t *testing.T) {
+	specs, err := LoadThemeDir(filepath.Join(t.TempDir(), "none"))
+	assert.NoError(t, err)
+	assert.Empty(t, specs)
+}
+
+func TestLoadThemeDir_AppliesOverridesPerMode(t *testing.T) {
+	dir := t.TempDir()
+	raw := `{
+  "name": "brand",
+  "dark": {
+    "primary": "#10B1AE", "secondary": "#6B50FF", "background": "#16161A",
+    "surface": "#1A1A1F", "foreground": "#F1EFEF",
+    "overrides": {"success": "#00ff00", "focus": "#ff00ff"}
+  }
+}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "brand.json"), []byte(raw), 0o644))
+
+	specs, err := LoadThemeDir(dir)
+	require.NoError(t, err)
+	require.Len(t, specs, 1)
+
+	dark := PaletteFromSpec(specs[0], true)
+	assert.Equal(t, "#00ff00", hexOf(dark.Success))
+	assert.Equal(t, "#ff00ff", hexOf(dark.Focus))
+	assert.GreaterOrEqual(t, ContrastRatio(dark.Success, dark.OnSuccess), DefaultMinContrast)
+
+	light := PaletteFromSpec(specs[0], false)
+	assert.NotEqual(t, "#00ff00", hexOf(light.Success), "dark overrides do not leak into light mode")
+}
+
+func TestLoadThemeDir_SkipsInvalidFiles(t *testing.T) {
+	dir := t.TempDir()
+	bad := `{"name": "bad", "dark": {"primary": "nope"}}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bad.json"), []byte(bad), 0o644))
+	_, err := SaveThemeFile(dir, ThemeSpec{
+		Name:       "good",
+		Primary:    lipgloss.Color("#10B1AE"),
+		Secondary:  lipgloss.Color("#6B50FF"),
+		Background: lipgloss.Color("#16161A"),
+		Surface:    lipgloss.Color("#1A1A1F"),
+		Foreground: lipgloss.Color("#F1EFEF"),
+	})
+	require.NoError(t, err)
+
+	specs, err := LoadThemeDir(dir)
+
+	assert.ErrorContains(t, err, "bad.json")
+	require.Len(t, specs, 1)
+	assert.Equal(t, "good", specs[0].Name)
+}
+
+func TestImportBase16_ClassicFormat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Default Dark.yaml")
+	raw := `scheme: "Default Dark"
+author: "Chris Kempson"
+base00: 181818
+base01: "282828"
+base02: "383838"
+base03: "585858"
+base04: "b8b8b8"
+base05: "d8d8d8"
+base06: "e8e8e8"
+base07: "f8f8f8"
+base08: "ab4642"
+base09: "dc9656"
+base0A: "f7ca88"
+base0B: "a1b56c"
+base0C: "86c1b9"
+base0D: "7cafc2"
+base0E: "ba8baf"
+base0F: "a16946"
+`
+	require.NoError(t, os.WriteFile(path, []byte(raw), 0o644))
+
+	spec, err := ImportBase16(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, "default-dark", spec.Name)
+	assert.Equal(t, "#181818", hexOf(spec.Background), "unquoted colors are read as hex")
+	assert.Equal(t, "#7cafc2", hexOf(spec.Primary))
+	assert.Nil(t, spec.Light)
+	dark := PaletteFromSpec(spec, true)
+	assert.Equal(t, "#ab4642", hexOf(dark.Error))
+}
+
+func TestImportBase16_TintedLightScheme(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "one-light.yaml")
+	raw := `system: "base16"
+name: "One Light"
+variant: "light"
+palette:
+  base00: "#fafafa"
+  base01: "#f0f0f1"
+  base02: "#e5e5e6"
+  base03: "#a0a1a7"
+  base04: "#696c77"
+  base05: "#383a42"
+  base06: "#202227"
+  base07: "#090a0b"
+  base08: "#ca1243"
+  base09: "#d75f00"
+  base0A: "#c18401"
+  base0B: "#50a14f"
+  base0C: "#0184bc"
+  base0D: "#4078f2"
+  base0E: "#a626a4"
+  base0F: "#986801"
+`
+	require.NoError(t, os.WriteFile(path, []byte(raw), 0o644))
+
+	spec, err := ImportBase16(path)
+	require.NoError(t, err)
+
+	require.NotNil(t, spec.Light)
+	assert.Equal(t, "#fafafa", hexOf(spec.Light.Background))
+	assert.Less(t, lightness(t, spec.Background), 0.2, "dark variant is derived")
+	assert.Equal(t, "#50a14f", hexOf(PaletteFromSpec(spec, false).Success))
+}
+
+func TestImportBase16_RejectsOtherSystems(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scheme.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("system: \"base8\"\npalette: {}\n"), 0o644))
+
+	_, err := ImportBase16(path)
+	assert.ErrorContains(t, err, "unsupported scheme system")
+}
+
+func TestContrastRatio_Extremes(t *testing.T) {
+	assert.InDelta(t, 21, ContrastRatio(lipgloss.Color("#000000"), lipgloss.Color("#ffffff")), 0.01)
+	assert.InDelta(t, 1, ContrastRatio(lipgloss.Color("#777777"), lipgloss.Color("#777777")), 0.01)
+}
+
+func TestBuildPalette_MeetsMinContrast(t *testing.T) {
+	for _, name := range AvailableThemes() {
+		for _, isDark := range []bool{true, false} {
+			p := NewPalette(name, isDark)
+			pairs := map[string][2]any{
+				"Foreground/Background": {p.Foreground, p.Background},
+				"Foreground/Surface":    {p.Foreground, p.Surface},
+				"OnPrimary":             {p.OnPrimary, p.Primary},
+				"OnSecondary":           {p.OnSecondary, p.Secondary},
+				"OnSuccess":             {p.OnSuccess, p.Success},
+				"OnError":               {p.OnError, p.Error},
+				"OnWarning":             {p.OnWarning, p.Warning},
+				"OnInfo":                {p.OnInfo, p.Info},
+			}
+			for label, pair := range pairs {
+				fg, bg := pair[0].(color.Color), pair[1].(color.Color)
+				// ember and neon override some On* colors by hand.
+				if spec, _ := Spec(name); spec.Modify != nil && label != "Foreground/Background" && label != "Foreground/Surface" {
+					continue
+				}
+				assert.GreaterOrEqual(t, ContrastRatio(fg, bg), DefaultMinContrast-0.01,
+					"%s (dark=%t) %s", name, isDark, label)
+			}
+		}
+	}
+}
+
+func TestSetMinContrast_ZeroDisablesCorrection(t *testing.T) {
+	t.Cleanup(func() { SetMinContrast(DefaultMinContrast) })
+	spec := ThemeSpec{
+		Name:       "low",
+		Primary:    lipgloss.Color("#10B1AE"),
+		Secondary:  lipgloss.Color("#6B50FF"),
+		Background: lipgloss.Color("#16161A"),
+		Surface:    lipgloss.Color("#1A1A1F"),
+		Foreground: lipgloss.Color("#3A3A40"), // far too dark to read
+	}
+
+	assert.GreaterOrEqual(t, ContrastRatio(PaletteFromSpec(spec, true).Foreground, spec.Background), DefaultMinContrast)
+
+	SetMinContrast(0)
+	assert.Equal(t, "#3a3a40", hexOf(PaletteFromSpec(spec, true).Foreground))
+}
+
+func TestSimulateColorVision_RedGreenConfusion(t *testing.T) {
+	red, green := lipgloss.Color("#FF4444"), lipgloss.Color("#44DD66")
+	require.Greater(t, colorDistance(red, green), 0.5)
+
+	for _, v := range []ColorVision{Deuteranopia, Protanopia} {
+		simRed, simGreen := SimulateColorVision(red, v), SimulateColorVision(green, v)
+		assert.Less(t, colorDistance(simRed, simGreen), colorDistance(red, green), v.String())
+	}
+	// Grays are unaffected.
+	gray := lipgloss.Color("#808080")
+	assert.Less(t, colorDistance(gray, SimulateColorVision(gray, Tritanopia)), 0.01)
+}
+
+func TestValidatePalette_WarnsOnColorblindConfusion(t *testing.T) {
+	p := NewPalette("default", true)
+
+	warnings := ValidatePalette(p)
+	assert.Contains(t, strings.Join(warnings, "\n"), "indistinguishable with deuteranopia")
+
+	for _, isDark := range []bool{true, false} {
+		safe := ColorblindSafeStatus(NewPalette("default", isDark), isDark)
+		for _, w := range ValidatePalette(safe) {
+			assert.NotContains(t, w, "indistinguishable", "isDark=%v", isDark)
+		}
+	}
+}
+
+func TestManager_SetColorblindSafe(t *testing.T) {
+	m := NewManager()
+	assert.Nil(t, m.SetColorblindSafe(false), "unchanged")
+
+	m.Init("default", true, 80)
+	before := m.State().Palette.Success
+	require.NotNil(t, m.SetColorblindSafe(true))
+	assert.Equal(t, ColorblindSafeStatus(NewPalette("default", true), true).Success, m.State().Palette.Success)
+	assert.NotEqual(t, before, m.State().Palette.Success)
+
+	require.NotNil(t, m.SetColorblindSafe(false))
+	assert.Equal(t, before, m.State().Palette.Success)
+}
+
+func TestNewPalette_QuantizesToColorProfile(t *testing.T) {
+	t.Cleanup(func() { SetColorProfile(colorprofile.TrueColor) })
+
+	_, indexed := NewPalette("default", true).Primary.(ansi.IndexedColor)
+	assert.False(t, indexed, "truecolor palettes are left alone")
+
+	SetColorProfile(colorprofile.ANSI256)
+	p := NewPalette("default", true)
+	assert.IsType(t, ansi.IndexedColor(0), p.Primary)
+	assert.IsType(t, ansi.IndexedColor(0), p.OnSuccess)
+
+	SetColorProfile(colorprofile.ANSI)
+	p = NewPalette("default", true)
+	assert.IsType(t, ansi.BasicColor(0), p.Background)
+	assert.IsType(t, ansi.BasicColor(0), p.Foreground)
+	assert.NotEqual(t, p.Foreground, p.Background)
+}
+
+func TestManager_Refresh_FollowsColorProfile(t *testing.T) {
+	t.Cleanup(func() { SetColorProfile(colorprofile.TrueColor) })
+	m := NewManager()
+	assert.Nil(t, m.Refresh(), "not initialised")
+	m.Init("default", true, 80)
+	other := NewManager()
+	other.Init("default", true, 80)
+
+	SetColorProfile(colorprofile.ANSI256)
+	require.NotNil(t, m.Refresh())
+	assert.IsType(t, ansi.IndexedColor(0), m.State().Palette.Primary)
+
+	// The profile is process-wide: a manager that was not refreshed builds
+	// its next palette with it too, rather than from a stale cache.
+	other.SetDarkMode(false)
+	other.SetDarkMode(true)
+	assert.IsType(t, ansi.IndexedColor(0), other.State().Palette.Primary)
+}
+
+func TestDetectCapabilities(t *testing.T) {
+	c := DetectCapabilities([]string{"TERM=xterm-256color", "COLORTERM=truecolor", "LANG=en_US.UTF-8", "TERM_PROGRAM=WezTerm"})
+	assert.True(t, c.TrueColor())
+	assert.True(t, c.Unicode)
+	assert.True(t, c.Hyperlinks)
+	assert.False(t, c.Graphemes, "only the terminal can tell")
+	assert.Equal(t, "WezTerm", c.Terminal)
+
+	c = DetectCapabilities([]string{"TERM=linux", "LANG=en_US.UTF-8"})
+	assert.False(t, c.Colors256())
+	assert.False(t, c.Unicode, "the Linux console has no emoji")
+	assert.False(t, c.Hyperlinks)
+}
+
+func TestCapabilities_Record(t *testing.T) {
+	var c Capabilities
+	assert.True(t, c.Record(tea.ColorProfileMsg{Profile: colorprofile.ANSI256}))
+	assert.True(t, c.Colors256())
+	assert.False(t, c.TrueColor())
+
+	assert.True(t, c.Record(tea.TerminalVersionMsg{Name: "kitty(0.39.1)"}))
+	assert.True(t, c.Hyperlinks)
+
+	assert.True(t, c.Record(tea.ModeReportMsg{Mode: ansi.ModeUnicodeCore, Value: ansi.ModeReset}))
+	assert.True(t, c.Graphemes)
+	assert.False(t, c.Record(tea.ModeReportMsg{Mode: ansi.ModeMouseNormal, Value: ansi.ModeSet}))
+	assert.False(t, c.Record(tea.ColorProfileMsg{Profile: colorprofile.ANSI256}), "unchanged")
+}
+
+func TestManager_SetCapabilities(t *testing.T) {
+	t.Cleanup(func() { SetColorProfile(colorprofile.TrueColor) })
+	m := NewManager()
+	caps := Capabilities{Profile: colorprofile.TrueColor, Unicode: true}
+	assert.Nil(t, m.SetCapabilities(caps), "no theme to restyle yet")
+	m.Init("default", true, 80)
+	assert.Equal(t, caps, m.State().Caps, "Init keeps the capabilities")
+
+	assert.Nil(t, m.SetCapabilities(caps), "unchanged")
+	caps.Profile = colorprofile.ANSI256
+	SetColorProfile(caps.Profile)
+	require.NotNil(t, m.SetCapabilities(caps))
+	assert.Equal(t, caps, m.Capabilities())
+	assert.IsType(t, ansi.IndexedColor(0), m.State().Palette.Primary)
+}
+
+func TestNewPalette_Monochrome(t *testing.T) {
+	SetMonochrome(true)
+	t.Cleanup(func() { SetMonochrome(false) })
+
+	p := NewPalette("default", true)
+	for _, c := range paletteColors(&p) {
+		assert.Equal(t, lipgloss.NoColor{}, *c)
+	}
+	assert.Empty(t, ValidatePalette(p))
+
+	s := NewFromPalette(p, 80)
+	out := s.StatusLeft.Render("ok")
+	assert.NotContains(t, out, "38;", "no foreground color")
+	assert.NotContains(t, out, "48;", "no background color")
+	assert.Contains(t, out, "\x1b[1m", "bold is kept")
+}
+
+func TestExportPalette_JSON(t *testing.T) {
+	p := NewPalette("ocean", true)
+	out, err := ExportPalette(p, ExportJSON)
+	require.NoError(t, err)
+
+	var got map[string]string
+	require.NoError(t, json.Unmarshal(out, &got))
+	assert.Len(t, got, len(paletteColors(&p)), "every palette color is exported")
+	assert.Equal(t, hexOf(p.Primary), got["primary"])
+	assert.Equal(t, hexOf(p.OnPrimary), got["onPrimary"])
+	assert.Equal(t, hexOf(p.ForegroundMuted), got["foregroundMuted"])
+	assert.True(t, strings.HasPrefix(string(out), "{\n  \"primary\": "), "keys keep Palette field order")
+}
+
+func TestExportPalette_CSS(t *testing.T) {
+	p := NewPalette("ocean", false)
+	out, err := ExportPalette(p, ExportCSS)
+	require.NoError(t, err)
+
+	css := string(out)
+	assert.True(t, strings.HasPrefix(css, ":root {\n"))
+	assert.Contains(t, css, "  --on-primary: "+hexOf(p.OnPrimary)+";\n")
+	assert.Contains(t, css, "  --border-muted: "+hexOf(p.BorderMuted)+";\n")
+}
+
+func TestExportPalette_Base16RoundTrip(t *testing.T) {
+	for _, isDark := range []bool{true, false} {
+		p := NewPalette("forest", isDark)
+		out, err := ExportPalette(p, ExportBase16)
+		require.NoError(t, err)
+
+		path := filepath.Join(t.TempDir(), "forest-export.yaml")
+		require.NoError(t, os.WriteFile(path, out, 0o644))
+		spec, err := ImportBase16(path)
+		require.NoError(t, err)
+
+		core := spec.core(isDark)
+		assert.Equal(t, hexOf(p.Primary), hexOf(core.Primary), "dark=%t", isDark)
+		assert.Equal(t, hexOf(p.Background), hexOf(core.Background), "dark=%t", isDark)
+		assert.Equal(t, hexOf(p.Foreground), hexOf(core.Foreground), "dark=%t", isDark)
+	}
+}
+
+func TestExportPalette_SkipsMonochromeColors(t *testing.T) {
+	SetMonochrome(true)
+	t.Cleanup(func() { SetMonochrome(false) })
+
+	out, err := ExportPalette(NewPalette("ocean", true), ExportCSS)
+	require.NoError(t, err)
+	assert.Equal(t, ":root {\n}\n", string(out))
+}
+
+func TestExportPalette_UnknownFormat(t *testing.T) {
+	_, err := ExportPalette(NewPalette("ocean", true), "xml")
+	assert.ErrorContains(t, err, `unknown export format "xml"`)
+}
+
+func TestParseANSIColor(t *testing.T) {
+	i, c, ok := ParseANSIColor("\x1b]4;12;rgb:0000/8080/ffff\x07")
+	require.True(t, ok)
+	assert.Equal(t, 12, i)
+	assert.Equal(t, "#0080ff", hexOf(c))
+
+	i, c, ok = ParseANSIColor("\x1b]4;1;rgb:cd/00/00\x1b\\")
+	require.True(t, ok, "ST-terminated reply")
+	assert.Equal(t, 1, i)
+	assert.Equal(t, "#cd0000", hexOf(c))
+
+	for _, bad := range []string{
+		"\x1b]11;rgb:0000/0000/0000\x07", // background, not OSC 4
+		"\x1b]4;16;rgb:00/00/00\x07",     // beyond the 16 ANSI colors
+		"\x1b]4;3;?\x07",                 // our own query echoed back
+	} {
+		_, _, ok := ParseANSIColor(bad)
+		assert.False(t, ok, "%q", bad)
+	}
+}
+
+// terminalReplies returns OSC 4 replies for a dark scheme whose color i has
+// red channel i*16.
+func terminalReplies() []tea.Msg {
+	msgs := make([]tea.Msg, 16)
+	for i := range msgs {
+		msgs[i] = uv.UnknownOscEvent(fmt.Sprintf("\x1b]4;%d;rgb:%02x/40/80\x07", i, i*16))
+	}
+	return msgs
+}
+
+func TestTerminalColors_CompleteNeedsAllColorsAndBackground(t *testing.T) {
+	var tc TerminalColors
+	for _, msg := range terminalReplies() {
+		assert.True(t, tc.Record(msg))
+	}
+	assert.False(t, tc.Complete(), "background not reported yet")
+	assert.False(t, tc.Record(tea.KeyPressMsg{Code: 'a'}))
+
+	tc.Record(tea.BackgroundColorMsg{Color: lipgloss.Color("#101010")})
+	assert.True(t, tc.Complete())
+}
+
+func TestTerminalColors_Spec(t *testing.T) {
+	var tc TerminalColors
+	for _, msg := range terminalReplies() {
+		tc.Record(msg)
+	}
+	tc.Record(tea.BackgroundColorMsg{Color: lipgloss.Color("#101010")})
+	tc.Record(tea.ForegroundColorMsg{Color: lipgloss.Color("#e0e0e0")})
+
+	spec := tc.Spec()
+	assert.Equal(t, TerminalThemeName, spec.Name)
+	assert.Nil(t, spec.Light, "a dark scheme derives its light variant")
+	assert.Equal(t, hexOf(tc.ANSI[4]), hexOf(spec.Primary))
+	assert.Equal(t, hexOf(tc.ANSI[5]), hexOf(spec.Secondary))
+	assert.Equal(t, "#101010", hexOf(spec.Background))
+
+	p := PaletteFromSpec(spec, true)
+	assert.Equal(t, hexOf(tc.ANSI[1]), hexOf(p.Error))
+	assert.Equal(t, hexOf(tc.ANSI[2]), hexOf(p.Success))
+
+	tc.Background = lipgloss.Color("#fafafa")
+	tc.Foreground = nil
+	spec = tc.Spec()
+	require.NotNil(t, spec.Light, "a light background makes a light scheme")
+	assert.Equal(t, hexOf(tc.ANSI[0]), hexOf(spec.Light.Foreground), "black stands in for the foreground")
+}
+
+func TestValidateThemeName_TerminalIsReserved(t *testing.T) {
+	assert.Error(t, ValidateThemeName(TerminalThemeName))
+}
+
+func TestTokens_DriveStyles(t *testing.T) {
+	t.Cleanup(func() { SetTokens(DefaultTokens()) })
+	p := NewPalette("default", true)
+
+	s := NewFromPalette(p, 80)
+	assert.Equal(t, 1, s.Footer.GetMarginTop())
+	assert.Equal(t, 3, s.Body.GetPaddingLeft())
+	assert.True(t, s.PlainTitle.GetBold())
+
+	compact := CompactTokens()
+	compact.Border.Frame = lipgloss.NormalBorder()
+	compact.Emphasis.Title = Emphasis{Underline: true}
+	SetTokens(compact)
+
+	s = NewFromPalette(p, 80)
+	assert.Equal(t, 0, s.Footer.GetMarginTop())
+	assert.Equal(t, compact.Gap.SM, s.Header.GetPaddingTop())
+	assert.Equal(t, lipgloss.NormalBorder(), s.Footer.GetBorderStyle())
+	assert.False(t, s.PlainTitle.GetBold())
+	assert.True(t, s.PlainTitle.GetUnderline())
+	assert.Equal(t, lipgloss.NormalBorder(), NewModalStylesFromPalette(p).Dialog.GetBorderStyle())
+}
+
+func TestManager_Refresh_RestylesWithTokens(t *testing.T) {
+	t.Cleanup(func() { SetTokens(DefaultTokens()) })
+	m := NewManager()
+	m.Init("default", true, 80)
+
+	SetTokens(CompactTokens())
+	cmd := m.Refresh()
+	require.NotNil(t, cmd)
+	_, ok := cmd().(ThemeChangedMsg)
+	assert.True(t, ok)
+	assert.Equal(t, CompactTokens(), CurrentTokens())
+}
+
+func TestOverride_RestylesOneComponent(t *testing.T) {
+	t.Cleanup(func() { delete(styleOverrides, "modal.dialog"); resetMemo() })
+	p := NewPalette("default", true)
+	Override("modal.dialog", func(s lipgloss.Style, p Palette) lipgloss.Style {
+		return s.Border(lipgloss.DoubleBorder()).BorderForeground(p.Secondary)
+	})
+	Override("modal.dialog", func(s lipgloss.Style, _ Palette) lipgloss.Style {
+		return s.Width(s.GetWidth() + 8)
+	})
+
+	m := NewModalStylesFromPalette(p)
+	assert.Equal(t, lipgloss.DoubleBorder(), m.Dialog.GetBorderStyle())
+	assert.Equal(t, p.Secondary, m.Dialog.GetBorderTopForeground())
+	assert.Equal(t, 60, m.Dialog.GetWidth(), "overrides run in registration order")
+	assert.True(t, m.Title.GetBold(), "other components keep their styles")
+	assert.Equal(t, lipgloss.RoundedBorder(), NewFromPalette(p, 80).Footer.GetBorderStyle())
+}
+
+// TestSettings_ConcurrentUse changes the package-level settings while other
+// goroutines build palettes and styles from them; run with -race.
+func TestSettings_ConcurrentUse(t *testing.T) {
+	t.Cleanup(func() {
+		SetTokens(DefaultTokens())
+		SetColorProfile(colorprofile.TrueColor)
+		delete(styleOverrides, "modal.title")
+		resetMemo()
+	})
+	p := NewPalette("default", true)
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for range 50 {
+				NewModalStylesFromPalette(p)
+				ListItemStyles(p)
+				degradePalette(p)
+			}
+		})
+	}
+	for i := range 50 {
+		SetTokens(map[bool]Tokens{true: CompactTokens(), false: DefaultTokens()}[i%2 == 0])
+		SetColorProfile(map[bool]colorprofile.Profile{true: colorprofile.ANSI256, false: colorprofile.TrueColor}[i%2 == 0])
+		Override("modal.title", func(s lipgloss.Style, _ Palette) lipgloss.Style { return s })
+	}
+	wg.Wait()
+}
+
+func TestBlendPalettes_Endpoints(t *testing.T) {
+	from, to := NewPalette("default", true), NewPalette("ocean", true)
+	assert.Equal(t, hexOf(from.Primary), hexOf(BlendPalettes(from, to, 0).Primary))
+	assert.Equal(t, hexOf(to.Primary), hexOf(BlendPalettes(from, to, 1).Primary))
+	mid := BlendPalettes(from, to, 0.5).Background
+	assert.NotEqual(t, hexOf(from.Background), hexOf(mid))
+	assert.NotEqual(t, hexOf(to.Background), hexOf(mid))
+}
+
+func TestManager_SetThemeName_CrossFades(t *testing.T) {
+	m := NewManager()
+	m.Init("default", true, 80)
+	m.SetFadeFrames(3)
+	from, to := NewPalette("default", true), NewPalette("ocean", true)
+
+	cmd := m.SetThemeName("ocean")
+	require.NotNil(t, cmd)
+	assert.Equal(t, "ocean", m.State().Name, "the state holds the final theme at once")
+	frame, ok := cmd().(FadeFrameMsg)
+	require.True(t, ok, "the fade starts with a tick")
+
+	var shown []Palette
+	for {
+		cmd = m.Fade(frame)
+		require.NotNil(t, cmd)
+		msg := cmd()
+		if changed, ok := msg.(ThemeChangedMsg); ok {
+			shown = append(shown, changed.State.Palette)
+			break
+		}
+		batch := msg.(tea.BatchMsg)
+		shown = append(shown, batch[0]().(ThemeChangedMsg).State.Palette)
+		frame = batch[1]().(FadeFrameMsg)
+	}
+	require.Len(t, shown, 3)
+	assert.NotEqual(t, hexOf(from.Background), hexOf(shown[0].Background))
+	assert.NotEqual(t, hexOf(to.Background), hexOf(shown[0].Background))
+	assert.Equal(t, hexOf(to.Background), hexOf(shown[2].Background), "the fade ends on the new palette")
+
+	assert.Nil(t, m.Fade(frame), "frames after the end are ignored")
+}
+
+func TestManager_SetThemeName_SnapsWithoutFade(t *testing.T) {
+	m := NewManager()
+	m.Init("default", true, 80)
+	cmd := m.SetThemeName("ocean")
+	require.NotNil(t, cmd)
+	_, ok := cmd().(ThemeChangedMsg)
+	assert.True(t, ok)
+}
+
+func TestRamp_RunsFromPrimaryToSecondary(t *testing.T) {
+	p := NewPalette("ocean", true)
+	ramp := Ramp(p, 5)
+	require.Len(t, ramp, 5)
+	assert.Equal(t, hexOf(p.Primary), hexOf(ramp[0]))
+	assert.Equal(t, hexOf(p.Secondary), hexOf(ramp[4]))
+
+	assert.Nil(t, Ramp(p, 0))
+	assert.Equal(t, []color.Color{p.Primary}, Ramp(p, 1))
+}
+
+func TestStatusRamp_PassesThroughWarning(t *testing.T) {
+	p := NewPalette("default", true)
+	ramp := StatusRamp(p, 5)
+	assert.Equal(t, hexOf(p.Success), hexOf(ramp[0]))
+	assert.Equal(t, hexOf(p.Warning), hexOf(ramp[2]), "the middle stop sits mid-ramp")
+	assert.Equal(t, hexOf(p.Error), hexOf(ramp[4]))
+}
+
+func TestRampThrough_QuantizesToProfile(t *testing.T) {
+	t.Cleanup(func() { SetColorProfile(colorprofile.TrueColor) })
+	SetColorProfile(colorprofile.ANSI)
+	for _, c := range RampThrough(6, lipgloss.Color("#10B1AE"), lipgloss.Color("#6B50FF")) {
+		_, ok := c.(ansi.BasicColor)
+		assert.True(t, ok, "%T is an ANSI color", c)
+	}
+}
+
+func TestNewPalette_IsCachedUntilRegistrationChanges(t *testing.T) {
+	registerForTest(t, testSpec("memo", "#ff0000"))
+	first := NewPalette("memo", true)
+	assert.Contains(t, memo.palettes, paletteKey{"memo", true})
+	assert.Equal(t, first, NewPalette("memo", true))
+
+	registerForTest(t, testSpec("memo", "#0000ff"))
+	assert.Equal(t, "#0000ff", hexOf(NewPalette("memo", true).Primary),
+		"re-registering a theme drops cached palettes")
+}
+
+func TestNewFromPalette_CachesByWidth(t *testing.T) {
+	t.Cleanup(resetMemo)
+	p := NewPalette("default", true)
+	narrow := NewFromPalette(p, 60)
+	assert.Equal(t, narrow, NewFromPalette(p, 60))
+	assert.Equal(t, ContentWidth(100), NewFromPalette(p, 100).MaxWidth)
+	assert.Len(t, memo.styles, 2)
+
+	other := NewPalette("default", false)
+	assert.Equal(t, other.Foreground, NewFromPalette(other, 60).Body.GetForeground(),
+		"a new palette is never served stale styles")
+	assert.Len(t, memo.styles, 1)
+
+	SetTokens(CompactTokens())
+	t.Cleanup(func() { SetTokens(DefaultTokens()) })
+	assert.Equal(t, 0, NewFromPalette(other, 60).Footer.GetMarginTop(),
+		"changing tokens drops cached styles")
+}
+
+func TestNewManager_IsIndependent(t *testing.T) {
+	a, b := NewManager(), NewManager()
+	a.Init("default", true, 80)
+	b.Init("ocean", false, 40)
+	assert.Equal(t, "default", a.State().Name)
+	assert.Equal(t, 80, a.State().Width)
+
+	assert.Nil(t, FromContext(context.Background()))
+	assert.Same(t, b, FromContext(NewContext(context.Background(), b)))
+}
+
+func TestManager_SetPaletteSource(t *testing.T) {
+	fixed := NewPalette("ocean", true)
+	m := NewManager()
+	m.Init("default", true, 80)
+	m.SetPaletteSource(func(string, bool) Palette { return fixed })
+	assert.Equal(t, fixed, m.State().Palette, "the current palette is rebuilt")
+
+	m.SetThemeName("forest")
+	assert.Equal(t, fixed, m.State().Palette)
+}
+
+func TestHuhFromPalette_UsesOnlyPaletteColors(t *testing.T) {
+	p := NewPalette("ocean", true)
+	s := HuhFromPalette(p)
+
+	assert.Equal(t, p.Primary, s.Focused.Title.GetForeground())
+	assert.Equal(t, p.Secondary, s.Blurred.Title.GetForeground())
+	assert.Equal(t, p.Primary, s.Focused.FocusedButton.GetBackground())
+	assert.Equal(t, p.SurfaceRaised, s.Focused.BlurredButton.GetBackground())
+	assert.Equal(t, p.Primary, s.Focused.Directory.GetForeground(), "file picker")
+	assert.Equal(t, p.Foreground, s.Focused.File.GetForeground(), "file picker")
+	assert.Equal(t, p.Foreground, s.Focused.TextInput.Text.GetForeground())
+	assert.Equal(t, p.ForegroundMuted, s.Help.ShortKey.GetForeground())
+	assert.Equal(t, p.ForegroundSubtle, s.Help.FullDesc.GetForeground())
+}
+
+func TestGenerateRandom_IsValidForAnySeed(t *testing.T) {
+	for h := 0.0; h < 360; h += 15 {
+		for _, l := range []float64{0.1, 0.5, 0.95} {
+			seed := colorful.Hcl(h, 0.6, l)
+			spec, err := GenerateRandom(seed)
+			require.NoError(t, err, "seed %s", seed.Hex())
+			assert.Equal(t, RandomThemeName, spec.Name)
+			assert.Empty(t, generatedWarnings(spec, true), "seed %s", seed.Hex())
+			assert.Empty(t, generatedWarnings(spec, false), "seed %s", seed.Hex())
+			assert.GreaterOrEqual(t, colorDistance(spec.Primary, spec.Secondary), minSecondaryDistance)
+		}
+	}
+
+	_, err := GenerateRandom(color.RGBA{})
+	assert.ErrorContains(t, err, "unusable seed")
+}
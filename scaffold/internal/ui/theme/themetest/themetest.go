@@ -0,0 +1,71 @@
+// Package themetest provides theme test doubles: a fixed palette that does
+// not depend on the theme registry, contrast correction or color profile, a
+// theme state carrying it, and a theme manager that shows it whatever theme
+// is selected.
+//
+// Give each test its own manager instead of the deprecated
+// [theme.GetManager], so parallel tests do not see each other's theme.
+// Package-level settings such as [theme.SetTokens] are process-wide and
+// still shared:
+//
+//	mgr := themetest.NewManager(themetest.Palette())
+//	ctx := theme.NewContext(context.Background(), mgr)
+package themetest
+
+import (
+	"charm.land/lipgloss/v2"
+
+	"scaffold/internal/ui/theme"
+)
+
+// Palette returns a fixed dark palette in which every color is distinct, so
+// tests can tell from a rendered color which field it came from.
+func Palette() theme.Palette {
+	return theme.Palette{
+		Primary:    lipgloss.Color("#0000aa"),
+		Secondary:  lipgloss.Color("#0000bb"),
+		Background: lipgloss.Color("#000000"),
+		Surface:    lipgloss.Color("#111111"),
+		Foreground: lipgloss.Color("#eeeeee"),
+
+		SurfaceRaised: lipgloss.Color("#222222"),
+		Overlay:       lipgloss.Color("#333333"),
+		Border:        lipgloss.Color("#444444"),
+		BorderMuted:   lipgloss.Color("#555555"),
+
+		ForegroundMuted:  lipgloss.Color("#aaaaaa"),
+		ForegroundSubtle: lipgloss.Color("#999999"),
+
+		OnPrimary:      lipgloss.Color("#ffffff"),
+		PrimaryMuted:   lipgloss.Color("#000055"),
+		OnSecondary:    lipgloss.Color("#fffffe"),
+		SecondaryMuted: lipgloss.Color("#000066"),
+
+		Focus: lipgloss.Color("#0000cc"),
+
+		Success: lipgloss.Color("#00aa00"),
+		Error:   lipgloss.Color("#aa0000"),
+		Warning: lipgloss.Color("#aaaa00"),
+		Info:    lipgloss.Color("#00aaaa"),
+
+		OnSuccess: lipgloss.Color("#010101"),
+		OnError:   lipgloss.Color("#020202"),
+		OnWarning: lipgloss.Color("#030303"),
+		OnInfo:    lipgloss.Color("#040404"),
+	}
+}
+
+// State returns a dark theme state carrying Palette, for applying straight
+// to a screen under test.
+func State() theme.State {
+	return theme.State{Name: "default", IsDark: true, Palette: Palette()}
+}
+
+// NewManager returns a theme manager whose palette is always p, in dark and
+// light mode and for every theme name. Manager-wide options such as
+// colorblind-safe status colors still apply on top of it.
+func NewManager(p theme.Palette) *theme.Manager {
+	m := theme.NewManager()
+	m.SetPaletteSource(func(string, bool) theme.Palette { return p })
+	return m
+}
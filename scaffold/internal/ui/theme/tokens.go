@@ -0,0 +1,107 @@
+package theme
+
+import "charm.land/lipgloss/v2"
+
+// Tokens are the design decisions other than color that styles are built
+// from: how much space surrounds things, which borders frame them, and how
+// text is emphasised. Every style constructor in this package reads the
+// current tokens, so switching them with [SetTokens] changes the density and
+// look of the whole app at once.
+type Tokens struct {
+	Space    Spacing    // horizontal padding and margins, in columns
+	Gap      Spacing    // vertical padding and margins, in lines
+	Border   Borders    // border shapes
+	Emphasis Typography // text emphasis levels
+}
+
+// Spacing is a scale of distances, smallest first.
+type Spacing struct {
+	XS int // separates text from an adjacent edge
+	SM int // pads boxes and separates blocks
+	MD int // insets body text from the window edge
+}
+
+// Borders holds the border shapes styles draw with.
+type Borders struct {
+	Frame lipgloss.Border // boxes such as dialogs and the footer
+	Rule  lipgloss.Border // dividers such as a title's underline
+}
+
+// Typography holds the emphasis levels text is styled with.
+type Typography struct {
+	Title  Emphasis // headings and titles
+	Strong Emphasis // selected items and important status
+	Hint   Emphasis // hints and secondary notes
+}
+
+// Emphasis is a combination of text attributes.
+type Emphasis struct {
+	Bold      bool
+	Italic    bool
+	Underline bool
+	Faint     bool
+}
+
+// Apply sets e's attributes on s. Attributes e leaves off are left as they
+// are in s.
+func (e Emphasis) Apply(s lipgloss.Style) lipgloss.Style {
+	if e.Bold {
+		s = s.Bold(true)
+	}
+	if e.Italic {
+		s = s.Italic(true)
+	}
+	if e.Underline {
+		s = s.Underline(true)
+	}
+	if e.Faint {
+		s = s.Faint(true)
+	}
+	return s
+}
+
+// DefaultTokens returns the tokens the app is designed around.
+func DefaultTokens() Tokens {
+	return Tokens{
+		Space: Spacing{XS: 1, SM: 2, MD: 3},
+		Gap:   Spacing{XS: 1, SM: 2, MD: 3},
+		Border: Borders{
+			Frame: lipgloss.RoundedBorder(),
+			Rule:  lipgloss.NormalBorder(),
+		},
+		Emphasis: Typography{
+			Title:  Emphasis{Bold: true},
+			Strong: Emphasis{Bold: true},
+			Hint:   Emphasis{Italic: true},
+		},
+	}
+}
+
+// CompactTokens returns tokens that drop most vertical spacing, for small
+// terminals and users who prefer density.
+func CompactTokens() Tokens {
+	t := DefaultTokens()
+	t.Gap = Spacing{XS: 0, SM: 1, MD: 1}
+	return t
+}
+
+// tokens are the design tokens styles are currently built from, guarded by
+// settingsMu.
+var tokens = DefaultTokens()
+
+// SetTokens sets the design tokens styles are built from, for every Manager
+// in the process. Styles built before the call keep the old tokens; once the
+// TUI is running, call [Manager.Refresh] afterwards to rebuild them.
+func SetTokens(t Tokens) {
+	settingsMu.Lock()
+	tokens = t
+	settingsMu.Unlock()
+	resetMemo()
+}
+
+// CurrentTokens returns the design tokens styles are built from.
+func CurrentTokens() Tokens {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return tokens
+}
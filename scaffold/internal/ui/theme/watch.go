@@ -0,0 +1,108 @@
+package theme
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/fsnotify/fsnotify"
+)
+
+// ThemeFileChangedMsg reports that a theme file in a watched directory was
+// written. Spec holds the reloaded theme, or Err why it could not be loaded.
+// Pass Spec to [Manager.Reload] on the UI goroutine to apply it.
+type ThemeFileChangedMsg struct {
+	Path string
+	Spec ThemeSpec
+	Err  error
+}
+
+// watchDebounce collapses the bursts of events editors produce for one save.
+const watchDebounce = 100 * time.Millisecond
+
+// Watch watches dir for theme files being written and sends a
+// [ThemeFileChangedMsg] through send for each one, until ctx is done. The
+// directory is created if missing so that new themes can be dropped in.
+//
+// Watch only parses files; it never touches the registry, which belongs to
+// the UI goroutine. It is meant to run in its own goroutine with send bound
+// to the program, e.g. (*tea.Program).Send.
+func (m *Manager) Watch(ctx context.Context, dir string, send func(tea.Msg)) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("theme: creating %s: %w", dir, err)
+	}
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("theme: watching %s: %w", dir, err)
+	}
+	defer w.Close()
+	if err := w.Add(dir); err != nil {
+		return fmt.Errorf("theme: watching %s: %w", dir, err)
+	}
+
+	var mu sync.Mutex
+	pending := map[string]*time.Timer{}
+	defer func() {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, t := range pending {
+			t.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err, ok := <-w.Errors:
+			if !ok {
+				return nil
+			}
+			send(ThemeFileChangedMsg{Path: dir, Err: fmt.Errorf("theme: watching %s: %w", dir, err)})
+		case ev, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+			if (!ev.Has(fsnotify.Write) && !ev.Has(fsnotify.Create)) || !isThemeFile(ev.Name) {
+				continue
+			}
+			path := filepath.Clean(ev.Name)
+			mu.Lock()
+			if t, ok := pending[path]; ok {
+				t.Reset(watchDebounce)
+			} else {
+				pending[path] = time.AfterFunc(watchDebounce, func() {
+					mu.Lock()
+					delete(pending, path)
+					mu.Unlock()
+					if ctx.Err() != nil {
+						return
+					}
+					spec, err := LoadThemeFile(path)
+					send(ThemeFileChangedMsg{Path: path, Spec: spec, Err: err})
+				})
+			}
+			mu.Unlock()
+		}
+	}
+}
+
+// Reload registers a theme reloaded from disk. When it is the active theme,
+// or one the active theme extends, the palette is rebuilt and a ThemeChangedMsg command is returned so the UI
+// restyles; otherwise Reload returns nil.
+func (m *Manager) Reload(spec ThemeSpec) tea.Cmd {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	RegisterTheme(spec)
+	clear(m.paletteCache)
+	if !extendsTheme(m.state.Name, spec.Name) {
+		return nil
+	}
+	m.state.Palette = m.getCachedPalette(m.state.Name, m.state.IsDark)
+	return RequestThemeUpdate(m.state)
+}
@@ -0,0 +1,92 @@
+package theme
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSpec(name, primary string) ThemeSpec {
+	return ThemeSpec{
+		Name:       name,
+		Primary:    lipgloss.Color(primary),
+		Secondary:  lipgloss.Color("#6B50FF"),
+		Background: lipgloss.Color("#16161A"),
+		Surface:    lipgloss.Color("#1A1A1F"),
+		Foreground: lipgloss.Color("#F1EFEF"),
+	}
+}
+
+func TestWatch_ReportsRewrittenThemeFile(t *testing.T) {
+	dir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	msgs := make(chan tea.Msg, 8)
+	done := make(chan error, 1)
+	m := NewManager()
+	go func() { done <- m.Watch(ctx, dir, func(msg tea.Msg) { msgs <- msg }) }()
+	time.Sleep(50 * time.Millisecond) // let the watcher subscribe
+
+	_, err := SaveThemeFile(dir, testSpec("hot", "#ff0000"))
+	require.NoError(t, err)
+
+	select {
+	case msg := <-msgs:
+		changed, ok := msg.(ThemeFileChangedMsg)
+		require.True(t, ok)
+		require.NoError(t, changed.Err)
+		assert.Equal(t, "hot", changed.Spec.Name)
+		assert.Equal(t, "#ff0000", hexOf(changed.Spec.Primary))
+	case <-time.After(2 * time.Second):
+		t.Fatal("no reload reported")
+	}
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestWatch_IgnoresOtherFiles(t *testing.T) {
+	dir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	msgs := make(chan tea.Msg, 8)
+	m := NewManager()
+	go m.Watch(ctx, dir, func(msg tea.Msg) { msgs <- msg })
+	time.Sleep(50 * time.Millisecond)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("x"), 0o644))
+
+	select {
+	case msg := <-msgs:
+		t.Fatalf("unexpected %T", msg)
+	case <-time.After(3 * watchDebounce):
+	}
+}
+
+func TestReload_RestylesOnlyTheActiveTheme(t *testing.T) {
+	t.Cleanup(func() {
+		delete(themeRegistry, "hot")
+		delete(themeRegistry, "cold")
+		resetMemo()
+	})
+	m := NewManager()
+	RegisterTheme(testSpec("hot", "#ff0000"))
+	m.Init("hot", true, 80)
+
+	assert.Nil(t, m.Reload(testSpec("cold", "#0000ff")), "inactive theme")
+
+	cmd := m.Reload(testSpec("hot", "#00ff00"))
+	require.NotNil(t, cmd)
+	changed, ok := cmd().(ThemeChangedMsg)
+	require.True(t, ok)
+	assert.Equal(t, "#00ff00", hexOf(changed.State.Palette.Primary))
+}
@@ -0,0 +1,108 @@
+// Package ui — theme and dark/light quick toggles for rootModel.
+package ui
+
+import (
+	"slices"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"scaffold/config"
+	"scaffold/internal/logger"
+	"scaffold/internal/ui/status"
+	"scaffold/internal/ui/theme"
+)
+
+// configSaveDelay is how long the config waits after a quick toggle before
+// it is written, so flicking through themes saves once.
+const configSaveDelay = time.Second
+
+// appearances are the Appearance settings in the order alt+d cycles them.
+var appearances = []string{"auto", "dark", "light"}
+
+// configSaveMsg writes the config when no toggle has come since the one
+// that scheduled it.
+type configSaveMsg struct {
+	gen int
+}
+
+// isDark reports whether the dark variant of the theme is shown: as the
+// config's Appearance forces, or following the terminal background.
+func (m rootModel) isDark() bool {
+	switch m.cfg.UI.Appearance {
+	case "dark":
+		return true
+	case "light":
+		return false
+	}
+	return m.bgDark
+}
+
+// handleCycleTheme switches to the theme step places after the current one
+// in theme.AvailableThemes, wrapping around, and saves it shortly after.
+func (m rootModel) handleCycleTheme(step int) (tea.Model, tea.Cmd) {
+	themes := slices.DeleteFunc(theme.AvailableThemes(), func(name string) bool {
+		return name == theme.RandomThemeName
+	})
+	if len(themes) == 0 {
+		return m, nil
+	}
+	i := slices.Index(themes, m.cfg.UI.ThemeName)
+	if i < 0 && step < 0 {
+		i = 0 // a theme not in the list, such as a rolled one, steps back to the last
+	}
+	next := themes[((i+step)%len(themes)+len(themes))%len(themes)]
+	m.cfg.UI.ThemeName = next
+	return m, tea.Batch(
+		status.SetInfo("Theme: "+next, 0),
+		m.themeMgr.SetThemeName(next),
+		m.saveConfigLater(),
+	)
+}
+
+// handleCycleAppearance moves the Appearance setting on from auto to dark to
+// light and back, and saves it shortly after.
+func (m rootModel) handleCycleAppearance() (tea.Model, tea.Cmd) {
+	i := slices.Index(appearances, m.cfg.UI.Appearance) // -1, as if auto, when unset
+	m.cfg.UI.Appearance = appearances[(max(i, 0)+1)%len(appearances)]
+	text := "Appearance: " + m.cfg.UI.Appearance
+	if m.cfg.UI.Appearance == "auto" {
+		text += " (follows the terminal)"
+	}
+	return m, tea.Batch(status.SetInfo(text, 0), m.applyAppearance(), m.saveConfigLater())
+}
+
+// saveConfigLater returns the command that writes the config after
+// configSaveDelay unless another change comes first, or nil without a config
+// file.
+func (m *rootModel) saveConfigLater() tea.Cmd {
+	if m.configPath == "" {
+		return nil
+	}
+	m.saveGen++
+	m.savePending = true
+	gen := m.saveGen
+	return tea.Tick(configSaveDelay, func(time.Time) tea.Msg { return configSaveMsg{gen: gen} })
+}
+
+func (m rootModel) handleConfigSave(msg configSaveMsg) (tea.Model, tea.Cmd) {
+	if msg.gen != m.saveGen || m.configPath == "" {
+		return m, nil
+	}
+	m.savePending = false
+	if err := config.Save(&m.cfg, m.configPath); err != nil {
+		return m, status.SetError("Save failed: "+err.Error(), 0)
+	}
+	return m, nil
+}
+
+// flushConfig writes a debounced config save that has not fired yet, so a
+// toggle made just before quitting is kept.
+func (m rootModel) flushConfig() {
+	if !m.savePending || m.configPath == "" {
+		return
+	}
+	if err := config.Save(&m.cfg, m.configPath); err != nil {
+		logger.Debug("config not saved: %v", err)
+	}
+}
@@ -0,0 +1,122 @@
+// Package ui — custom theme persistence for rootModel.
+package ui
+
+import (
+	"path/filepath"
+
+	tea "charm.land/bubbletea/v2"
+
+	"scaffold/config"
+	"scaffold/internal/logger"
+	"scaffold/internal/ui/screens"
+	"scaffold/internal/ui/status"
+	"scaffold/internal/ui/theme"
+)
+
+// themesDir is the directory of custom theme files, next to the config file.
+const themesDir = "themes"
+
+// themesPath returns where custom themes are saved, or "" when there is no
+// config file to sit beside.
+func (m rootModel) themesPath() string {
+	if m.configPath == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(m.configPath), themesDir)
+}
+
+// loadCustomThemes registers every theme in the themes directory. Files that
+// fail to load are logged and skipped; contrast warnings are logged here and
+// shown in Settings while the theme is selected. All themes are registered
+// before any is checked, so a theme may extend one loaded after it.
+func (m rootModel) loadCustomThemes() {
+	dir := m.themesPath()
+	if dir == "" {
+		return
+	}
+	specs, err := theme.LoadThemeDir(dir)
+	if err != nil {
+		logger.Debug("custom themes: %v", err)
+	}
+	for _, spec := range specs {
+		m.themeMgr.Register(spec)
+	}
+	for _, spec := range specs {
+		if _, err := theme.ResolveSpec(spec); err != nil {
+			logger.Debug("custom themes: %v (using default colors)", err)
+		}
+		for _, isDark := range []bool{true, false} {
+			for _, w := range theme.ValidatePalette(theme.PaletteFromSpec(spec, isDark)) {
+				logger.Debug("custom theme %s (dark=%t): %s", spec.Name, isDark, w)
+			}
+		}
+	}
+}
+
+// designTokens returns the design tokens for cfg's density setting.
+func designTokens(cfg config.Config) theme.Tokens {
+	if cfg.UI.CompactMode {
+		return theme.CompactTokens()
+	}
+	return theme.DefaultTokens()
+}
+
+// registerTerminalTheme registers the "terminal" theme once the terminal has
+// reported its colors, and re-registers it when a later reply changes them.
+// It restyles the UI when the theme is active and returns nil until the
+// colors are complete; terminals that ignore OSC 4 never get the theme.
+func (m rootModel) registerTerminalTheme() tea.Cmd {
+	if !m.termColors.Complete() {
+		return nil
+	}
+	return m.themeMgr.Reload(m.termColors.Spec())
+}
+
+func (m rootModel) handleThemePreview(msg screens.ThemePreviewMsg) (tea.Model, tea.Cmd) {
+	if msg.Spec == nil {
+		return m, m.themeMgr.EndPreview()
+	}
+	return m, m.themeMgr.Preview(*msg.Spec)
+}
+
+// handleThemeSaveAs writes the edited theme, registers it, makes it the
+// configured theme, and closes the editor. Without a config file the theme
+// is only registered for the session.
+func (m rootModel) handleThemeSaveAs(msg screens.ThemeSaveAsMsg) (tea.Model, tea.Cmd) {
+	var saveCmd tea.Cmd
+	if dir := m.themesPath(); dir == "" {
+		saveCmd = status.SetInfo("Theme "+msg.Spec.Name+" applied (no config file)", 0)
+	} else if _, err := theme.SaveThemeFile(dir, msg.Spec); err != nil {
+		return m, status.SetError("Save failed: "+err.Error(), 0)
+	} else {
+		saveCmd = status.SetSuccess("Theme "+msg.Spec.Name+" saved", 0)
+	}
+
+	m.themeMgr.Register(msg.Spec)
+	m.cfg.UI.ThemeName = msg.Spec.Name
+	if m.configPath != "" {
+		if err := config.Save(&m.cfg, m.configPath); err != nil {
+			saveCmd = status.SetError("Save failed: "+err.Error(), 0)
+		}
+	}
+
+	themeCmd := m.themeMgr.SetThemeName(msg.Spec.Name)
+	if themeCmd == nil {
+		// Overwrote the active custom theme: the name is unchanged, so
+		// re-read its freshly registered palette instead.
+		themeCmd = m.themeMgr.EndPreview()
+	}
+	updated, backCmd := m.handleBack(screens.BackMsg{})
+	return updated, tea.Batch(saveCmd, themeCmd, backCmd)
+}
+
+// handleThemeFileChanged applies a theme file edited while the app runs.
+func (m rootModel) handleThemeFileChanged(msg theme.ThemeFileChangedMsg) (tea.Model, tea.Cmd) {
+	if msg.Err != nil {
+		return m, status.SetError("Theme reload failed: "+msg.Err.Error(), 0)
+	}
+	return m, tea.Batch(
+		m.themeMgr.Reload(msg.Spec),
+		status.SetInfo("Theme "+msg.Spec.Name+" reloaded", 0),
+	)
+}
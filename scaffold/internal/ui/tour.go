@@ -0,0 +1,180 @@
+// Package ui — the --tour walkthrough of every built-in screen.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"scaffold/internal/logger"
+	"scaffold/internal/ui/keys"
+	"scaffold/internal/ui/modal"
+	"scaffold/internal/ui/nav"
+	"scaffold/internal/ui/screens"
+	"scaffold/internal/ui/status"
+)
+
+// tourStepDuration is how long the tour stays on a step before moving on.
+const tourStepDuration = 6 * time.Second
+
+// tourModalID identifies the dialog shown by the tour, so its answer is
+// recognisable if the tour ends while it is open.
+const tourModalID = "tour"
+
+// tourStep is one stop of the tour: what is shown and what the footer says
+// about it.
+type tourStep struct {
+	narration string
+	route     string // screen pushed on Home, as accepted by buildScreen; "" stays on Home
+	welcome   bool   // push the welcome screen, which has no route
+	modal     bool   // show a confirmation dialog on top
+}
+
+// tourSteps are shown in order. Each screen is opened fresh on top of Home,
+// so steps do not depend on each other.
+var tourSteps = []tourStep{
+	{narration: "Home lists every screen: ↑/↓ to move, enter to open"},
+	{narration: "The welcome screen greets first-time users", welcome: true},
+	{narration: "Detail screens load their content in a cancellable background task", route: "detail?id=about&title=About"},
+	{narration: "Settings is generated from the config struct and validated as you type", route: "settings"},
+	{narration: "Dialogs take the focus until they are answered", modal: true},
+	{narration: "The theme editor previews palette changes live and saves custom themes", route: "theme-editor"},
+	{narration: "The font catalog previews every banner font; g cycles gradients, c copies the name", route: "fonts"},
+	{narration: "Notes is a scratchpad kept beside the config file; ctrl+n opens it anywhere", route: "notes"},
+	{narration: "Capabilities shows what the terminal reports it supports", route: "capabilities"},
+	{narration: "The key debugger shows each key event as the terminal sends it", route: "key-debug"},
+	{narration: "Feature flags turn experimental features on and off; f9 opens them anywhere", route: "features"},
+}
+
+// tour is the state of the --tour walkthrough.
+type tour struct {
+	active bool
+	step   int // index into tourSteps; -1 before the first step is shown
+	keys   keys.TourKeyMap
+}
+
+// tourTickMsg moves the tour on from step when it is still showing it.
+// Ticks from steps that were skipped are ignored.
+type tourTickMsg struct {
+	step int
+}
+
+// tourTick returns a command that moves the tour on from step once
+// tourStepDuration has passed.
+func tourTick(step int) tea.Cmd {
+	return tea.Tick(tourStepDuration, func(time.Time) tea.Msg {
+		return tourTickMsg{step: step}
+	})
+}
+
+// WithTour returns m set up to run the tour when it starts: every built-in
+// screen is shown in turn with narration in the footer, advancing on its
+// own. While the tour runs, keys move between steps instead of reaching the
+// screens. The first-run welcome is left to the tour.
+func (m rootModel) WithTour() rootModel {
+	m.tour = tour{active: true, step: -1, keys: keys.DefaultTourKeyMap()}
+	m.firstRun = false
+	return m
+}
+
+// startTour returns the command that shows the first step of the tour, or
+// nil when no tour was requested.
+func (m rootModel) startTour() tea.Cmd {
+	if !m.tour.active {
+		return nil
+	}
+	return func() tea.Msg { return tourTickMsg{step: m.tour.step} }
+}
+
+func (m rootModel) handleTourTick(msg tourTickMsg) (tea.Model, tea.Cmd) {
+	if !m.tour.active || msg.step != m.tour.step {
+		return m, nil
+	}
+	return m.showTourStep(m.tour.step + 1)
+}
+
+// handleTourKey handles a key press while the tour runs. Keys other than the
+// tour's own are dropped, so screens are looked at, not used.
+func (m rootModel) handleTourKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.tour.keys.Quit):
+		return m, tea.Quit
+	case key.Matches(msg, m.tour.keys.Next):
+		return m.showTourStep(m.tour.step + 1)
+	case key.Matches(msg, m.tour.keys.Prev):
+		return m.showTourStep(max(m.tour.step-1, 0))
+	case key.Matches(msg, m.tour.keys.End):
+		// Stay on the current screen so it can be tried out.
+		return m.endTour("Tour ended")
+	}
+	return m, nil
+}
+
+// showTourStep shows step i of the tour, or ends the tour when there are no
+// more steps.
+func (m rootModel) showTourStep(i int) (tea.Model, tea.Cmd) {
+	if i >= len(tourSteps) {
+		next, cmd := m.handlePopTo(nav.PopToMsg{ID: "home"})
+		m = next.(rootModel)
+		next, endCmd := m.endTour("Tour finished")
+		return next, tea.Batch(cmd, endCmd)
+	}
+	step := tourSteps[i]
+	m.tour.step = i
+	m.modal = modal.Model{}
+
+	next, cmd := m.handlePopTo(nav.PopToMsg{ID: "home"})
+	m = next.(rootModel)
+	cmds := []tea.Cmd{cmd}
+	if s := m.tourScreen(step); s != nil {
+		next, cmd = m.handleNavigate(NavigateMsg{Screen: s})
+		m = next.(rootModel)
+		cmds = append(cmds, cmd)
+	}
+	if step.modal {
+		m.modal = modal.New(modal.ShowMsg{
+			ID:    tourModalID,
+			Kind:  modal.KindConfirm,
+			Title: "Confirm",
+			Body:  "Discard unsaved changes?",
+		}, m.themeMgr.State().Palette)
+	}
+	m.statusbar = m.statusbar.WithNarration(fmt.Sprintf("Tour %d/%d · %s", i+1, len(tourSteps), step.narration))
+	return m, tea.Batch(append(cmds, tourTick(i))...)
+}
+
+// tourScreen builds the screen step shows on top of Home, or returns nil
+// when it stays on Home.
+func (m rootModel) tourScreen(step tourStep) screens.Screen {
+	if step.welcome {
+		return screens.NewWelcome()
+	}
+	if step.route == "" {
+		return nil
+	}
+	st, err := nav.ParseRoute(step.route)
+	if err != nil {
+		logger.Debug("tour: %v", err)
+		return nil
+	}
+	s, ok := m.buildScreen(st)
+	if !ok {
+		logger.Debug("tour: unknown screen %q", st.Route)
+		return nil
+	}
+	return s
+}
+
+// endTour stops the tour and hands the keys back to the screens, reporting
+// text in the footer.
+func (m rootModel) endTour(text string) (tea.Model, tea.Cmd) {
+	m.tour.active = false
+	m.modal = modal.Model{}
+	m.statusbar = m.statusbar.WithNarration("")
+	// The help changes back from the tour keys, which can change its height.
+	m.bodyH = m.bodyHeight()
+	m.sizeTop()
+	return m, status.SetInfo(text, 0)
+}
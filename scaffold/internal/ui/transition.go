@@ -0,0 +1,62 @@
+// Package ui — push/pop transition and theme fade animation for rootModel.
+package ui
+
+import (
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"scaffold/internal/features"
+	"scaffold/internal/ui/nav"
+)
+
+// transitionFrames maps the AnimationSpeed setting to a frame count at
+// nav's ~30 fps tick. "none" (and anything unknown) disables animation.
+func transitionFrames(speed string) int {
+	switch speed {
+	case "slow":
+		return 12
+	case "normal":
+		return 8
+	case "fast":
+		return 4
+	}
+	return 0
+}
+
+// bodyView renders the active screen's body as it appears in View.
+func (m rootModel) bodyView() string {
+	// MaxWidth clips screens that cannot reflow any narrower rather than
+	// letting the App frame wrap their lines.
+	return m.styles.Body.MaxHeight(m.bodyH).MaxWidth(m.styles.MaxWidth).Render(m.stack.Top().Body())
+}
+
+// animate starts a transition from the previously rendered body to the
+// current one, honouring the Transition and AnimationSpeed settings. It
+// returns nil when animation is disabled, by the settings, reduced motion or
+// the transitions feature flag, the UI is not ready yet, or the navigation
+// was a no-op.
+func (m *rootModel) animate(dir nav.Direction, from string) tea.Cmd {
+	if m.state != rootStateReady || m.cfg.UI.ReducedMotion || !features.Transitions.Enabled() {
+		return nil
+	}
+	to := m.bodyView()
+	if to == from {
+		return nil
+	}
+	m.anim = nav.NewAnimation(
+		nav.TransitionStyle(m.cfg.UI.Transition), dir, from, to,
+		max(lipgloss.Width(from), lipgloss.Width(to)),
+		transitionFrames(m.cfg.UI.AnimationSpeed),
+	)
+	return m.anim.Start()
+}
+
+// themeFadeFrames returns how many frames theme switches cross-fade over:
+// none unless the theme-fade feature flag is on and motion is not reduced,
+// else as many as a screen transition at the configured AnimationSpeed.
+func (m rootModel) themeFadeFrames() int {
+	if !features.ThemeFade.Enabled() || m.cfg.UI.ReducedMotion {
+		return 0
+	}
+	return transitionFrames(m.cfg.UI.AnimationSpeed)
+}
@@ -3,22 +3,104 @@ package ui
 
 import (
 	"context"
+	"os"
+	"sync"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/colorprofile"
 
 	"scaffold/config"
+	"scaffold/internal/features"
+	"scaffold/internal/logger"
+	"scaffold/internal/timefmt"
+	"scaffold/internal/ui/nav"
+	"scaffold/internal/ui/theme"
 )
 
 // New creates a new root model from the config.
 // ctx and cancel are the application-wide context for graceful shutdown.
+// The theme manager carried by ctx (see theme.NewContext) styles the UI;
+// without one the model gets a manager of its own.
 // configPath is the path to persist settings; empty means no file save.
 // firstRun indicates that no config file existed before this launch.
+// Custom themes saved by the theme editor are registered first, and the
+// plugins in the plugins directory are found, for Init to start, unless the
+// plugins feature flag is off. Unless firstRun is set, the navigation stack
+// saved by the previous session is reopened, once the plugins have started
+// when it holds plugin screens. The session is counted in the local usage
+// stats unless the config turns them off, and the break reminder timer is
+// started when the config turns it on, as is the idle timer. Clock times
+// follow the locale of the environment.
 func New(ctx context.Context, cancel context.CancelFunc, cfg config.Config, configPath string, firstRun bool) rootModel {
-	return newRootModel(ctx, cancel, cfg, configPath, firstRun)
+	m := newRootModel(ctx, cancel, cfg, configPath, firstRun)
+	m.locale = timefmt.Locale(os.Environ())
+	m.loadCustomThemes()
+	if features.Plugins.Enabled() {
+		m.discoverPlugins()
+	}
+	if !firstRun {
+		m.restoreNavState()
+	}
+	m.startStats(time.Now())
+	m.startBreaks(time.Now())
+	m.startIdle(time.Now())
+	return m
 }
 
 // Run starts the TUI program. ctx is used to cancel background goroutines on quit.
-func Run(ctx context.Context, m rootModel) error {
-	_, err := tea.NewProgram(m, tea.WithContext(ctx)).Run()
+// Each background func is started in its own goroutine with a Navigator bound
+// to the program, so non-UI code can request navigation safely.
+// The themes directory is watched so edited theme files restyle the running
+// UI while the theme-hot-reload feature flag is on. On exit the final
+// navigation stack and usage stats are saved alongside the config file, a
+// config change still waiting on its delayed save is written, and the
+// plugins are shut down.
+// SIGTERM and SIGHUP quit the program the way the quit key does, restoring
+// the terminal; the context is then cancelled and the background funcs are
+// given a moment to return before the state is saved, and Run returns a
+// SignalError.
+// In monochrome mode the renderer is told the terminal has no colors, so
+// colors from outside the theme are stripped too.
+func Run(ctx context.Context, m rootModel, background ...func(context.Context, nav.Navigator)) error {
+	opts := []tea.ProgramOption{tea.WithContext(ctx), tea.WithoutSignalHandler()}
+	if theme.Monochrome() {
+		opts = append(opts, tea.WithColorProfile(colorprofile.Ascii))
+	}
+	p := tea.NewProgram(m, opts...)
+	if dir := m.themesPath(); dir != "" {
+		// Reloads are dropped rather than the watcher stopped, so the
+		// theme-hot-reload flag can be turned back on at runtime.
+		send := func(msg tea.Msg) {
+			if features.ThemeHotReload.Enabled() {
+				p.Send(msg)
+			}
+		}
+		go func() {
+			if err := m.themeMgr.Watch(ctx, dir, send); err != nil {
+				logger.Debug("theme hot-reload disabled: %v", err)
+			}
+		}()
+	}
+	navigator := nav.NewNavigator(p)
+	var wg sync.WaitGroup
+	for _, fn := range background {
+		wg.Go(func() { fn(ctx, navigator) })
+	}
+	stopSignals := handleSignals(p)
+	final, err := p.Run()
+	stopSignals()
+	m.stopBackground(&wg)
+	if rm, ok := final.(rootModel); ok {
+		rm.saveNavState()
+		rm.flushConfig()
+		if rm.quitSignal != nil && err == nil {
+			err = SignalError{Signal: rm.quitSignal}
+		}
+		rm.plugins.Shutdown() // started after Init, so only the final model has them
+	}
+	if err := m.stats.Save(); err != nil {
+		logger.Debug("stats not saved: %v", err)
+	}
 	return err
 }
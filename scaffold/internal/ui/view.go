@@ -2,6 +2,7 @@
 package ui
 
 import (
+	"charm.land/bubbles/v2/help"
 	"charm.land/bubbles/v2/key"
 	"charm.land/lipgloss/v2"
 
@@ -10,16 +11,22 @@ import (
 )
 
 // helpView renders the persistent help box showing global and screen-specific keybindings.
+// While the tour runs it shows the tour keys instead.
 func (m rootModel) helpView() string {
-	combined := m.combinedKeys()
-	return m.styles.Help.Render(m.help.View(combined))
+	var km help.KeyMap = m.combinedKeys()
+	if m.tour.active {
+		km = m.tour.keys
+	}
+	// help.Model still emits an item that leaves no room for its ellipsis,
+	// so clip to the content width for very narrow terminals.
+	return m.styles.Help.MaxWidth(m.styles.MaxWidth).Render(m.help.View(km))
 }
 
 // combinedKeys returns a key map that combines global keys with screen-specific keys.
 func (m rootModel) combinedKeys() combinedKeyMap {
 	return combinedKeyMap{
 		global: m.keys,
-		screen: m.current,
+		screen: m.stack.Active(),
 	}
 }
 
@@ -67,7 +74,7 @@ func (m rootModel) bodyHeight() int {
 		return 0
 	}
 	helpH := lipgloss.Height(m.helpView())
-	body := m.height - m.header.Height() - helpH - footerLines
+	body := m.height - m.stripeLines() - m.header.Height() - helpH - footerLines
 
 	// Cap at maxBodyPercent of terminal height
 	maxBody := m.height * maxBodyPercent / 100
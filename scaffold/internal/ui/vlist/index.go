@@ -0,0 +1,160 @@
+package vlist
+
+import (
+	"slices"
+	"sync"
+	"unicode"
+)
+
+// Match is an item accepted by a filter query.
+type Match struct {
+	Index int // position in the values the Index was built from
+	Score int // higher is better
+}
+
+// Index filters a fixed set of strings by fuzzy subsequence matching. The
+// lowercase rune form of every value is computed once up front, and the
+// results for earlier queries are kept: a query that extends one of them
+// only re-scores that query's matches, since a value that did not match
+// "ab" cannot match "abc". Typing therefore narrows an ever smaller set,
+// and backspacing returns to a cached result.
+//
+// An Index is safe for concurrent use, so matching can run off the UI
+// goroutine.
+type Index struct {
+	values [][]rune // lowercased values
+
+	mu      sync.Mutex
+	history []cachedQuery // successive prefixes of the last query
+}
+
+// cachedQuery holds the indexes that matched query, in ascending order.
+type cachedQuery struct {
+	query   []rune
+	matches []int
+}
+
+// NewIndex builds an index over values.
+func NewIndex(values []string) *Index {
+	x := &Index{values: make([][]rune, len(values))}
+	for i, v := range values {
+		x.values[i] = lowerRunes(v)
+	}
+	return x
+}
+
+// Len returns the number of indexed values.
+func (x *Index) Len() int { return len(x.values) }
+
+// Match returns the values matching query, best first; equal scores keep
+// index order. An empty query matches nothing; callers show everything
+// instead.
+func (x *Index) Match(query string) []Match {
+	q := lowerRunes(query)
+	if len(q) == 0 {
+		return nil
+	}
+
+	candidates := x.candidates(q)
+	found := make([]Match, 0, len(candidates))
+	indexes := make([]int, 0, len(candidates))
+	for _, i := range candidates {
+		if score, ok := scoreRunes(x.values[i], q); ok {
+			found = append(found, Match{Index: i, Score: score})
+			indexes = append(indexes, i)
+		}
+	}
+	x.remember(q, indexes)
+
+	slices.SortFunc(found, func(a, b Match) int {
+		if a.Score != b.Score {
+			return b.Score - a.Score
+		}
+		return a.Index - b.Index
+	})
+	return found
+}
+
+// candidates returns the matches of the longest cached prefix of q, or every
+// index when none is cached.
+func (x *Index) candidates(q []rune) []int {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for i := len(x.history) - 1; i >= 0; i-- {
+		if hasPrefix(q, x.history[i].query) {
+			return x.history[i].matches
+		}
+	}
+	all := make([]int, len(x.values))
+	for i := range all {
+		all[i] = i
+	}
+	return all
+}
+
+// remember caches the result for q, dropping entries that are not prefixes
+// of it so the history stays one chain of extensions.
+func (x *Index) remember(q []rune, matches []int) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	kept := x.history[:0]
+	for _, c := range x.history {
+		if hasPrefix(q, c.query) && len(c.query) < len(q) {
+			kept = append(kept, c)
+		}
+	}
+	x.history = append(kept, cachedQuery{query: q, matches: matches})
+}
+
+// Scoring weights.
+const (
+	scoreMatch       = 1
+	scoreConsecutive = 5
+	scoreWordStart   = 8
+	maxLeadPenalty   = 10
+)
+
+// scoreRunes matches q as a subsequence of v, taking the leftmost match of
+// each rune. Consecutive runes and runes at word starts score higher, and
+// matches starting late in v are penalised slightly.
+func scoreRunes(v, q []rune) (int, bool) {
+	score, qi, prev := 0, 0, -2
+	for vi := 0; vi < len(v) && qi < len(q); vi++ {
+		if v[vi] != q[qi] {
+			continue
+		}
+		if qi == 0 {
+			score -= min(vi, maxLeadPenalty)
+		}
+		score += scoreMatch
+		if vi == prev+1 {
+			score += scoreConsecutive
+		}
+		if vi == 0 || isSeparator(v[vi-1]) {
+			score += scoreWordStart
+		}
+		prev = vi
+		qi++
+	}
+	return score, qi == len(q)
+}
+
+func isSeparator(r rune) bool {
+	switch r {
+	case ' ', '-', '_', '/', '.', '\\':
+		return true
+	}
+	return false
+}
+
+func lowerRunes(s string) []rune {
+	r := []rune(s)
+	for i, c := range r {
+		r[i] = unicode.ToLower(c)
+	}
+	return r
+}
+
+func hasPrefix(s, prefix []rune) bool {
+	return len(s) >= len(prefix) && slices.Equal(s[:len(prefix)], prefix)
+}
@@ -0,0 +1,115 @@
+package vlist
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func indexOf(matches []Match) []int {
+	out := make([]int, len(matches))
+	for i, m := range matches {
+		out[i] = m.Index
+	}
+	return out
+}
+
+func TestIndex_MatchIsCaseInsensitiveSubsequence(t *testing.T) {
+	x := NewIndex([]string{"README.md", "internal/ui/model.go", "go.mod", "Makefile"})
+
+	assert.ElementsMatch(t, []int{1, 2}, indexOf(x.Match("MOD")))
+	assert.Empty(t, x.Match("xyz"))
+	assert.Nil(t, x.Match(""))
+}
+
+func TestIndex_RanksWordStartsAndRunsFirst(t *testing.T) {
+	x := NewIndex([]string{"a-model-x", "mxoxdxexl", "model"})
+
+	got := indexOf(x.Match("model"))
+
+	require.Len(t, got, 3)
+	assert.Equal(t, 2, got[0], "an exact word at the start wins")
+	assert.Equal(t, 1, got[2], "a scattered match ranks last")
+}
+
+func TestIndex_ExtendedQueryOnlyRescoresPreviousMatches(t *testing.T) {
+	x := NewIndex([]string{"alpha", "alpine", "beta"})
+	x.Match("al")
+
+	// A value that did not match "al" cannot match "alp"; prove the cache is
+	// consulted by checking the history chain.
+	x.Match("alp")
+
+	require.Len(t, x.history, 2)
+	assert.Equal(t, "al", string(x.history[0].query))
+	assert.Equal(t, []int{0, 1}, x.history[1].matches)
+}
+
+func TestIndex_BackspaceReturnsToCachedPrefix(t *testing.T) {
+	x := NewIndex([]string{"alpha", "alpine", "beta"})
+	x.Match("a")
+	x.Match("alp")
+
+	assert.ElementsMatch(t, []int{0, 1, 2}, indexOf(x.Match("a")))
+	require.Len(t, x.history, 1, "longer queries are dropped once no longer a prefix")
+}
+
+func TestFilterAsync_AppliesLatestResultOnly(t *testing.T) {
+	m := New(countingRender(new(int))).SetItems(items(100)).SetSize(40, 5)
+
+	m, stale := m.FilterAsync("zzz")
+	m, latest := m.FilterAsync("99")
+	assert.Equal(t, 100, m.Len(), "previous results stay until scoring finishes")
+
+	m, _ = m.Update(latest())
+	m, _ = m.Update(stale())
+
+	assert.Equal(t, "99", m.Filter())
+	require.Equal(t, 1, m.Len())
+	assert.Equal(t, "item-00099", m.SelectedItem().FilterValue())
+}
+
+func TestFilterAsync_IgnoresOtherLists(t *testing.T) {
+	a := New(countingRender(new(int))).SetItems(items(10)).SetSize(40, 5)
+	b := New(countingRender(new(int))).SetItems(items(10)).SetSize(40, 5)
+
+	_, cmd := a.FilterAsync("zzz")
+	b, _ = b.Update(cmd())
+
+	assert.Equal(t, 10, b.Len())
+}
+
+// --- benchmarks at 50k entries ---
+
+func benchIndex() *Index {
+	values := make([]string, 50000)
+	for i := range values {
+		values[i] = fmt.Sprintf("src/pkg%03d/module_%05d/file.go", i%500, i)
+	}
+	return NewIndex(values)
+}
+
+// BenchmarkIndex_FirstKeystroke_50k scores every entry.
+func BenchmarkIndex_FirstKeystroke_50k(b *testing.B) {
+	x := benchIndex()
+	for b.Loop() {
+		x.history = nil
+		x.Match("m")
+	}
+}
+
+// BenchmarkIndex_TypingQuery_50k types a query one rune at a time, as a
+// user would, reporting the cost per keystroke.
+func BenchmarkIndex_TypingQuery_50k(b *testing.B) {
+	x := benchIndex()
+	const query = "pkg042mod"
+	for b.Loop() {
+		x.history = nil
+		for i := 1; i <= len(query); i++ {
+			x.Match(query[:i])
+		}
+	}
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*len(query)), "ns/keystroke")
+}
@@ -0,0 +1,347 @@
+// Package vlist provides a virtualized list for very large item sets. Only
+// the rows in the visible window, plus a few overscan rows either side, are
+// ever rendered; rendered rows are cached so scrolling re-renders only the
+// rows that enter the window and the two whose selection changed.
+//
+// Filtering fuzzy-matches through an incremental [Index] and keeps the
+// selected item selected when it still matches. [Model.FilterAsync] scores
+// off the UI goroutine for item sets large enough that a keystroke should
+// not wait for it.
+package vlist
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+)
+
+// Item is an entry in the list. FilterValue is matched against the filter.
+type Item interface {
+	FilterValue() string
+}
+
+// RenderFunc renders item at width. It must return exactly the list's item
+// height in lines.
+type RenderFunc func(item Item, selected bool, width int) string
+
+// DefaultOverscan is the number of off-screen rows kept rendered above and
+// below the window.
+const DefaultOverscan = 5
+
+// KeyMap defines the list's key bindings.
+type KeyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	PageUp   key.Binding
+	PageDown key.Binding
+	Home     key.Binding
+	End      key.Binding
+}
+
+// DefaultKeyMap returns the default list key bindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Up:       key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+		Down:     key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+		PageUp:   key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "page up")),
+		PageDown: key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdn", "page down")),
+		Home:     key.NewBinding(key.WithKeys("home", "g"), key.WithHelp("g/home", "first")),
+		End:      key.NewBinding(key.WithKeys("end", "G"), key.WithHelp("G/end", "last")),
+	}
+}
+
+// FilteredMsg carries the result of an asynchronous filter back to the list
+// that requested it.
+type FilteredMsg struct {
+	id      int64
+	seq     int
+	matches []int
+}
+
+// lastID hands out list identities so results reach the right list.
+var lastID atomic.Int64
+
+// Model is a virtualized list. The zero value is not usable; call New.
+type Model struct {
+	id         int64
+	seq        int // bumped per FilterAsync; older results are discarded
+	index      *Index
+	items      []Item
+	matches    []int // indexes into items that pass the filter; nil = all
+	filter     string
+	cursor     int // position in the visible items
+	offset     int // first visible position shown in the window
+	width      int
+	height     int
+	itemHeight int
+	overscan   int
+	render     RenderFunc
+	cache      map[int]string // item index -> unselected rendering
+	keys       KeyMap
+}
+
+// New creates an empty list whose rows are drawn by render, one line each.
+func New(render RenderFunc) Model {
+	return Model{
+		id:         lastID.Add(1),
+		itemHeight: 1,
+		overscan:   DefaultOverscan,
+		render:     render,
+		keys:       DefaultKeyMap(),
+	}
+}
+
+// WithItemHeight returns the list with rows of h lines.
+func (m Model) WithItemHeight(h int) Model {
+	m.itemHeight = max(h, 1)
+	m.cache = nil
+	m.offset = m.clampOffset(m.offset)
+	return m.refresh()
+}
+
+// WithOverscan returns the list keeping n rows rendered beyond each edge of
+// the window.
+func (m Model) WithOverscan(n int) Model {
+	m.overscan = max(n, 0)
+	return m.refresh()
+}
+
+// SetItems replaces the items, reapplying the current filter. The cursor
+// moves to the start.
+func (m Model) SetItems(items []Item) Model {
+	m.items = items
+	values := make([]string, len(items))
+	for i, it := range items {
+		values[i] = it.FilterValue()
+	}
+	m.index = NewIndex(values)
+	m.seq++ // pending results refer to the old items
+	m.cache = nil
+	m.cursor, m.offset = 0, 0
+	m.matches = m.match(m.filter)
+	return m.refresh()
+}
+
+// SetSize sets the list dimensions. Changing the width drops cached rows.
+func (m Model) SetSize(width, height int) Model {
+	if width != m.width {
+		m.cache = nil
+	}
+	m.width, m.height = width, height
+	m.offset = m.clampOffset(m.offset)
+	return m.refresh()
+}
+
+// SetFilter filters the items by fuzzy-matching query against FilterValue,
+// best matches first. An empty query shows every item in order. The
+// selected item stays selected if it still matches.
+func (m Model) SetFilter(query string) Model {
+	m.seq++
+	m.filter = query
+	return m.applyMatches(m.match(query))
+}
+
+// FilterAsync records query as the filter and returns a command that scores
+// it off the UI goroutine. The list keeps showing the previous results until
+// Update receives the FilteredMsg; results for a query that has since been
+// replaced are dropped.
+func (m Model) FilterAsync(query string) (Model, tea.Cmd) {
+	m.seq++
+	m.filter = query
+	id, seq, index := m.id, m.seq, m.index
+	return m, func() tea.Msg {
+		return FilteredMsg{id: id, seq: seq, matches: matchIndexes(index, query)}
+	}
+}
+
+// applyMatches installs a filter result, keeping the selection if it is
+// still among the matches.
+func (m Model) applyMatches(matches []int) Model {
+	selected, hadSelection := m.selectedIndex()
+	m.matches = matches
+	m.cursor, m.offset = 0, 0
+	if hadSelection {
+		for pos := range m.Len() {
+			if m.itemAt(pos) == selected {
+				m.cursor = pos
+				break
+			}
+		}
+	}
+	m.offset = m.clampOffset(m.offset)
+	return m.refresh()
+}
+
+// Filter returns the current filter query.
+func (m Model) Filter() string { return m.filter }
+
+// Invalidate drops every cached row, e.g. after a theme change restyles the
+// render function's output.
+func (m Model) Invalidate() Model {
+	m.cache = nil
+	return m.refresh()
+}
+
+// Len returns the number of items passing the filter.
+func (m Model) Len() int {
+	if m.matches != nil {
+		return len(m.matches)
+	}
+	return len(m.items)
+}
+
+// Cursor returns the selected position among the filtered items.
+func (m Model) Cursor() int { return m.cursor }
+
+// Select moves the cursor to position pos among the filtered items.
+func (m Model) Select(pos int) Model {
+	if m.Len() == 0 {
+		return m
+	}
+	m.cursor = max(0, min(pos, m.Len()-1))
+	m.offset = m.clampOffset(m.offset)
+	return m.refresh()
+}
+
+// SelectedItem returns the selected item, or nil when nothing matches.
+func (m Model) SelectedItem() Item {
+	i, ok := m.selectedIndex()
+	if !ok {
+		return nil
+	}
+	return m.items[i]
+}
+
+// Update handles cursor movement keys and asynchronous filter results.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if filtered, ok := msg.(FilteredMsg); ok {
+		if filtered.id == m.id && filtered.seq == m.seq {
+			m = m.applyMatches(filtered.matches)
+		}
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyPressMsg)
+	if !ok {
+		return m, nil
+	}
+	page := max(m.rows(), 1)
+	switch {
+	case key.Matches(keyMsg, m.keys.Up):
+		return m.Select(m.cursor - 1), nil
+	case key.Matches(keyMsg, m.keys.Down):
+		return m.Select(m.cursor + 1), nil
+	case key.Matches(keyMsg, m.keys.PageUp):
+		return m.Select(m.cursor - page), nil
+	case key.Matches(keyMsg, m.keys.PageDown):
+		return m.Select(m.cursor + page), nil
+	case key.Matches(keyMsg, m.keys.Home):
+		return m.Select(0), nil
+	case key.Matches(keyMsg, m.keys.End):
+		return m.Select(m.Len() - 1), nil
+	}
+	return m, nil
+}
+
+// View renders the rows in the window.
+func (m Model) View() string {
+	end := min(m.offset+m.rows(), m.Len())
+	rows := make([]string, 0, end-m.offset)
+	for pos := m.offset; pos < end; pos++ {
+		i := m.itemAt(pos)
+		if pos == m.cursor {
+			rows = append(rows, m.render(m.items[i], true, m.width))
+			continue
+		}
+		row, ok := m.cache[i]
+		if !ok {
+			row = m.render(m.items[i], false, m.width)
+		}
+		rows = append(rows, row)
+	}
+	return strings.Join(rows, "\n")
+}
+
+// ShortHelp returns the list's key bindings for a help bar.
+func (m Model) ShortHelp() []key.Binding {
+	return []key.Binding{m.keys.Up, m.keys.Down}
+}
+
+// FullHelp returns the list's key bindings grouped for an expanded help bar.
+func (m Model) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{m.keys.Up, m.keys.Down},
+		{m.keys.PageUp, m.keys.PageDown, m.keys.Home, m.keys.End},
+	}
+}
+
+// rows returns how many items fit in the window.
+func (m Model) rows() int {
+	return m.height / m.itemHeight
+}
+
+// clampOffset scrolls offset so that the cursor is inside the window.
+func (m Model) clampOffset(offset int) int {
+	rows := max(m.rows(), 1)
+	if m.cursor < offset {
+		offset = m.cursor
+	}
+	if m.cursor >= offset+rows {
+		offset = m.cursor - rows + 1
+	}
+	return max(0, min(offset, max(m.Len()-rows, 0)))
+}
+
+// refresh renders the unselected rows of the window and its overscan,
+// reusing cached renderings and dropping rows that left the range.
+func (m Model) refresh() Model {
+	if m.render == nil || m.width <= 0 {
+		return m
+	}
+	start := max(m.offset-m.overscan, 0)
+	end := min(m.offset+m.rows()+m.overscan, m.Len())
+	cache := make(map[int]string, end-start)
+	for pos := start; pos < end; pos++ {
+		i := m.itemAt(pos)
+		if row, ok := m.cache[i]; ok {
+			cache[i] = row
+		} else {
+			cache[i] = m.render(m.items[i], false, m.width)
+		}
+	}
+	m.cache = cache
+	return m
+}
+
+func (m Model) itemAt(pos int) int {
+	if m.matches != nil {
+		return m.matches[pos]
+	}
+	return pos
+}
+
+func (m Model) selectedIndex() (int, bool) {
+	if m.cursor >= m.Len() {
+		return 0, false
+	}
+	return m.itemAt(m.cursor), true
+}
+
+func (m Model) match(query string) []int {
+	return matchIndexes(m.index, query)
+}
+
+// matchIndexes returns the item indexes matching query, best first, or nil
+// (everything) for an empty query.
+func matchIndexes(index *Index, query string) []int {
+	if query == "" || index == nil {
+		return nil
+	}
+	found := index.Match(query)
+	matches := make([]int, len(found))
+	for i, f := range found {
+		matches[i] = f.Index
+	}
+	return matches
+}
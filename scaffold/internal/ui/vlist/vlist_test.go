@@ -0,0 +1,145 @@
+package vlist
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type name string
+
+func (n name) FilterValue() string { return string(n) }
+
+func items(n int) []Item {
+	out := make([]Item, n)
+	for i := range out {
+		out[i] = name(fmt.Sprintf("item-%05d", i))
+	}
+	return out
+}
+
+// countingRender renders "> name" for the selection and "  name" otherwise,
+// counting calls in *calls.
+func countingRender(calls *int) RenderFunc {
+	return func(item Item, selected bool, _ int) string {
+		*calls++
+		if selected {
+			return "> " + item.FilterValue()
+		}
+		return "  " + item.FilterValue()
+	}
+}
+
+func down(m Model) Model {
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	return m
+}
+
+func TestView_RendersOnlyTheWindow(t *testing.T) {
+	var calls int
+	m := New(countingRender(&calls)).WithOverscan(2).SetItems(items(10000)).SetSize(40, 5)
+
+	lines := strings.Split(m.View(), "\n")
+
+	assert.Equal(t, []string{"> item-00000", "  item-00001", "  item-00002", "  item-00003", "  item-00004"}, lines)
+	assert.LessOrEqual(t, calls, 5+2+1, "window plus overscan, plus the selected row")
+}
+
+func TestScrolling_ReusesCachedRows(t *testing.T) {
+	var calls int
+	m := New(countingRender(&calls)).SetItems(items(10000)).SetSize(40, 5)
+	for range 4 {
+		m = down(m)
+	}
+	m.View()
+
+	calls = 0
+	m = down(m) // scrolls the window by one row
+	view := m.View()
+
+	assert.True(t, strings.HasSuffix(view, "> item-00005"))
+	assert.LessOrEqual(t, calls, 2, "only the new overscan row and the selection are rendered")
+}
+
+func TestSetFilter_KeepsSelection(t *testing.T) {
+	m := New(countingRender(new(int))).SetItems(items(100)).SetSize(40, 5)
+	m = m.Select(42)
+
+	m = m.SetFilter("item-0004")
+
+	require.NotNil(t, m.SelectedItem())
+	assert.Equal(t, "item-00042", m.SelectedItem().FilterValue())
+	assert.Less(t, m.Len(), 100)
+}
+
+func TestSetFilter_NoMatches(t *testing.T) {
+	m := New(countingRender(new(int))).SetItems(items(100)).SetSize(40, 5)
+
+	m = m.SetFilter("zzz")
+
+	assert.Equal(t, 0, m.Len())
+	assert.Nil(t, m.SelectedItem())
+	assert.Empty(t, m.View())
+
+	m = m.SetFilter("")
+	assert.Equal(t, 100, m.Len())
+}
+
+func TestUpdate_PageAndEnds(t *testing.T) {
+	m := New(countingRender(new(int))).SetItems(items(100)).SetSize(40, 10)
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyPgDown})
+	assert.Equal(t, 10, m.Cursor())
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnd})
+	assert.Equal(t, 99, m.Cursor())
+	assert.True(t, strings.HasPrefix(m.View(), "  item-00090"))
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyHome})
+	assert.Equal(t, 0, m.Cursor())
+}
+
+func TestWithItemHeight_FitsFewerRows(t *testing.T) {
+	render := func(item Item, _ bool, _ int) string { return item.FilterValue() + "\ndesc" }
+	m := New(render).WithItemHeight(2).SetItems(items(100)).SetSize(40, 6)
+
+	assert.Equal(t, 6, strings.Count(m.View(), "\n")+1)
+}
+
+// --- benchmarks at 10k items ---
+
+func benchList() Model {
+	render := func(item Item, selected bool, _ int) string {
+		if selected {
+			return "> " + item.FilterValue()
+		}
+		return "  " + item.FilterValue()
+	}
+	return New(render).SetItems(items(10000)).SetSize(80, 40)
+}
+
+func BenchmarkView_10k(b *testing.B) {
+	m := benchList()
+	for b.Loop() {
+		_ = m.View()
+	}
+}
+
+func BenchmarkCursorDown_10k(b *testing.B) {
+	m := benchList()
+	for b.Loop() {
+		m = down(m)
+		if m.Cursor() == m.Len()-1 {
+			m = m.Select(0)
+		}
+	}
+}
+
+func BenchmarkSetFilter_10k(b *testing.B) {
+	m := benchList()
+	for b.Loop() {
+		_ = m.SetFilter("item-0999")
+	}
+}
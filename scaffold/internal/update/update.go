@@ -0,0 +1,325 @@
+// Package update replaces the running binary with the latest GitHub release.
+//
+// A release is expected to carry, as assets:
+//
+//   - one raw binary per platform, named by AssetName, e.g.
+//     scaffold_linux_amd64 or scaffold_windows_amd64.exe
+//   - ChecksumsFile, in sha256sum format ("<hex>  <name>" per line)
+//   - SignatureFile, the base64 ed25519 signature of ChecksumsFile
+//
+// The binary is checked against its checksum, and the checksums against the
+// signature when the build embeds a public key (see PublicKey). Only then is
+// it swapped in, by renaming it over the old binary so an interrupted update
+// never leaves a half-written executable behind.
+package update
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ChecksumsFile is the name of the release asset listing checksums.
+	ChecksumsFile = "checksums.txt"
+	// SignatureFile is the name of the release asset signing ChecksumsFile.
+	SignatureFile = "checksums.txt.sig"
+	// DefaultBaseURL is the GitHub REST API.
+	DefaultBaseURL = "https://api.github.com"
+)
+
+// PublicKey is the base64 ed25519 key release checksums are signed with.
+// Builds set it with
+//
+//	-ldflags "-X scaffold/internal/update.PublicKey=<base64 key>"
+//
+// When empty, updates are verified by checksum only.
+var PublicKey string
+
+var (
+	// ErrNoAsset is returned when a release has no binary for the platform.
+	ErrNoAsset = errors.New("update: release has no binary for this platform")
+	// ErrChecksum is returned when a download does not match its checksum.
+	ErrChecksum = errors.New("update: checksum mismatch")
+	// ErrSignature is returned when the checksums are not signed by
+	// PublicKey.
+	ErrSignature = errors.New("update: bad signature")
+	// ErrTooLarge is returned when a download exceeds its size limit.
+	ErrTooLarge = errors.New("update: download too large")
+)
+
+// Download size limits, so a broken or hostile server cannot exhaust memory.
+const (
+	// MaxBinarySize caps the release binary.
+	MaxBinarySize = 256 << 20
+	// maxMetadataSize caps the release listing, ChecksumsFile and
+	// SignatureFile.
+	maxMetadataSize = 1 << 20
+)
+
+// Release is a published GitHub release.
+type Release struct {
+	Tag    string  `json:"tag_name"`
+	Assets []Asset `json:"assets"`
+}
+
+// Asset is a file attached to a release.
+type Asset struct {
+	Name string `json:"name"`
+	URL  string `json:"browser_download_url"`
+}
+
+// Version returns the release tag without its leading "v".
+func (r Release) Version() string { return strings.TrimPrefix(r.Tag, "v") }
+
+// Newer reports whether the release is a later version than current.
+func (r Release) Newer(current string) bool {
+	return CompareVersions(r.Version(), current) > 0
+}
+
+// asset returns the asset named name.
+func (r Release) asset(name string) (Asset, bool) {
+	for _, a := range r.Assets {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return Asset{}, false
+}
+
+// Updater finds and installs releases of one repository.
+type Updater struct {
+	Repo      string       // "owner/name"
+	BaseURL   string       // GitHub API; DefaultBaseURL when empty
+	Client    *http.Client // http.DefaultClient when nil
+	PublicKey []byte       // ed25519 key; nil checks checksums only
+	Binary    string       // asset name prefix, e.g. "scaffold"
+}
+
+// New returns an Updater for repo using the PublicKey the build embeds.
+func New(repo, binary string) (Updater, error) {
+	u := Updater{Repo: repo, Binary: binary}
+	if PublicKey != "" {
+		key, err := base64.StdEncoding.DecodeString(PublicKey)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			return u, fmt.Errorf("update: embedded public key is not a base64 ed25519 key")
+		}
+		u.PublicKey = key
+	}
+	return u, nil
+}
+
+// AssetName returns the release asset holding the binary for goos/goarch.
+func (u Updater) AssetName(goos, goarch string) string {
+	name := u.Binary + "_" + goos + "_" + goarch
+	if goos == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+// Latest returns the repository's latest release.
+func (u Updater) Latest(ctx context.Context) (Release, error) {
+	base := u.BaseURL
+	if base == "" {
+		base = DefaultBaseURL
+	}
+	body, err := u.get(ctx, strings.TrimSuffix(base, "/")+"/repos/"+u.Repo+"/releases/latest", maxMetadataSize)
+	if err != nil {
+		return Release{}, err
+	}
+	var r Release
+	if err := json.Unmarshal(body, &r); err != nil {
+		return Release{}, fmt.Errorf("update: decoding release: %w", err)
+	}
+	if r.Tag == "" {
+		return Release{}, errors.New("update: release has no tag")
+	}
+	return r, nil
+}
+
+// Install downloads r's binary for the running platform, verifies it and
+// replaces exe with it.
+func (u Updater) Install(ctx context.Context, r Release, exe string) error {
+	name := u.AssetName(runtime.GOOS, runtime.GOARCH)
+	bin, ok := r.asset(name)
+	if !ok {
+		return fmt.Errorf("%w (%s)", ErrNoAsset, name)
+	}
+	sums, ok := r.asset(ChecksumsFile)
+	if !ok {
+		return fmt.Errorf("update: release has no %s", ChecksumsFile)
+	}
+
+	sumsData, err := u.get(ctx, sums.URL, maxMetadataSize)
+	if err != nil {
+		return err
+	}
+	if u.PublicKey != nil {
+		sig, ok := r.asset(SignatureFile)
+		if !ok {
+			return fmt.Errorf("%w: release has no %s", ErrSignature, SignatureFile)
+		}
+		sigData, err := u.get(ctx, sig.URL, maxMetadataSize)
+		if err != nil {
+			return err
+		}
+		if err := Verify(u.PublicKey, sumsData, sigData); err != nil {
+			return err
+		}
+	}
+	want, err := checksumFor(sumsData, name)
+	if err != nil {
+		return err
+	}
+	data, err := u.get(ctx, bin.URL, MaxBinarySize)
+	if err != nil {
+		return err
+	}
+	if got := sha256.Sum256(data); hex.EncodeToString(got[:]) != want {
+		return fmt.Errorf("%w for %s", ErrChecksum, name)
+	}
+	return Replace(exe, data)
+}
+
+// Verify checks that sig, base64 text, is key's signature of data.
+func Verify(key, data, sig []byte) error {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(sig)))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrSignature, err)
+	}
+	if !ed25519.Verify(key, data, raw) {
+		return ErrSignature
+	}
+	return nil
+}
+
+// checksumFor finds name's sha256 in a sha256sum listing.
+func checksumFor(sums []byte, name string) (string, error) {
+	sc := bufio.NewScanner(bytes.NewReader(sums))
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == name {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+	return "", fmt.Errorf("update: %s lists no checksum for %s", ChecksumsFile, name)
+}
+
+// Replace atomically swaps the file at exe for data, keeping its mode. The
+// new binary is written next to exe and renamed over it. Windows cannot
+// replace a running executable, so there the old one is first moved to
+// exe+".old", which the next update removes.
+func Replace(exe string, data []byte) error {
+	info, err := os.Stat(exe)
+	if err != nil {
+		return fmt.Errorf("update: %w", err)
+	}
+	dir := filepath.Dir(exe)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(exe)+".new-*")
+	if err != nil {
+		return fmt.Errorf("update: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("update: writing new binary: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("update: writing new binary: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), info.Mode().Perm()|0o111); err != nil {
+		return fmt.Errorf("update: %w", err)
+	}
+	if runtime.GOOS == "windows" {
+		old := exe + ".old"
+		_ = os.Remove(old)
+		if err := os.Rename(exe, old); err != nil {
+			return fmt.Errorf("update: moving old binary aside: %w", err)
+		}
+	}
+	if err := os.Rename(tmp.Name(), exe); err != nil {
+		return fmt.Errorf("update: replacing binary: %w", err)
+	}
+	return nil
+}
+
+// get fetches url, failing on any status but 200 or a body longer than
+// limit bytes.
+func (u Updater) get(ctx context.Context, url string, limit int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("update: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	client := u.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("update: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("update: GET %s: %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("update: GET %s: %w", url, err)
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("%w: GET %s: over %d bytes", ErrTooLarge, url, limit)
+	}
+	return data, nil
+}
+
+// CompareVersions compares dotted numeric versions such as "1.10.2",
+// returning -1, 0 or 1. A leading "v" and any pre-release or build suffix
+// ("-rc.1", "+abc") are ignored; missing parts count as 0.
+func CompareVersions(a, b string) int {
+	pa, pb := versionParts(a), versionParts(b)
+	for i := range max(len(pa), len(pb)) {
+		var x, y int
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionParts(v string) []int {
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	var parts []int
+	for p := range strings.SplitSeq(v, ".") {
+		n, _ := strconv.Atoi(p)
+		parts = append(parts, n)
+	}
+	return parts
+}
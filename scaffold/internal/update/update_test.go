@@ -0,0 +1,204 @@
+package update
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// releaseServer serves a GitHub-like API with one release whose assets are
+// files, keyed by name.
+func releaseServer(t *testing.T, tag string, files map[string][]byte) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	mux.HandleFunc("/repos/acme/tool/releases/latest", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"tag_name": %q, "assets": [`, tag)
+		first := true
+		for name := range files {
+			if !first {
+				fmt.Fprint(w, ",")
+			}
+			first = false
+			fmt.Fprintf(w, `{"name": %q, "browser_download_url": %q}`, name, srv.URL+"/dl/"+name)
+		}
+		fmt.Fprint(w, "]}")
+	})
+	mux.HandleFunc("/dl/{name}", func(w http.ResponseWriter, r *http.Request) {
+		data, ok := files[r.PathValue("name")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(data)
+	})
+	return srv
+}
+
+// signedRelease returns release files holding bin for this platform, with
+// checksums signed by priv.
+func signedRelease(u Updater, bin []byte, priv ed25519.PrivateKey) map[string][]byte {
+	name := u.AssetName(runtime.GOOS, runtime.GOARCH)
+	sum := sha256.Sum256(bin)
+	sums := []byte(hex.EncodeToString(sum[:]) + "  " + name + "\n" +
+		"0000  other_os_arch\n")
+	return map[string][]byte{
+		name:          bin,
+		ChecksumsFile: sums,
+		SignatureFile: []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(priv, sums)) + "\n"),
+	}
+}
+
+func fakeExe(t *testing.T) string {
+	t.Helper()
+	exe := filepath.Join(t.TempDir(), "tool")
+	require.NoError(t, os.WriteFile(exe, []byte("old"), 0o755))
+	return exe
+}
+
+func TestInstall_VerifiesAndReplaces(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	u := Updater{Repo: "acme/tool", Binary: "tool", PublicKey: pub}
+	srv := releaseServer(t, "v1.2.0", signedRelease(u, []byte("new"), priv))
+	u.BaseURL = srv.URL
+
+	r, err := u.Latest(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.0", r.Version())
+	assert.True(t, r.Newer("1.1.9"))
+	assert.False(t, r.Newer("1.2.0"))
+
+	exe := fakeExe(t)
+	require.NoError(t, u.Install(context.Background(), r, exe))
+	got, err := os.ReadFile(exe)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(got))
+	info, err := os.Stat(exe)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+
+	entries, err := os.ReadDir(filepath.Dir(exe))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "no temp file is left behind")
+}
+
+func TestInstall_RejectsTampering(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	u := Updater{Repo: "acme/tool", Binary: "tool", PublicKey: pub}
+	name := u.AssetName(runtime.GOOS, runtime.GOARCH)
+
+	tests := map[string]struct {
+		tamper func(files map[string][]byte)
+		want   error
+	}{
+		"binary swapped": {
+			tamper: func(f map[string][]byte) { f[name] = []byte("evil") },
+			want:   ErrChecksum,
+		},
+		"checksums rewritten": {
+			tamper: func(f map[string][]byte) {
+				sum := sha256.Sum256([]byte("evil"))
+				f[name] = []byte("evil")
+				f[ChecksumsFile] = []byte(hex.EncodeToString(sum[:]) + "  " + name + "\n")
+			},
+			want: ErrSignature,
+		},
+		"signature missing": {
+			tamper: func(f map[string][]byte) { delete(f, SignatureFile) },
+			want:   ErrSignature,
+		},
+		"no binary for this platform": {
+			tamper: func(f map[string][]byte) { delete(f, name) },
+			want:   ErrNoAsset,
+		},
+	}
+	for desc, tt := range tests {
+		t.Run(desc, func(t *testing.T) {
+			files := signedRelease(u, []byte("new"), priv)
+			tt.tamper(files)
+			u := u
+			u.BaseURL = releaseServer(t, "v2.0.0", files).URL
+			r, err := u.Latest(context.Background())
+			require.NoError(t, err)
+
+			exe := fakeExe(t)
+			assert.ErrorIs(t, u.Install(context.Background(), r, exe), tt.want)
+			got, err := os.ReadFile(exe)
+			require.NoError(t, err)
+			assert.Equal(t, "old", string(got), "the old binary is kept")
+		})
+	}
+}
+
+func TestInstall_ChecksumOnlyWithoutKey(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	u := Updater{Repo: "acme/tool", Binary: "tool"}
+	files := signedRelease(u, []byte("new"), priv)
+	delete(files, SignatureFile)
+	u.BaseURL = releaseServer(t, "v2.0.0", files).URL
+
+	r, err := u.Latest(context.Background())
+	require.NoError(t, err)
+	exe := fakeExe(t)
+	require.NoError(t, u.Install(context.Background(), r, exe))
+}
+
+func TestInstall_RejectsOversizedChecksums(t *testing.T) {
+	u := Updater{Repo: "acme/tool", Binary: "tool"}
+	files := map[string][]byte{
+		u.AssetName(runtime.GOOS, runtime.GOARCH): []byte("new"),
+		ChecksumsFile: bytes.Repeat([]byte("0"), maxMetadataSize+1),
+	}
+	u.BaseURL = releaseServer(t, "v2.0.0", files).URL
+
+	r, err := u.Latest(context.Background())
+	require.NoError(t, err)
+	exe := fakeExe(t)
+	assert.ErrorIs(t, u.Install(context.Background(), r, exe), ErrTooLarge)
+}
+
+func TestLatest_HTTPError(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(srv.Close)
+	_, err := Updater{Repo: "acme/tool", BaseURL: srv.URL}.Latest(context.Background())
+	assert.ErrorContains(t, err, "404")
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"v1.10.0", "1.9.9", 1},
+		{"1.2", "1.2.1", -1},
+		{"2.0.0-rc.1", "2.0.0", 0},
+		{"1.0.0+build", "v1", 0},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, CompareVersions(tt.a, tt.b), "%s vs %s", tt.a, tt.b)
+	}
+}
+
+func TestAssetName(t *testing.T) {
+	u := Updater{Binary: "scaffold"}
+	assert.Equal(t, "scaffold_linux_arm64", u.AssetName("linux", "arm64"))
+	assert.Equal(t, "scaffold_windows_amd64.exe", u.AssetName("windows", "amd64"))
+}
@@ -0,0 +1,12 @@
+// Package version holds the version of this build: the one the CLI, the
+// status bar, plugins and the update command all report.
+package version
+
+// Version is the version of this build, without a leading "v". Release
+// builds set it with
+//
+//	-ldflags "-X scaffold/internal/version.Version=<version>"
+//
+// so an installed release reports its own version, and the next update
+// compares against it.
+var Version = "1.0.0"
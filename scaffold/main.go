@@ -4,14 +4,18 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"runtime"
+	"strings"
 
 	"scaffold/cmd"
 	"scaffold/config"
+	"scaffold/internal/features"
 	"scaffold/internal/logger"
 	"scaffold/internal/ui"
+	"scaffold/internal/ui/theme"
 )
 
 func main() {
@@ -26,6 +30,10 @@ func main() {
 		return
 	}
 
+	if cmd.SnapshotDir() != "" || cmd.SnapshotCompare() != "" {
+		os.Exit(runSnapshot())
+	}
+
 	// Initialize logger early based on CLI flag (config may override later)
 	logger.Setup(cmd.IsDebugMode())
 	defer logger.Close()
@@ -40,6 +48,11 @@ func main() {
 	logger.Debug("starting scaffold (debug mode enabled)")
 	logger.Debug("config path: %s", configPath)
 
+	if err := features.Load(cfg.Features); err != nil {
+		logger.Debug("feature flags: %v", err)
+	}
+	logger.Debug("active features: %s", strings.Join(features.Active(), ", "))
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -57,8 +70,42 @@ func main() {
 	logger.Debug("first run: %v", firstRun)
 	logger.Debug("starting UI")
 
-	if err := ui.Run(ctx, ui.New(ctx, cancel, *cfg, configPath, firstRun)); err != nil {
+	if cmd.NoColor() {
+		theme.SetMonochrome(true)
+		logger.Debug("monochrome rendering enabled")
+	}
+
+	// Start from what the environment advertises; the UI refines it with
+	// the terminal's own replies.
+	caps := theme.DetectCapabilities(os.Environ())
+	theme.SetColorProfile(caps.Profile)
+	themeMgr := theme.NewManager()
+	themeMgr.SetCapabilities(caps)
+	ctx = theme.NewContext(ctx, themeMgr)
+
+	m := ui.New(ctx, cancel, *cfg, configPath, firstRun)
+	if route := cmd.StartScreen(); route != "" {
+		var err error
+		if m, err = m.WithScreen(route); err != nil {
+			fmt.Fprintf(os.Stderr, "--screen: %v\n", err)
+			os.Exit(1)
+		}
+		logger.Debug("starting on screen: %s", route)
+	}
+
+	if cmd.Tour() {
+		m = m.WithTour()
+		logger.Debug("starting the tour")
+	}
+
+	if err := ui.Run(ctx, m); err != nil {
 		logger.Debug("Program exited: %v", err)
+		// Shut down by SIGTERM or SIGHUP: the UI has quit cleanly and saved
+		// its state, so exit with the status the signal would have given.
+		var sigErr ui.SignalError
+		if errors.As(err, &sigErr) {
+			os.Exit(sigErr.ExitCode())
+		}
 		os.Exit(1)
 	}
 }
@@ -89,3 +136,35 @@ func loadConfig() (*config.Config, string) {
 
 	return cfg, configPath
 }
+
+// runSnapshot renders the deterministic frame sequence and either writes it
+// (--snapshot-dir) or compares it against golden files (--snapshot-compare).
+// It returns the process exit code: 0 on success, 1 on error or mismatch.
+func runSnapshot() int {
+	frames := ui.Snapshot()
+
+	if dir := cmd.SnapshotDir(); dir != "" {
+		if err := ui.WriteSnapshots(dir, frames); err != nil {
+			fmt.Fprintf(os.Stderr, "snapshot: %v\n", err)
+			return 1
+		}
+		fmt.Printf("wrote %d frames to %s\n", len(frames), dir)
+	}
+
+	if dir := cmd.SnapshotCompare(); dir != "" {
+		diffs, err := ui.CompareSnapshots(dir, frames)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "snapshot: %v\n", err)
+			return 1
+		}
+		for _, d := range diffs {
+			fmt.Fprintln(os.Stderr, d)
+		}
+		if len(diffs) > 0 {
+			fmt.Fprintf(os.Stderr, "%d of %d frames differ\n", len(diffs), len(frames))
+			return 1
+		}
+		fmt.Printf("all %d frames match %s\n", len(frames), dir)
+	}
+	return 0
+}
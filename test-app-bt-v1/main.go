@@ -6,7 +6,7 @@ import (
 	"strings"
 	"time"
 
-	"ralphio/test-app-3/banner"
+	"banner"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -6,7 +6,7 @@ import (
 	"strings"
 	"time"
 
-	"test-app/banner"
+	"banner"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"